@@ -3,13 +3,15 @@ package client
 import (
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-06-01/subscriptions"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/giantswarm/microerror"
 
 	"github.com/giantswarm/azure-operator/v4/client/senddecorator"
@@ -18,6 +20,14 @@ import (
 
 const (
 	defaultAzureGUID = "37f13270-5c7a-56ff-9211-8426baaeaabd"
+
+	// Resource providers the clients talk to. Backpressure is shared between
+	// all clients of the same resource provider and subscription.
+	providerAuthorization = "Microsoft.Authorization"
+	providerCompute       = "Microsoft.Compute"
+	providerNetwork       = "Microsoft.Network"
+	providerResources     = "Microsoft.Resources"
+	providerStorage       = "Microsoft.Storage"
 )
 
 // AzureClientSet is the collection of Azure API clients.
@@ -35,10 +45,16 @@ type AzureClientSet struct {
 	DNSZonesClient *dns.ZonesClient
 	// InterfacesClient manages virtual network interfaces.
 	InterfacesClient *network.InterfacesClient
+	// PermissionsClient lists the permissions granted to the client set.
+	PermissionsClient *authorization.PermissionsClient
+	// ResourceSkusClient lists the VM sizes available in the subscription.
+	ResourceSkusClient *compute.ResourceSkusClient
 	//SecurityRulesClient manages networking rules in a security group.
 	SecurityRulesClient *network.SecurityRulesClient
 	//StorageAccountsClient manages blobs in storage containers.
 	StorageAccountsClient *storage.AccountsClient
+	// SubscriptionsClient is used to get information about subscriptions.
+	SubscriptionsClient *subscriptions.Client
 	// UsageClient is used to work with limits and quotas.
 	UsageClient *compute.UsageClient
 	// VirtualNetworkClient manages virtual networks.
@@ -53,13 +69,19 @@ type AzureClientSet struct {
 	VirtualMachineScaleSetVMsClient *compute.VirtualMachineScaleSetVMsClient
 	// VnetPeeringClient manages virtual network peerings.
 	VnetPeeringClient *network.VirtualNetworkPeeringsClient
+	// WhatIfClient predicts the changes deployments of ARM templates make.
+	WhatIfClient *features.DeploymentsClient
 }
 
 // NewAzureClientSet returns the Azure API clients using the given Authorizer.
-func NewAzureClientSet(clientCredentialsConfig auth.ClientCredentialsConfig, subscriptionID, partnerID string) (*AzureClientSet, error) {
-	authorizer, err := clientCredentialsConfig.Authorizer()
-	if err != nil {
-		return nil, microerror.Mask(err)
+// The given backpressure registry and throttle keep track of the rate limiting
+// of the subscription and are usually shared between all client sets.
+func NewAzureClientSet(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*AzureClientSet, error) {
+	if backpressures == nil {
+		return nil, microerror.Maskf(invalidConfigError, "backpressure registry must not be empty")
+	}
+	if throttle == nil {
+		return nil, microerror.Maskf(invalidConfigError, "throttle must not be empty")
 	}
 
 	if partnerID == "" {
@@ -67,59 +89,75 @@ func NewAzureClientSet(clientCredentialsConfig auth.ClientCredentialsConfig, sub
 	}
 	partnerID = fmt.Sprintf("pid-%s", partnerID)
 
-	deploymentsClient, err := newDeploymentsClient(authorizer, subscriptionID, partnerID)
+	deploymentsClient, err := newDeploymentsClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	dnsRecordSetsClient, err := newDNSRecordSetsClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	dnsZonesClient, err := newDNSZonesClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	groupsClient, err := newGroupsClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	interfacesClient, err := newInterfacesClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	dnsRecordSetsClient, err := newDNSRecordSetsClient(authorizer, subscriptionID, partnerID)
+	permissionsClient, err := newPermissionsClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	dnsZonesClient, err := newDNSZonesClient(authorizer, subscriptionID, partnerID)
+	resourceSkusClient, err := newResourceSkusClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	groupsClient, err := newGroupsClient(authorizer, subscriptionID, partnerID)
+	securityGroupsClient, err := newSecurityGroupsClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	interfacesClient, err := newInterfacesClient(authorizer, subscriptionID, partnerID)
+	storageAccountsClient, err := newStorageAccountsClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	securityGroupsClient, err := newSecurityGroupsClient(authorizer, subscriptionID, partnerID)
+	subscriptionsClient, err := newSubscriptionsClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	storageAccountsClient, err := newStorageAccountsClient(authorizer, subscriptionID, partnerID)
+	usageClient, err := newUsageClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	usageClient, err := newUsageClient(authorizer, subscriptionID, partnerID)
+	virtualNetworkClient, err := newVirtualNetworkClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	virtualNetworkClient, err := newVirtualNetworkClient(authorizer, subscriptionID, partnerID)
+	virtualNetworkGatewayConnectionsClient, err := newVirtualNetworkGatewayConnectionsClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	virtualNetworkGatewayConnectionsClient, err := newVirtualNetworkGatewayConnectionsClient(authorizer, subscriptionID, partnerID)
+	virtualNetworkGatewaysClient, err := newVirtualNetworkGatewaysClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	virtualNetworkGatewaysClient, err := newVirtualNetworkGatewaysClient(authorizer, subscriptionID, partnerID)
+	virtualMachineScaleSetVMsClient, err := newVirtualMachineScaleSetVMsClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	virtualMachineScaleSetVMsClient, err := newVirtualMachineScaleSetVMsClient(authorizer, subscriptionID, partnerID)
+	virtualMachineScaleSetsClient, err := newVirtualMachineScaleSetsClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	virtualMachineScaleSetsClient, err := newVirtualMachineScaleSetsClient(authorizer, subscriptionID, partnerID)
+	vnetPeeringClient, err := newVnetPeeringClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	vnetPeeringClient, err := newVnetPeeringClient(authorizer, subscriptionID, partnerID)
+	whatIfClient, err := newWhatIfClient(authorizer, subscriptionID, partnerID, backpressures, throttle)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
@@ -130,9 +168,12 @@ func NewAzureClientSet(clientCredentialsConfig auth.ClientCredentialsConfig, sub
 		DNSZonesClient:                         dnsZonesClient,
 		GroupsClient:                           groupsClient,
 		InterfacesClient:                       interfacesClient,
+		PermissionsClient:                      permissionsClient,
+		ResourceSkusClient:                     resourceSkusClient,
 		SecurityRulesClient:                    securityGroupsClient,
 		StorageAccountsClient:                  storageAccountsClient,
 		SubscriptionID:                         subscriptionID,
+		SubscriptionsClient:                    subscriptionsClient,
 		UsageClient:                            usageClient,
 		VirtualNetworkClient:                   virtualNetworkClient,
 		VirtualNetworkGatewayConnectionsClient: virtualNetworkGatewayConnectionsClient,
@@ -140,113 +181,142 @@ func NewAzureClientSet(clientCredentialsConfig auth.ClientCredentialsConfig, sub
 		VirtualMachineScaleSetVMsClient:        virtualMachineScaleSetVMsClient,
 		VirtualMachineScaleSetsClient:          virtualMachineScaleSetsClient,
 		VnetPeeringClient:                      vnetPeeringClient,
+		WhatIfClient:                           whatIfClient,
 	}
 
 	return clientSet, nil
 }
 
-func prepareClient(client *autorest.Client, authorizer autorest.Authorizer, partnerID string) *autorest.Client {
+func prepareClient(client *autorest.Client, authorizer autorest.Authorizer, partnerID, subscriptionID, provider string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) *autorest.Client {
 	client.Authorizer = authorizer
 	_ = client.AddToUserAgent(partnerID)
-	senddecorator.ConfigureClient(&backpressure.Backpressure{}, client)
+	senddecorator.ConfigureClient(backpressures.Get(subscriptionID, provider), throttle, subscriptionID, client)
 
 	return client
 }
 
-func newDeploymentsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*resources.DeploymentsClient, error) {
+func newDeploymentsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*resources.DeploymentsClient, error) {
 	client := resources.NewDeploymentsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerResources, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newDNSRecordSetsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*dns.RecordSetsClient, error) {
+func newDNSRecordSetsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*dns.RecordSetsClient, error) {
 	client := dns.NewRecordSetsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerNetwork, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newDNSZonesClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*dns.ZonesClient, error) {
+func newDNSZonesClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*dns.ZonesClient, error) {
 	client := dns.NewZonesClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerNetwork, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newGroupsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*resources.GroupsClient, error) {
+func newGroupsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*resources.GroupsClient, error) {
 	client := resources.NewGroupsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerResources, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newInterfacesClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*network.InterfacesClient, error) {
+func newInterfacesClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*network.InterfacesClient, error) {
 	client := network.NewInterfacesClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerNetwork, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newSecurityGroupsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*network.SecurityRulesClient, error) {
+func newPermissionsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*authorization.PermissionsClient, error) {
+	client := authorization.NewPermissionsClient(subscriptionID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerAuthorization, backpressures, throttle)
+
+	return &client, nil
+}
+
+func newResourceSkusClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*compute.ResourceSkusClient, error) {
+	client := compute.NewResourceSkusClient(subscriptionID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerCompute, backpressures, throttle)
+
+	return &client, nil
+}
+
+func newSecurityGroupsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*network.SecurityRulesClient, error) {
 	client := network.NewSecurityRulesClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerNetwork, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newStorageAccountsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*storage.AccountsClient, error) {
+func newStorageAccountsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*storage.AccountsClient, error) {
 	client := storage.NewAccountsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerStorage, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newUsageClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*compute.UsageClient, error) {
+func newSubscriptionsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*subscriptions.Client, error) {
+	client := subscriptions.NewClient()
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerResources, backpressures, throttle)
+
+	return &client, nil
+}
+
+func newUsageClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*compute.UsageClient, error) {
 	client := compute.NewUsageClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerCompute, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newVirtualNetworkClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*network.VirtualNetworksClient, error) {
+func newVirtualNetworkClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*network.VirtualNetworksClient, error) {
 	client := network.NewVirtualNetworksClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerNetwork, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newVirtualNetworkGatewayConnectionsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*network.VirtualNetworkGatewayConnectionsClient, error) {
+func newVirtualNetworkGatewayConnectionsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*network.VirtualNetworkGatewayConnectionsClient, error) {
 	client := network.NewVirtualNetworkGatewayConnectionsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerNetwork, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newVirtualNetworkGatewaysClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*network.VirtualNetworkGatewaysClient, error) {
+func newVirtualNetworkGatewaysClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*network.VirtualNetworkGatewaysClient, error) {
 	client := network.NewVirtualNetworkGatewaysClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerNetwork, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newVirtualMachineScaleSetsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*compute.VirtualMachineScaleSetsClient, error) {
+func newVirtualMachineScaleSetsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*compute.VirtualMachineScaleSetsClient, error) {
 	client := compute.NewVirtualMachineScaleSetsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerCompute, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newVirtualMachineScaleSetVMsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*compute.VirtualMachineScaleSetVMsClient, error) {
+func newVirtualMachineScaleSetVMsClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*compute.VirtualMachineScaleSetVMsClient, error) {
 	client := compute.NewVirtualMachineScaleSetVMsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerCompute, backpressures, throttle)
 
 	return &client, nil
 }
 
-func newVnetPeeringClient(authorizer autorest.Authorizer, subscriptionID, partnerID string) (*network.VirtualNetworkPeeringsClient, error) {
+func newVnetPeeringClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*network.VirtualNetworkPeeringsClient, error) {
 	client := network.NewVirtualNetworkPeeringsClient(subscriptionID)
-	prepareClient(&client.Client, authorizer, partnerID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerNetwork, backpressures, throttle)
+
+	return &client, nil
+}
+
+func newWhatIfClient(authorizer autorest.Authorizer, subscriptionID, partnerID string, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*features.DeploymentsClient, error) {
+	client := features.NewDeploymentsClient(subscriptionID)
+	prepareClient(&client.Client, authorizer, partnerID, subscriptionID, providerResources, backpressures, throttle)
 
 	return &client, nil
 }
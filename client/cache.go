@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/k8sclient"
+	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/giantswarm/azure-operator/v4/client/senddecorator"
+	"github.com/giantswarm/azure-operator/v4/pkg/backpressure"
+	"github.com/giantswarm/azure-operator/v4/pkg/credential"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+type CacheConfig struct {
+	Backpressures *backpressure.Registry
+	K8sClient     k8sclient.Interface
+	Throttle      *senddecorator.Throttle
+
+	// GSTenantID is the tenant ID the Service Principals of the organizations
+	// belong to.
+	GSTenantID string
+	// MSIEnabled tells whether managed identities are enabled for tenant
+	// clusters, which requires credentials to be able to assign roles.
+	MSIEnabled bool
+}
+
+// Cache holds the Azure client sets of the organizations keyed by their
+// credential Secret. Client sets are reused, including their authorizers and
+// tokens, for as long as the resource version of the Secret does not change.
+type Cache struct {
+	backpressures *backpressure.Registry
+	k8sClient     k8sclient.Interface
+	throttle      *senddecorator.Throttle
+
+	gsTenantID string
+	msiEnabled bool
+
+	mutex   sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	clientSet       *AzureClientSet
+	credentials     auth.ClientCredentialsConfig
+	resourceVersion string
+
+	// check is the result of the last credential check. It is reset whenever
+	// the Secret changes because a new entry is created.
+	check CredentialCheck
+}
+
+func NewCache(config CacheConfig) (*Cache, error) {
+	if config.Backpressures == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Backpressures must not be empty", config)
+	}
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Throttle == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Throttle must not be empty", config)
+	}
+
+	c := &Cache{
+		backpressures: config.Backpressures,
+		k8sClient:     config.K8sClient,
+		throttle:      config.Throttle,
+
+		gsTenantID: config.GSTenantID,
+		msiEnabled: config.MSIEnabled,
+
+		entries: map[string]cacheEntry{},
+	}
+
+	return c, nil
+}
+
+// GetOrganizationClientSet returns the Azure client set configured with the
+// organization's credentials of the given cluster together with these
+// credentials. A new client set is only created when the credential Secret
+// changed since the last call.
+func (c *Cache) GetOrganizationClientSet(cr providerv1alpha1.AzureConfig) (*AzureClientSet, auth.ClientCredentialsConfig, error) {
+	k := secretKey(cr)
+
+	secret, err := credential.GetCredentialSecret(c.k8sClient, cr)
+	if errors.IsNotFound(microerror.Cause(err)) {
+		c.mutex.Lock()
+		delete(c.entries, k)
+		c.mutex.Unlock()
+
+		return nil, auth.ClientCredentialsConfig{}, microerror.Mask(err)
+	} else if err != nil {
+		return nil, auth.ClientCredentialsConfig{}, microerror.Mask(err)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[k]
+	if ok && entry.resourceVersion == secret.ResourceVersion {
+		return entry.clientSet, entry.credentials, nil
+	}
+
+	credentials, err := credential.OrganizationCredentialsFromSecret(secret, c.gsTenantID)
+	if err != nil {
+		return nil, auth.ClientCredentialsConfig{}, microerror.Maskf(invalidSecretError, "credential secret %#q: %s", k, err)
+	}
+
+	authorizer, err := credentials.Authorizer()
+	if err != nil {
+		return nil, auth.ClientCredentialsConfig{}, microerror.Maskf(invalidSecretError, "credential secret %#q: %s", k, err)
+	}
+
+	clientSet, err := NewAzureClientSet(authorizer, credentials.SubscriptionID, credentials.PartnerID, c.backpressures, c.throttle)
+	if err != nil {
+		return nil, auth.ClientCredentialsConfig{}, microerror.Mask(err)
+	}
+
+	c.entries[k] = cacheEntry{
+		clientSet:       clientSet,
+		credentials:     credentials.ClientCredentialsConfig,
+		resourceVersion: secret.ResourceVersion,
+	}
+
+	return clientSet, credentials.ClientCredentialsConfig, nil
+}
+
+// CheckOrganizationCredentials validates the organization's credentials of the
+// given cluster. Credentials are validated against Azure whenever the
+// credential Secret changes. Results are cached per Secret and refreshed
+// periodically, failed results more frequently than valid ones. An error is
+// only returned when the Secret can not be read.
+func (c *Cache) CheckOrganizationCredentials(ctx context.Context, cr providerv1alpha1.AzureConfig) (CredentialCheck, error) {
+	k := secretKey(cr)
+
+	clientSet, _, err := c.GetOrganizationClientSet(cr)
+	if errors.IsNotFound(microerror.Cause(err)) {
+		check := newCredentialCheck(CredentialStatusInvalidSecret, "credential secret %#q not found", k)
+		reportCredentialCheck(key.CredentialNamespace(cr), key.CredentialName(cr), check)
+		return check, nil
+	} else if IsInvalidSecret(err) {
+		check := newCredentialCheck(CredentialStatusInvalidSecret, "%s", err)
+		reportCredentialCheck(key.CredentialNamespace(cr), key.CredentialName(cr), check)
+		return check, nil
+	} else if err != nil {
+		return CredentialCheck{}, microerror.Mask(err)
+	}
+
+	c.mutex.Lock()
+	entry := c.entries[k]
+	c.mutex.Unlock()
+
+	if entry.clientSet == clientSet && !entry.check.expired(time.Now()) {
+		return entry.check, nil
+	}
+
+	check := checkCredentials(ctx, clientSet, requiredActionsFor(c.msiEnabled))
+	reportCredentialCheck(key.CredentialNamespace(cr), key.CredentialName(cr), check)
+
+	c.mutex.Lock()
+	entry, ok := c.entries[k]
+	if ok && entry.clientSet == clientSet {
+		entry.check = check
+		c.entries[k] = entry
+	}
+	c.mutex.Unlock()
+
+	return check, nil
+}
+
+func secretKey(cr providerv1alpha1.AzureConfig) string {
+	return fmt.Sprintf("%s/%s", key.CredentialNamespace(cr), key.CredentialName(cr))
+}
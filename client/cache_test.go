@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/client/senddecorator"
+	"github.com/giantswarm/azure-operator/v4/pkg/backpressure"
+	"github.com/giantswarm/azure-operator/v4/service/unittest"
+)
+
+func TestCacheReusesClientSetUntilCredentialSecretChanges(t *testing.T) {
+	ctx := context.Background()
+	fakeK8sClient := unittest.FakeK8sClient()
+
+	throttle, err := senddecorator.NewThrottle(senddecorator.ThrottleConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache, err := NewCache(CacheConfig{
+		Backpressures: &backpressure.Registry{},
+		K8sClient:     fakeK8sClient,
+		Throttle:      throttle,
+
+		GSTenantID: "giantswarmTenantID",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "credential-test",
+			Namespace: "giantswarm",
+		},
+		Data: map[string][]byte{
+			"azure.azureoperator.clientid":       []byte("clientID"),
+			"azure.azureoperator.clientsecret":   []byte("clientSecret"),
+			"azure.azureoperator.subscriptionid": []byte("subscriptionID"),
+			"azure.azureoperator.tenantid":       []byte("giantswarmTenantID"),
+		},
+	}
+	err = fakeK8sClient.CtrlClient().Create(ctx, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cr := v1alpha1.AzureConfig{
+		Spec: v1alpha1.AzureConfigSpec{
+			Azure: v1alpha1.AzureConfigSpecAzure{
+				CredentialSecret: v1alpha1.CredentialSecret{
+					Name:      secret.Name,
+					Namespace: secret.Namespace,
+				},
+			},
+		},
+	}
+
+	first, _, err := cache.GetOrganizationClientSet(cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _, err := cache.GetOrganizationClientSet(cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Fatalf("client set should be reused as long as the credential secret does not change")
+	}
+
+	secret.Data["azure.azureoperator.subscriptionid"] = []byte("rotatedSubscriptionID")
+	err = fakeK8sClient.CtrlClient().Update(ctx, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	third, _, err := cache.GetOrganizationClientSet(cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third == second {
+		t.Fatalf("client set should be recreated when the credential secret changes")
+	}
+	if third.SubscriptionID != "rotatedSubscriptionID" {
+		t.Fatalf("subscriptionID has the wrong value: expected %#q, got %#q", "rotatedSubscriptionID", third.SubscriptionID)
+	}
+
+	err = fakeK8sClient.CtrlClient().Delete(ctx, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = cache.GetOrganizationClientSet(cr)
+	if err == nil {
+		t.Fatalf("it should fail when the credential secret is deleted")
+	}
+}
+
+func TestCacheReportsInvalidCredentialSecret(t *testing.T) {
+	ctx := context.Background()
+	fakeK8sClient := unittest.FakeK8sClient()
+
+	throttle, err := senddecorator.NewThrottle(senddecorator.ThrottleConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache, err := NewCache(CacheConfig{
+		Backpressures: &backpressure.Registry{},
+		K8sClient:     fakeK8sClient,
+		Throttle:      throttle,
+
+		GSTenantID: "giantswarmTenantID",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cr := v1alpha1.AzureConfig{
+		Spec: v1alpha1.AzureConfigSpec{
+			Azure: v1alpha1.AzureConfigSpecAzure{
+				CredentialSecret: v1alpha1.CredentialSecret{
+					Name:      "credential-test",
+					Namespace: "giantswarm",
+				},
+			},
+		},
+	}
+
+	check, err := cache.CheckOrganizationCredentials(ctx, cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if check.Status != CredentialStatusInvalidSecret {
+		t.Fatalf("status == %#q, want %#q when the credential secret does not exist", check.Status, CredentialStatusInvalidSecret)
+	}
+
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "credential-test",
+			Namespace: "giantswarm",
+		},
+		Data: map[string][]byte{
+			"azure.azureoperator.clientsecret":   []byte("clientSecret"),
+			"azure.azureoperator.subscriptionid": []byte("subscriptionID"),
+			"azure.azureoperator.tenantid":       []byte("giantswarmTenantID"),
+		},
+	}
+	err = fakeK8sClient.CtrlClient().Create(ctx, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check, err = cache.CheckOrganizationCredentials(ctx, cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if check.Status != CredentialStatusInvalidSecret {
+		t.Fatalf("status == %#q, want %#q when the client ID is missing", check.Status, CredentialStatusInvalidSecret)
+	}
+	if !check.Failed() {
+		t.Fatalf("check should be failed")
+	}
+}
@@ -0,0 +1,298 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/giantswarm/microerror"
+)
+
+// CredentialStatus is the outcome of validating the credentials of an
+// organization.
+type CredentialStatus string
+
+const (
+	// CredentialStatusValid means the credentials can be used to manage tenant
+	// clusters in the subscription.
+	CredentialStatusValid CredentialStatus = "Valid"
+	// CredentialStatusInvalidSecret means the credential Secret does not exist
+	// or can not be parsed.
+	CredentialStatusInvalidSecret CredentialStatus = "InvalidSecret"
+	// CredentialStatusTokenAcquisitionFailed means Azure AD refused to issue a
+	// token, e.g. because the client secret expired.
+	CredentialStatusTokenAcquisitionFailed CredentialStatus = "TokenAcquisitionFailed"
+	// CredentialStatusSubscriptionAccessDenied means a token was issued but the
+	// identity can not access the subscription.
+	CredentialStatusSubscriptionAccessDenied CredentialStatus = "SubscriptionAccessDenied"
+	// CredentialStatusMissingPermissions means the role assignments of the
+	// identity do not grant all the actions the operator needs.
+	CredentialStatusMissingPermissions CredentialStatus = "MissingPermissions"
+	// CredentialStatusUnknown means the credentials could not be validated
+	// because of a transient error. The check is retried shortly.
+	CredentialStatusUnknown CredentialStatus = "Unknown"
+)
+
+const (
+	// credentialCheckValidTTL is the time after which valid credentials are
+	// checked again, so that revoked role assignments are eventually noticed
+	// even though the Secret did not change.
+	credentialCheckValidTTL = 1 * time.Hour
+	// credentialCheckFailedTTL is the time after which failed checks are
+	// retried, so that fixed role assignments are noticed.
+	credentialCheckFailedTTL = 5 * time.Minute
+	// credentialCheckUnknownTTL is the time after which checks which failed
+	// because of transient errors are retried.
+	credentialCheckUnknownTTL = 1 * time.Minute
+
+	// maxCredentialCheckMessageLength limits the size of the messages
+	// persisted in the status of the custom resources.
+	maxCredentialCheckMessageLength = 256
+)
+
+// requiredActions are the actions the operator performs on behalf of the
+// organizations in their subscriptions.
+var requiredActions = []string{
+	"Microsoft.Compute/virtualMachineScaleSets/write",
+	"Microsoft.Network/virtualNetworks/write",
+	"Microsoft.Resources/deployments/write",
+	"Microsoft.Resources/subscriptions/resourceGroups/write",
+	"Microsoft.Storage/storageAccounts/write",
+}
+
+// requiredMSIActions are the actions the operator additionally performs when
+// managed identities are enabled. Role assignments are then created by the ARM
+// templates for the managed identities of the virtual machines.
+var requiredMSIActions = []string{
+	"Microsoft.Authorization/roleAssignments/write",
+}
+
+// requiredActionsFor returns the actions the operator performs on behalf of
+// the organizations with or without managed identities.
+func requiredActionsFor(msiEnabled bool) []string {
+	if !msiEnabled {
+		return requiredActions
+	}
+
+	return append(append([]string{}, requiredMSIActions...), requiredActions...)
+}
+
+// CredentialCheck is the result of validating the credentials of an
+// organization.
+type CredentialCheck struct {
+	Status  CredentialStatus
+	Message string
+	Time    time.Time
+}
+
+// Failed returns true when the credentials are known to be unusable. Checks
+// which could not be completed because of transient errors are not failed.
+func (c CredentialCheck) Failed() bool {
+	return c.Status != CredentialStatusValid && c.Status != CredentialStatusUnknown
+}
+
+func (c CredentialCheck) expired(now time.Time) bool {
+	if c.Time.IsZero() {
+		return true
+	}
+
+	var ttl time.Duration
+	switch c.Status {
+	case CredentialStatusValid:
+		ttl = credentialCheckValidTTL
+	case CredentialStatusUnknown:
+		ttl = credentialCheckUnknownTTL
+	default:
+		ttl = credentialCheckFailedTTL
+	}
+
+	return now.Sub(c.Time) >= ttl
+}
+
+func newCredentialCheck(status CredentialStatus, format string, args ...interface{}) CredentialCheck {
+	m := fmt.Sprintf(format, args...)
+	if len(m) > maxCredentialCheckMessageLength {
+		m = m[:maxCredentialCheckMessageLength]
+	}
+
+	return CredentialCheck{
+		Status:  status,
+		Message: m,
+		Time:    time.Now(),
+	}
+}
+
+// checkCredentials validates the credentials the given client set is
+// configured with by acquiring a token, reading the subscription and checking
+// the permissions granted in the subscription cover the given actions.
+func checkCredentials(ctx context.Context, clientSet *AzureClientSet, actions []string) CredentialCheck {
+	_, err := clientSet.SubscriptionsClient.Get(ctx, clientSet.SubscriptionID)
+	if err != nil {
+		return classifyCredentialError(err, CredentialStatusSubscriptionAccessDenied, "subscription %#q can not be accessed", clientSet.SubscriptionID)
+	}
+
+	permissions, err := listSubscriptionPermissions(ctx, clientSet.PermissionsClient)
+	if err != nil {
+		return classifyCredentialError(err, CredentialStatusMissingPermissions, "permissions in subscription %#q can not be listed", clientSet.SubscriptionID)
+	}
+
+	missing := missingActions(permissions, actions)
+	if len(missing) > 0 {
+		return newCredentialCheck(CredentialStatusMissingPermissions, "actions %s are not granted in subscription %#q", strings.Join(missing, ", "), clientSet.SubscriptionID)
+	}
+
+	return newCredentialCheck(CredentialStatusValid, "credentials are valid for subscription %#q", clientSet.SubscriptionID)
+}
+
+// classifyCredentialError maps the error of a request made during a credential
+// check to the check result. Authorization failures result in the given
+// status, errors which can not be attributed to the credentials result in
+// CredentialStatusUnknown.
+func classifyCredentialError(err error, status CredentialStatus, format string, args ...interface{}) CredentialCheck {
+	m := fmt.Sprintf(format, args...)
+
+	if isTokenAcquisitionError(err) {
+		return newCredentialCheck(CredentialStatusTokenAcquisitionFailed, "token can not be acquired: %s", err)
+	}
+
+	switch responseStatusCode(err) {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return newCredentialCheck(status, "%s: %s", m, err)
+	}
+
+	return newCredentialCheck(CredentialStatusUnknown, "%s: %s", m, err)
+}
+
+// isTokenAcquisitionError returns true when the given error was caused by the
+// authorizer failing to acquire a token from Azure AD.
+func isTokenAcquisitionError(err error) bool {
+	err = microerror.Cause(err)
+
+	for err != nil {
+		if _, ok := err.(adal.TokenRefreshError); ok {
+			return true
+		}
+
+		detailed, ok := err.(autorest.DetailedError)
+		if !ok {
+			return false
+		}
+		if detailed.PackageType == "azure.BearerAuthorizer" || detailed.PackageType == "autorest.BearerAuthorizer" {
+			return true
+		}
+
+		err = detailed.Original
+	}
+
+	return false
+}
+
+func responseStatusCode(err error) int {
+	detailed, ok := microerror.Cause(err).(autorest.DetailedError)
+	if !ok {
+		return 0
+	}
+
+	if code, ok := detailed.StatusCode.(int); ok {
+		return code
+	}
+	if detailed.Response != nil {
+		return detailed.Response.StatusCode
+	}
+
+	return 0
+}
+
+// listSubscriptionPermissions lists the permissions the client is granted at
+// subscription scope. The SDK only provides this for resource groups, so the
+// request is prepared here and sent using the client's pipeline.
+func listSubscriptionPermissions(ctx context.Context, client *authorization.PermissionsClient) ([]authorization.Permission, error) {
+	pathParameters := map[string]interface{}{
+		"subscriptionId": autorest.Encode("path", client.SubscriptionID),
+	}
+	const APIVersion = "2015-07-01"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/providers/Microsoft.Authorization/permissions", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "client", "listSubscriptionPermissions", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "client", "listSubscriptionPermissions", resp, "Failure sending request")
+	}
+
+	result, err := client.ListForResourceGroupResponder(resp)
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "client", "listSubscriptionPermissions", resp, "Failure responding to request")
+	}
+
+	if result.Value == nil {
+		return nil, nil
+	}
+
+	return *result.Value, nil
+}
+
+// missingActions returns the actions of the given list which are not granted
+// by the given permissions. An action is granted when it matches the actions
+// of a permission but none of its not actions.
+func missingActions(permissions []authorization.Permission, actions []string) []string {
+	var missing []string
+
+	for _, a := range actions {
+		var granted bool
+		for _, p := range permissions {
+			if matchesAnyAction(p.Actions, a) && !matchesAnyAction(p.NotActions, a) {
+				granted = true
+				break
+			}
+		}
+
+		if !granted {
+			missing = append(missing, a)
+		}
+	}
+
+	return missing
+}
+
+func matchesAnyAction(patterns *[]string, action string) bool {
+	if patterns == nil {
+		return false
+	}
+
+	for _, p := range *patterns {
+		if matchesAction(p, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesAction matches the given action against an action pattern of a role
+// definition. Patterns may contain wildcards and are case insensitive.
+func matchesAction(pattern, action string) bool {
+	expr := "(?i)^" + strings.Replace(regexp.QuoteMeta(pattern), `\*`, ".*", -1) + "$"
+
+	// Quoted patterns are always valid regular expressions.
+	re := regexp.MustCompile(expr)
+
+	return re.MatchString(action)
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_missingActions(t *testing.T) {
+	testCases := []struct {
+		name            string
+		permissions     []authorization.Permission
+		msiEnabled      bool
+		expectedMissing []string
+	}{
+		{
+			name: "case 0: owner is granted everything",
+			permissions: []authorization.Permission{
+				{Actions: &[]string{"*"}, NotActions: &[]string{}},
+			},
+			msiEnabled:      true,
+			expectedMissing: nil,
+		},
+		{
+			name: "case 1: contributor can not assign roles to managed identities",
+			permissions: []authorization.Permission{
+				{
+					Actions: &[]string{"*"},
+					NotActions: &[]string{
+						"Microsoft.Authorization/*/Delete",
+						"Microsoft.Authorization/*/Write",
+						"Microsoft.Authorization/elevateAccess/Action",
+					},
+				},
+			},
+			msiEnabled:      true,
+			expectedMissing: []string{"Microsoft.Authorization/roleAssignments/write"},
+		},
+		{
+			name: "case 2: contributor and user access administrator are granted everything",
+			permissions: []authorization.Permission{
+				{
+					Actions: &[]string{"*"},
+					NotActions: &[]string{
+						"Microsoft.Authorization/*/Delete",
+						"Microsoft.Authorization/*/Write",
+						"Microsoft.Authorization/elevateAccess/Action",
+					},
+				},
+				{
+					Actions: &[]string{"*/read", "Microsoft.Authorization/*", "Microsoft.Support/*"},
+				},
+			},
+			msiEnabled:      true,
+			expectedMissing: nil,
+		},
+		{
+			name: "case 3: reader is granted nothing",
+			permissions: []authorization.Permission{
+				{Actions: &[]string{"*/read"}},
+			},
+			msiEnabled:      true,
+			expectedMissing: requiredActionsFor(true),
+		},
+		{
+			name:            "case 4: no permissions",
+			msiEnabled:      true,
+			expectedMissing: requiredActionsFor(true),
+		},
+		{
+			name: "case 5: contributor is granted everything without managed identities",
+			permissions: []authorization.Permission{
+				{
+					Actions: &[]string{"*"},
+					NotActions: &[]string{
+						"Microsoft.Authorization/*/Delete",
+						"Microsoft.Authorization/*/Write",
+						"Microsoft.Authorization/elevateAccess/Action",
+					},
+				},
+			},
+			msiEnabled:      false,
+			expectedMissing: nil,
+		},
+		{
+			name:            "case 6: no permissions without managed identities",
+			msiEnabled:      false,
+			expectedMissing: requiredActions,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			missing := missingActions(tc.permissions, requiredActionsFor(tc.msiEnabled))
+
+			if !cmp.Equal(missing, tc.expectedMissing) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedMissing, missing))
+			}
+		})
+	}
+}
+
+func Test_classifyCredentialError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedStatus CredentialStatus
+	}{
+		{
+			name:           "case 0: token can not be refreshed",
+			err:            autorest.NewErrorWithError(autorest.NewErrorWithError(errors.New("AADSTS7000215"), "azure.BearerAuthorizer", "WithAuthorization", nil, "Failed to refresh the Token"), "subscriptions.Client", "Get", nil, "Failure preparing request"),
+			expectedStatus: CredentialStatusTokenAcquisitionFailed,
+		},
+		{
+			name:           "case 1: subscription access is forbidden",
+			err:            autorest.NewErrorWithError(errors.New("forbidden"), "subscriptions.Client", "Get", &http.Response{StatusCode: http.StatusForbidden}, "Failure responding to request"),
+			expectedStatus: CredentialStatusSubscriptionAccessDenied,
+		},
+		{
+			name:           "case 2: subscription does not exist",
+			err:            autorest.NewErrorWithError(errors.New("not found"), "subscriptions.Client", "Get", &http.Response{StatusCode: http.StatusNotFound}, "Failure responding to request"),
+			expectedStatus: CredentialStatusSubscriptionAccessDenied,
+		},
+		{
+			name:           "case 3: server error is transient",
+			err:            autorest.NewErrorWithError(errors.New("internal"), "subscriptions.Client", "Get", &http.Response{StatusCode: http.StatusInternalServerError}, "Failure responding to request"),
+			expectedStatus: CredentialStatusUnknown,
+		},
+		{
+			name:           "case 4: network error is transient",
+			err:            errors.New("connection reset by peer"),
+			expectedStatus: CredentialStatusUnknown,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			check := classifyCredentialError(tc.err, CredentialStatusSubscriptionAccessDenied, "subscription %#q can not be accessed", "subscriptionID")
+
+			if check.Status != tc.expectedStatus {
+				t.Fatalf("status == %#q, want %#q", check.Status, tc.expectedStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	PrometheusNamespace = "azure_operator"
+	PrometheusSubsystem = "credential"
+)
+
+var credentialStatuses = []CredentialStatus{
+	CredentialStatusValid,
+	CredentialStatusInvalidSecret,
+	CredentialStatusTokenAcquisitionFailed,
+	CredentialStatusSubscriptionAccessDenied,
+	CredentialStatusMissingPermissions,
+	CredentialStatusUnknown,
+}
+
+var (
+	credentialStatusGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "status",
+			Help:      "Result of the last check of an organization credential Secret. The current status is 1, all others are 0.",
+		},
+		[]string{"namespace", "name", "status"},
+	)
+	credentialChecksCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "checks_total",
+			Help:      "Number of organization credential checks performed per result.",
+		},
+		[]string{"status"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(credentialStatusGauge)
+	prometheus.MustRegister(credentialChecksCounter)
+}
+
+func reportCredentialCheck(namespace, name string, check CredentialCheck) {
+	credentialChecksCounter.WithLabelValues(string(check.Status)).Inc()
+
+	for _, s := range credentialStatuses {
+		var v float64
+		if s == check.Status {
+			v = 1
+		}
+		credentialStatusGauge.WithLabelValues(namespace, name, string(s)).Set(v)
+	}
+}
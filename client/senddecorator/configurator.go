@@ -6,15 +6,17 @@ import (
 	"github.com/giantswarm/azure-operator/v4/pkg/backpressure"
 )
 
-// ConfigureClient accepts backpressure instance and configures given autorest
-// Client instance with all local `autorest.SendDecorator` implementations in this
-// package.
+// ConfigureClient accepts backpressure and throttle instances and configures
+// given autorest Client instance with all local `autorest.SendDecorator`
+// implementations in this package. The given subscription ID is used to track
+// the rate limit budgets of the subscription the client is configured for.
 //
 // Existing SendDecorators are preserved, but moved to end of slice.
-func ConfigureClient(g *backpressure.Backpressure, c *autorest.Client) {
+func ConfigureClient(g *backpressure.Backpressure, t *Throttle, subscriptionID string, c *autorest.Client) {
 	c.SendDecorators = append([]autorest.SendDecorator{
 		// NOTE: Order matters here since these decorators are executed in
 		// order. See: https://godoc.org/github.com/Azure/go-autorest/autorest#Client
 		RateLimitCircuitBreaker(g),
+		RateLimitBudget(t, subscriptionID),
 	}, c.SendDecorators...)
 }
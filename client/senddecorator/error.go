@@ -1,6 +1,9 @@
 package senddecorator
 
-import "github.com/giantswarm/microerror"
+import (
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/giantswarm/microerror"
+)
 
 var tooManyRequestsError = &microerror.Error{
 	Kind: "tooManyRequestsError",
@@ -10,3 +13,41 @@ var tooManyRequestsError = &microerror.Error{
 func IsTooManyRequests(err error) bool {
 	return microerror.Cause(err) == tooManyRequestsError
 }
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
+var rateLimitBudgetExhaustedError = &microerror.Error{
+	Kind: "rateLimitBudgetExhaustedError",
+}
+
+// IsRateLimitBudgetExhausted asserts rateLimitBudgetExhaustedError. The error
+// may also be wrapped by the Azure clients into an autorest.DetailedError.
+func IsRateLimitBudgetExhausted(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	c := microerror.Cause(err)
+
+	if c == rateLimitBudgetExhaustedError {
+		return true
+	}
+
+	{
+		dErr, ok := c.(autorest.DetailedError)
+		if ok {
+			if microerror.Cause(dErr.Original) == rateLimitBudgetExhaustedError {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,26 @@
+package senddecorator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	PrometheusNamespace = "azure_operator"
+	PrometheusSubsystem = "api"
+)
+
+var (
+	remainingBudgetGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "ratelimit_remaining",
+			Help:      "Remaining calls of an ARM rate limit budget as last reported by Azure per subscription.",
+		},
+		[]string{"subscription_id", "budget"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(remainingBudgetGauge)
+}
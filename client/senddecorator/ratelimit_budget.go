@@ -0,0 +1,247 @@
+package senddecorator
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	// rateLimitHeaderPrefix is the common prefix of all response headers
+	// carrying the remaining number of calls of an ARM rate limit budget, e.g.
+	// x-ms-ratelimit-remaining-subscription-reads.
+	rateLimitHeaderPrefix = "x-ms-ratelimit-remaining-"
+	// rateLimitResourceHeader is the suffix of the header carrying resource
+	// provider specific budgets as a comma separated list of name;value pairs,
+	// e.g. Microsoft.Compute/HighCostGetVMScaleSet3Min;107.
+	rateLimitResourceHeader = "resource"
+
+	// budgetTTL is the time after which an observed budget is not considered
+	// anymore. Budgets are replenished over time, so deferring calls based on
+	// old observations would defer them forever when nothing else is calling
+	// the API.
+	budgetTTL = 5 * time.Minute
+)
+
+// knownBudgetLimits are the documented maximums of ARM rate limit budgets.
+// Budgets not listed here are related to the highest remaining value observed.
+var knownBudgetLimits = map[string]int64{
+	"subscription-reads":   12000,
+	"subscription-writes":  1200,
+	"subscription-deletes": 15000,
+	"tenant-reads":         12000,
+	"tenant-writes":        1200,
+	"tenant-deletes":       15000,
+	"Microsoft.Compute/HighCostGetVMScaleSet3Min":  190,
+	"Microsoft.Compute/HighCostGetVMScaleSet30Min": 900,
+}
+
+type lowPriorityKey struct{}
+
+// WithLowPriority returns a context marking the requests made with it as low
+// priority. Low priority requests are slowed down and deferred by
+// RateLimitBudget when the remaining rate limit budgets run low.
+func WithLowPriority(ctx context.Context) context.Context {
+	return context.WithValue(ctx, lowPriorityKey{}, true)
+}
+
+// IsLowPriority returns whether the given context marks requests as low
+// priority.
+func IsLowPriority(ctx context.Context) bool {
+	v, ok := ctx.Value(lowPriorityKey{}).(bool)
+	return ok && v
+}
+
+type ThrottleConfig struct {
+	// DeferThreshold is the fraction of the remaining budget below which low
+	// priority requests are not sent at all.
+	DeferThreshold float64
+	// SlowdownDelay is the time low priority requests are delayed by when the
+	// remaining budget is below SlowdownThreshold.
+	SlowdownDelay time.Duration
+	// SlowdownThreshold is the fraction of the remaining budget below which
+	// low priority requests are delayed.
+	SlowdownThreshold float64
+}
+
+// Throttle keeps track of the remaining ARM rate limit budgets per
+// subscription as reported in the response headers.
+type Throttle struct {
+	deferThreshold    float64
+	slowdownDelay     time.Duration
+	slowdownThreshold float64
+
+	mutex   sync.Mutex
+	budgets map[string]map[string]budget
+}
+
+type budget struct {
+	limit     int64
+	observed  time.Time
+	remaining int64
+}
+
+func NewThrottle(config ThrottleConfig) (*Throttle, error) {
+	if config.DeferThreshold < 0 || config.DeferThreshold > 1 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.DeferThreshold must be between 0 and 1", config)
+	}
+	if config.SlowdownDelay < 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.SlowdownDelay must not be negative", config)
+	}
+	if config.SlowdownThreshold < config.DeferThreshold || config.SlowdownThreshold > 1 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.SlowdownThreshold must be between %T.DeferThreshold and 1", config, config)
+	}
+
+	t := &Throttle{
+		deferThreshold:    config.DeferThreshold,
+		slowdownDelay:     config.SlowdownDelay,
+		slowdownThreshold: config.SlowdownThreshold,
+
+		budgets: map[string]map[string]budget{},
+	}
+
+	return t, nil
+}
+
+// RateLimitBudget records the remaining rate limit budgets reported in every
+// response for the given subscription and exports them as metrics. Low
+// priority requests are delayed or deferred when any of the budgets of the
+// subscription drops below the configured thresholds, so that they do not
+// contribute to exhausting the budgets of the whole subscription.
+func RateLimitBudget(t *Throttle, subscriptionID string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			ctx := r.Context()
+
+			if IsLowPriority(ctx) {
+				name, fraction := t.lowestBudget(subscriptionID, time.Now())
+
+				if fraction < t.deferThreshold {
+					return nil, microerror.Maskf(rateLimitBudgetExhaustedError, "budget %#q of subscription %#q is at %.0f%%", name, subscriptionID, fraction*100)
+				}
+
+				if fraction < t.slowdownThreshold {
+					select {
+					case <-time.After(t.slowdownDelay):
+					case <-ctx.Done():
+						return nil, microerror.Mask(ctx.Err())
+					}
+				}
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				t.observe(subscriptionID, resp.Header, time.Now())
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// lowestBudget returns the name and the remaining fraction of the budget of
+// the given subscription which is closest to being exhausted. Without any
+// recent observation the full budget is assumed.
+func (t *Throttle) lowestBudget(subscriptionID string, now time.Time) (string, float64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	lowestName := ""
+	lowest := float64(1)
+	for name, b := range t.budgets[subscriptionID] {
+		if now.Sub(b.observed) > budgetTTL || b.limit <= 0 {
+			continue
+		}
+
+		fraction := float64(b.remaining) / float64(b.limit)
+		if fraction < lowest {
+			lowestName = name
+			lowest = fraction
+		}
+	}
+
+	return lowestName, lowest
+}
+
+func (t *Throttle) observe(subscriptionID string, header http.Header, now time.Time) {
+	remaining := parseRateLimitHeaders(header)
+	if len(remaining) == 0 {
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	budgets, ok := t.budgets[subscriptionID]
+	if !ok {
+		budgets = map[string]budget{}
+		t.budgets[subscriptionID] = budgets
+	}
+
+	for name, value := range remaining {
+		limit, ok := knownBudgetLimits[name]
+		if !ok {
+			limit = budgets[name].limit
+		}
+		if value > limit {
+			limit = value
+		}
+
+		budgets[name] = budget{
+			limit:     limit,
+			observed:  now,
+			remaining: value,
+		}
+
+		remainingBudgetGauge.WithLabelValues(subscriptionID, name).Set(float64(value))
+	}
+}
+
+// parseRateLimitHeaders returns the remaining calls of all rate limit budgets
+// found in the given response headers, keyed by the budget name. Malformed
+// values are ignored.
+func parseRateLimitHeaders(header http.Header) map[string]int64 {
+	remaining := map[string]int64{}
+
+	for k, values := range header {
+		k = strings.ToLower(k)
+		if !strings.HasPrefix(k, rateLimitHeaderPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(k, rateLimitHeaderPrefix)
+
+		for _, v := range values {
+			if name != rateLimitResourceHeader {
+				n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+				if err != nil {
+					continue
+				}
+
+				remaining[name] = n
+				continue
+			}
+
+			for _, t := range strings.Split(v, ",") {
+				kv := strings.SplitN(t, ";", 2)
+				if len(kv) != 2 {
+					continue
+				}
+
+				n, err := strconv.ParseInt(strings.TrimSpace(kv[1]), 10, 64)
+				if err != nil {
+					continue
+				}
+
+				remaining[strings.TrimSpace(kv[0])] = n
+			}
+		}
+	}
+
+	return remaining
+}
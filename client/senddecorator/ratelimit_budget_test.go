@@ -0,0 +1,161 @@
+package senddecorator
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_parseRateLimitHeaders(t *testing.T) {
+	testCases := []struct {
+		name              string
+		header            http.Header
+		expectedRemaining map[string]int64
+	}{
+		{
+			name:              "case 0: no rate limit headers",
+			header:            http.Header{"Content-Type": []string{"application/json"}},
+			expectedRemaining: map[string]int64{},
+		},
+		{
+			name: "case 1: subscription and resource budgets",
+			header: http.Header{
+				"X-Ms-Ratelimit-Remaining-Subscription-Reads": []string{"11999"},
+				"X-Ms-Ratelimit-Remaining-Resource":           []string{"Microsoft.Compute/HighCostGetVMScaleSet3Min;107,Microsoft.Compute/HighCostGetVMScaleSet30Min;827"},
+			},
+			expectedRemaining: map[string]int64{
+				"subscription-reads":                           11999,
+				"Microsoft.Compute/HighCostGetVMScaleSet3Min":  107,
+				"Microsoft.Compute/HighCostGetVMScaleSet30Min": 827,
+			},
+		},
+		{
+			name: "case 2: malformed values are ignored",
+			header: http.Header{
+				"X-Ms-Ratelimit-Remaining-Subscription-Writes": []string{"many"},
+				"X-Ms-Ratelimit-Remaining-Resource":            []string{"Microsoft.Compute/PutVM3Min,Microsoft.Compute/PutVM30Min;12"},
+			},
+			expectedRemaining: map[string]int64{
+				"Microsoft.Compute/PutVM30Min": 12,
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			remaining := parseRateLimitHeaders(tc.header)
+
+			if !cmp.Equal(remaining, tc.expectedRemaining) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedRemaining, remaining))
+			}
+		})
+	}
+}
+
+func Test_RateLimitBudget(t *testing.T) {
+	testCases := []struct {
+		name          string
+		remaining     string
+		lowPriority   bool
+		expectedSent  int
+		errorMatcher  func(error) bool
+		expectedDelay bool
+	}{
+		{
+			name:         "case 0: low priority request with plenty of budget is sent",
+			remaining:    "11000",
+			lowPriority:  true,
+			expectedSent: 2,
+		},
+		{
+			name:          "case 1: low priority request with low budget is slowed down",
+			remaining:     "3000",
+			lowPriority:   true,
+			expectedSent:  2,
+			expectedDelay: true,
+		},
+		{
+			name:         "case 2: low priority request with exhausted budget is deferred",
+			remaining:    "100",
+			lowPriority:  true,
+			expectedSent: 1,
+			errorMatcher: IsRateLimitBudgetExhausted,
+		},
+		{
+			name:         "case 3: normal request with exhausted budget is sent",
+			remaining:    "100",
+			expectedSent: 2,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			throttle, err := NewThrottle(ThrottleConfig{
+				DeferThreshold:    0.1,
+				SlowdownDelay:     50 * time.Millisecond,
+				SlowdownThreshold: 0.5,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var sent int
+			sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				sent++
+				resp := &http.Response{
+					Header:     http.Header{},
+					StatusCode: http.StatusOK,
+				}
+				resp.Header.Set("X-Ms-Ratelimit-Remaining-Subscription-Reads", tc.remaining)
+				return resp, nil
+			})
+			s := RateLimitBudget(throttle, "sub")(sender)
+
+			ctx := context.Background()
+			if tc.lowPriority {
+				ctx = WithLowPriority(ctx)
+			}
+
+			// The first request records the budget, the second one is subject
+			// to throttling.
+			for j := 0; j < 2; j++ {
+				var r *http.Request
+				r, err = http.NewRequestWithContext(ctx, http.MethodGet, "https://management.azure.com", nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				start := time.Now()
+				_, err = s.Do(r)
+				delayed := time.Since(start) >= 50*time.Millisecond
+				if j == 1 && delayed != tc.expectedDelay {
+					t.Fatalf("delayed == %t, want %t", delayed, tc.expectedDelay)
+				}
+			}
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if sent != tc.expectedSent {
+				t.Fatalf("sent == %d, want %d", sent, tc.expectedSent)
+			}
+		})
+	}
+}
@@ -3,17 +3,22 @@ package azure
 import (
 	"github.com/giantswarm/azure-operator/v4/flag/service/azure/hostcluster"
 	"github.com/giantswarm/azure-operator/v4/flag/service/azure/msi"
+	"github.com/giantswarm/azure-operator/v4/flag/service/azure/ratelimit"
+	"github.com/giantswarm/azure-operator/v4/flag/service/azure/rollingupdate"
 	"github.com/giantswarm/azure-operator/v4/flag/service/azure/template"
 )
 
 type Azure struct {
 	ClientID         string
 	ClientSecret     string
+	DryRun           string
 	EnvironmentName  string
 	HostCluster      hostcluster.HostCluster
 	MSI              msi.MSI
 	Location         string
 	PartnerID        string
+	RateLimit        ratelimit.RateLimit
+	RollingUpdate    rollingupdate.RollingUpdate
 	SubscriptionID   string
 	TenantID         string
 	Template         template.Template
@@ -0,0 +1,7 @@
+package ratelimit
+
+type RateLimit struct {
+	DeferThreshold    string
+	SlowdownDelay     string
+	SlowdownThreshold string
+}
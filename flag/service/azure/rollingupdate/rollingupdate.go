@@ -0,0 +1,6 @@
+package rollingupdate
+
+type RollingUpdate struct {
+	MaxSurge       string
+	MaxUnavailable string
+}
@@ -0,0 +1,6 @@
+package canary
+
+type Canary struct {
+	Enabled string
+	Timeout string
+}
@@ -0,0 +1,7 @@
+package drainer
+
+type Drainer struct {
+	GracePeriod string
+	Timeout     string
+	Type        string
+}
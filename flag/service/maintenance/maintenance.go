@@ -0,0 +1,5 @@
+package maintenance
+
+type Maintenance struct {
+	Windows string
+}
@@ -0,0 +1,7 @@
+package remediation
+
+type Remediation struct {
+	Action          string
+	MaxConcurrent   string
+	UnhealthyPeriod string
+}
@@ -0,0 +1,6 @@
+package remotecommand
+
+type RemoteCommand struct {
+	MaxConcurrent string
+	Timeout       string
+}
@@ -0,0 +1,6 @@
+package rollback
+
+type Rollback struct {
+	MaxFailures string
+	Timeout     string
+}
@@ -4,14 +4,26 @@ import (
 	"github.com/giantswarm/operatorkit/flag/service/kubernetes"
 
 	"github.com/giantswarm/azure-operator/v4/flag/service/azure"
+	"github.com/giantswarm/azure-operator/v4/flag/service/canary"
+	"github.com/giantswarm/azure-operator/v4/flag/service/drainer"
 	"github.com/giantswarm/azure-operator/v4/flag/service/installation"
+	"github.com/giantswarm/azure-operator/v4/flag/service/maintenance"
+	"github.com/giantswarm/azure-operator/v4/flag/service/remediation"
+	"github.com/giantswarm/azure-operator/v4/flag/service/remotecommand"
+	"github.com/giantswarm/azure-operator/v4/flag/service/rollback"
 	"github.com/giantswarm/azure-operator/v4/flag/service/tenant"
 )
 
 type Service struct {
 	Azure          azure.Azure
+	Canary         canary.Canary
+	Drainer        drainer.Drainer
 	Installation   installation.Installation
 	Kubernetes     kubernetes.Kubernetes
+	Maintenance    maintenance.Maintenance
 	RegistryDomain string
+	Remediation    remediation.Remediation
+	RemoteCommand  remotecommand.RemoteCommand
+	Rollback       rollback.Rollback
 	Tenant         tenant.Tenant
 }
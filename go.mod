@@ -6,6 +6,7 @@ require (
 	github.com/Azure/azure-sdk-for-go v41.0.0+incompatible
 	github.com/Azure/azure-storage-blob-go v0.8.0
 	github.com/Azure/go-autorest/autorest v0.10.0
+	github.com/Azure/go-autorest/autorest/adal v0.8.2
 	github.com/Azure/go-autorest/autorest/azure/auth v0.4.2
 	github.com/Azure/go-autorest/autorest/to v0.3.0
 	github.com/MakeNowJust/heredoc v1.0.0 // indirect
@@ -49,7 +50,7 @@ require (
 	github.com/onsi/ginkgo v1.12.0 // indirect
 	github.com/onsi/gomega v1.9.0 // indirect
 	github.com/pelletier/go-toml v1.6.0 // indirect
-	github.com/prometheus/client_golang v1.5.0 // indirect
+	github.com/prometheus/client_golang v1.5.0
 	github.com/spf13/afero v1.2.2
 	github.com/spf13/cobra v0.0.6 // indirect
 	github.com/spf13/viper v1.6.2
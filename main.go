@@ -114,13 +114,32 @@ func mainError() error {
 
 	daemonCommand.PersistentFlags().String(f.Service.Azure.ClientID, "", "ID of the Active Directory Service Principal.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.ClientSecret, "", "Secret of the Active Directory Service Principal.")
+	daemonCommand.PersistentFlags().Bool(f.Service.Azure.DryRun, false, "Whether to only deploy ARM templates of clusters once the changes predicted by the ARM what-if API got approved. Can be overridden per cluster with an annotation.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.EnvironmentName, "AZUREPUBLICCLOUD", "Azure Cloud Environment identifier.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.Location, "westeurope", "Location of the host and guset clusters.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.PartnerID, "", "Partner id used in Azure for the attribution partner program.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.SubscriptionID, "", "ID of the Azure Subscription.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.TenantID, "", "ID of the Active Directory Tenant.")
 	daemonCommand.PersistentFlags().Bool(f.Service.Azure.MSI.Enabled, true, "Whether to enabled Managed Service Identity (MSI).")
+	daemonCommand.PersistentFlags().Float64(f.Service.Azure.RateLimit.DeferThreshold, 0.2, "Fraction of the remaining Azure API rate limit budget below which low priority calls are deferred.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Azure.RateLimit.SlowdownDelay, 5*time.Second, "Delay added to low priority Azure API calls when the remaining rate limit budget is below the slowdown threshold.")
+	daemonCommand.PersistentFlags().Float64(f.Service.Azure.RateLimit.SlowdownThreshold, 0.5, "Fraction of the remaining Azure API rate limit budget below which low priority calls are slowed down.")
+	daemonCommand.PersistentFlags().String(f.Service.Azure.RollingUpdate.MaxSurge, "100%", "Default number or percentage of worker instances created above the desired number of workers during upgrades. Can be overridden per cluster with an annotation.")
+	daemonCommand.PersistentFlags().String(f.Service.Azure.RollingUpdate.MaxUnavailable, "0", "Default number or percentage of old worker instances taken out of service without replacement during upgrades. Can be overridden per cluster with an annotation.")
 	daemonCommand.PersistentFlags().Int(f.Service.Azure.VMSSCheckWorkers, 5, "Number of workers in VMSS check worker pool.")
+	daemonCommand.PersistentFlags().Bool(f.Service.Canary.Enabled, false, "Whether worker upgrades start with a single canary node per node pool which has to pass health gates before the other workers get replaced. Can be overridden per cluster with an annotation.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Canary.Timeout, 30*time.Minute, "Time the health gates have to pass for a canary node before the upgrade halts. Zero disables the timeout.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Drainer.GracePeriod, -1*time.Second, "Termination grace period of pods evicted when draining nodes. Negative values use the grace period of the pods. Only used by the native drainer.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Drainer.Timeout, 10*time.Minute, "Time after which draining a node is considered timed out. Only used by the native drainer.")
+	daemonCommand.PersistentFlags().String(f.Service.Drainer.Type, "node-operator", "Drainer used to drain tenant cluster nodes, either native to drain them within the operator or node-operator to create DrainerConfig CRs reconciled by the node-operator.")
+	daemonCommand.PersistentFlags().String(f.Service.Maintenance.Windows, "", `JSON object mapping organizations to the maintenance windows disruptive upgrades of their clusters may start in, e.g. {"acme":[{"schedule":"0 22 * * 6","duration":"4h","timezone":"Europe/Berlin"}]}. The windows of organization "*" apply to all other organizations. Can be overridden per cluster with an annotation.`)
+	daemonCommand.PersistentFlags().String(f.Service.Remediation.Action, "reimage", "Action taken on worker instances whose nodes are unhealthy, either reimage or delete.")
+	daemonCommand.PersistentFlags().Int(f.Service.Remediation.MaxConcurrent, 1, "Default number of worker instances of a cluster remediated at once. Zero disables remediation. Can be overridden per cluster with an annotation.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Remediation.UnhealthyPeriod, 10*time.Minute, "Time a worker node has to be not ready, or a worker instance has to be without node, before the instance gets remediated.")
+	daemonCommand.PersistentFlags().Int(f.Service.RemoteCommand.MaxConcurrent, 5, "Number of instances of a cluster a remote command runs on at the same time.")
+	daemonCommand.PersistentFlags().Duration(f.Service.RemoteCommand.Timeout, 15*time.Minute, "Time after which a remote command running on an instance is considered failed.")
+	daemonCommand.PersistentFlags().Int(f.Service.Rollback.MaxFailures, 5, "Number of consecutive failed state transitions of a worker upgrade after which it gets rolled back. Zero disables rolling back on failures.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Rollback.Timeout, 1*time.Hour, "Time new worker instances have to become ready during upgrades before the upgrade gets rolled back. Zero disables the timeout.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.HostCluster.CIDR, "10.0.0.0/16", "CIDR of the host cluster virtual network used to create a peering.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.HostCluster.ResourceGroup, "", "Host cluster resource group name.")
 	daemonCommand.PersistentFlags().String(f.Service.Azure.HostCluster.Tenant.TenantID, "", "Tenant ID used for the Control Plane cluster.")
@@ -1,19 +1,39 @@
 package backpressure
 
-import "time"
+import (
+	"sync"
+	"time"
+)
 
+// Backpressure holds off requests until a point in time. It is safe for
+// concurrent use and its zero value is ready to use.
 type Backpressure struct {
+	mutex     sync.RWMutex
 	notBefore time.Time
 }
 
+// NotBefore holds off requests until the given time. When requests are
+// already held off for longer, the later time is kept, so that concurrent
+// callers do not shorten each other's backoff.
 func (g *Backpressure) NotBefore(t time.Time) {
-	g.notBefore = t
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if t.After(g.notBefore) {
+		g.notBefore = t
+	}
 }
 
 func (g *Backpressure) CanProceed() bool {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
 	return time.Now().After(g.notBefore)
 }
 
 func (g *Backpressure) RetryAfter() time.Time {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
 	return g.notBefore
 }
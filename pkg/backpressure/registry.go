@@ -0,0 +1,35 @@
+package backpressure
+
+import (
+	"sync"
+)
+
+// Registry holds a Backpressure per subscription and resource provider, so
+// that all clients talking to the same resource provider of a subscription
+// share their state. It is safe for concurrent use and its zero value is
+// ready to use.
+type Registry struct {
+	mutex         sync.Mutex
+	backpressures map[string]*Backpressure
+}
+
+// Get returns the Backpressure of the given subscription and resource
+// provider, e.g. Microsoft.Compute. It is created on first use.
+func (r *Registry) Get(subscriptionID, provider string) *Backpressure {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.backpressures == nil {
+		r.backpressures = map[string]*Backpressure{}
+	}
+
+	k := subscriptionID + "/" + provider
+
+	g, ok := r.backpressures[k]
+	if !ok {
+		g = &Backpressure{}
+		r.backpressures[k] = g
+	}
+
+	return g
+}
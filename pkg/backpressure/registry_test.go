@@ -0,0 +1,49 @@
+package backpressure
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func Test_Registry(t *testing.T) {
+	testCases := []struct {
+		name               string
+		subscriptionID     string
+		provider           string
+		expectedCanProceed bool
+	}{
+		{
+			name:               "case 0: same subscription and provider shares state",
+			subscriptionID:     "sub-a",
+			provider:           "Microsoft.Compute",
+			expectedCanProceed: false,
+		},
+		{
+			name:               "case 1: other provider of the same subscription is not affected",
+			subscriptionID:     "sub-a",
+			provider:           "Microsoft.Network",
+			expectedCanProceed: true,
+		},
+		{
+			name:               "case 2: same provider of another subscription is not affected",
+			subscriptionID:     "sub-b",
+			provider:           "Microsoft.Compute",
+			expectedCanProceed: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			r := &Registry{}
+			r.Get("sub-a", "Microsoft.Compute").NotBefore(time.Now().Add(100 * time.Second))
+
+			canProceed := r.Get(tc.subscriptionID, tc.provider).CanProceed()
+			if canProceed != tc.expectedCanProceed {
+				t.Fatalf("CanProceed() == %t, want %t", canProceed, tc.expectedCanProceed)
+			}
+		})
+	}
+}
@@ -24,7 +24,10 @@ func GetDeploymentTemplateChecksum(deployment resources.Deployment) (string, err
 }
 
 func GetDeploymentParametersChecksum(deployment resources.Deployment) (string, error) {
-	params := deployment.Properties.Parameters.(map[string]interface{})
+	params, ok := deployment.Properties.Parameters.(map[string]interface{})
+	if !ok {
+		return "", microerror.Maskf(invalidParametersError, "expected %T, got %T", params, deployment.Properties.Parameters)
+	}
 
 	filteredParams := map[string]interface{}{}
 
@@ -35,38 +38,54 @@ func GetDeploymentParametersChecksum(deployment resources.Deployment) (string, e
 		case "workerCloudConfigData":
 			fallthrough
 		case "masterCloudConfigData":
-			// These two fields are base64 encoded, so first decode 'em.
-			decoded, err := base64.StdEncoding.DecodeString(v.(struct{ Value interface{} }).Value.(string))
-			if err != nil {
-				return "", microerror.Mask(err)
+			// Parameters decoded from JSON lose the struct wrapping their
+			// values and cannot be filtered.
+			p, ok := v.(struct{ Value interface{} })
+			if !ok {
+				return "", microerror.Maskf(invalidParametersError, "parameter %#q: expected %T, got %T", k, p, v)
+			}
+			data, ok := p.Value.(string)
+			if !ok {
+				return "", microerror.Maskf(invalidParametersError, "parameter %#q: expected %T, got %T", k, data, p.Value)
 			}
 
-			// The decoded content is a JSON, convert it into a map to manipulate it.
-			var m map[string]interface{}
-			err = json.Unmarshal(decoded, &m)
+			filtered, err := filterCloudConfigData(data)
 			if err != nil {
 				return "", microerror.Mask(err)
 			}
 
-			// Safely delete the [ignition][config] field which is the one that changes at every loop.
-			// It is not semantically important and it can be ignored for the sake of checksum calculation.
-			_, ok := m["ignition"]
-			if ok {
-				m["ignition"].(map[string]interface{})["config"] = nil
-			}
+			// Add the new parameter into the filteredParameters.
+			filteredParams[k] = filtered
 
-			// Convert the modified map back to JSON.
-			jsonStr, err := json.Marshal(m)
-			if err != nil {
-				return "", microerror.Mask(err)
+			continue
+		case "nodePoolCloudConfigData":
+			// The cloud configs of the additional node pools are keyed by node
+			// pool name and have to be filtered one by one.
+			p, ok := v.(struct{ Value interface{} })
+			if !ok {
+				return "", microerror.Maskf(invalidParametersError, "parameter %#q: expected %T, got %T", k, p, v)
+			}
+			configs, ok := p.Value.(map[string]string)
+			if !ok {
+				return "", microerror.Maskf(invalidParametersError, "parameter %#q: expected %T, got %T", k, configs, p.Value)
 			}
 
-			// Encode back the JSON into base64.
-			encoded := base64.StdEncoding.EncodeToString(jsonStr)
+			filtered := map[string]string{}
+			for name, c := range configs {
+				f, err := filterCloudConfigData(c)
+				if err != nil {
+					return "", microerror.Mask(err)
+				}
 
-			// Add the new parameter into the filteredParameters.
-			filteredParams[k] = encoded
+				filtered[name] = f
+			}
 
+			filteredParams[k] = filtered
+
+			continue
+		case "workerCount":
+			// The number of workers changes whenever the cluster autoscaler
+			// scales the workers, which must not trigger a new deployment.
 			continue
 		default:
 			// All other fields are kept as-is.
@@ -85,3 +104,36 @@ func GetDeploymentParametersChecksum(deployment resources.Deployment) (string, e
 
 	return hash, nil
 }
+
+// filterCloudConfigData removes the parts of the given base64 encoded cloud
+// config which change at every iteration.
+func filterCloudConfigData(data string) (string, error) {
+	// The field is base64 encoded, so first decode it.
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	// The decoded content is a JSON, convert it into a map to manipulate it.
+	var m map[string]interface{}
+	err = json.Unmarshal(decoded, &m)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	// Safely delete the [ignition][config] field which is the one that changes at every loop.
+	// It is not semantically important and it can be ignored for the sake of checksum calculation.
+	_, ok := m["ignition"]
+	if ok {
+		m["ignition"].(map[string]interface{})["config"] = nil
+	}
+
+	// Convert the modified map back to JSON.
+	jsonStr, err := json.Marshal(m)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	// Encode back the JSON into base64.
+	return base64.StdEncoding.EncodeToString(jsonStr), nil
+}
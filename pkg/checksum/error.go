@@ -19,3 +19,12 @@ var nilTemplateLinkError = &microerror.Error{
 func IsNilTemplateLinkError(err error) bool {
 	return microerror.Cause(err) == nilTemplateLinkError
 }
+
+var invalidParametersError = &microerror.Error{
+	Kind: "invalidParametersError",
+}
+
+// IsInvalidParameters asserts invalidParametersError.
+func IsInvalidParameters(err error) bool {
+	return microerror.Cause(err) == invalidParametersError
+}
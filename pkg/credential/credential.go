@@ -26,51 +26,29 @@ const (
 // This means a configured `ClientCredentialsConfig` together with the subscription ID and the partner ID.
 // The Service Principals in the organizations' secrets will always belong the the GiantSwarm Tenant ID in `gsTenantID`.
 func GetOrganizationAzureCredentials(k8sClient k8sclient.Interface, cr providerv1alpha1.AzureConfig, gsTenantID string) (auth.ClientCredentialsConfig, string, string, error) {
-	credential := &v1.Secret{}
-	err := k8sClient.CtrlClient().Get(context.Background(), client.ObjectKey{Namespace: key.CredentialNamespace(cr), Name: key.CredentialName(cr)}, credential)
-	if err != nil {
-		return auth.ClientCredentialsConfig{}, "", "", microerror.Mask(err)
-	}
-
-	clientID, err := valueFromSecret(credential, clientIDKey)
-	if err != nil {
-		return auth.ClientCredentialsConfig{}, "", "", microerror.Mask(err)
-	}
-
-	clientSecret, err := valueFromSecret(credential, clientSecretKey)
+	credential, err := GetCredentialSecret(k8sClient, cr)
 	if err != nil {
 		return auth.ClientCredentialsConfig{}, "", "", microerror.Mask(err)
 	}
 
-	tenantID, err := valueFromSecret(credential, tenantIDKey)
+	c, err := OrganizationCredentialsFromSecret(credential, gsTenantID)
 	if err != nil {
 		return auth.ClientCredentialsConfig{}, "", "", microerror.Mask(err)
 	}
 
-	subscriptionID, err := valueFromSecret(credential, subscriptionIDKey)
-	if err != nil {
-		return auth.ClientCredentialsConfig{}, "", "", microerror.Mask(err)
-	}
+	return c.ClientCredentialsConfig, c.SubscriptionID, c.PartnerID, nil
+}
 
-	partnerID, err := valueFromSecret(credential, partnerIDKey)
+// GetCredentialSecret returns the Secret holding the organization's
+// credentials of the given cluster.
+func GetCredentialSecret(k8sClient k8sclient.Interface, cr providerv1alpha1.AzureConfig) (*v1.Secret, error) {
+	credential := &v1.Secret{}
+	err := k8sClient.CtrlClient().Get(context.Background(), client.ObjectKey{Namespace: key.CredentialNamespace(cr), Name: key.CredentialName(cr)}, credential)
 	if err != nil {
-		// No having Partner ID in the secret means that customer has not
-		// upgraded yet to use the Azure Partner Program. In that case we set a
-		// constant random generated GUID that we haven't registered with Azure.
-		// When all customers have migrated, we should error out instead.
-		partnerID = defaultAzureGUID
-	}
-
-	if tenantID == gsTenantID {
-		// The tenant cluster resources will belong to a subscription linked to the same Tenant ID used for authentication.
-		credentials := auth.NewClientCredentialsConfig(clientID, clientSecret, tenantID)
-		return credentials, subscriptionID, partnerID, nil
+		return nil, microerror.Mask(err)
 	}
 
-	credentials := auth.NewClientCredentialsConfig(clientID, clientSecret, gsTenantID)
-	credentials.AuxTenants = append(credentials.AuxTenants, tenantID)
-
-	return credentials, subscriptionID, partnerID, nil
+	return credential, nil
 }
 
 func valueFromSecret(secret *v1.Secret, key string) (string, error) {
@@ -11,3 +11,12 @@ var missingValueError = &microerror.Error{
 var invalidConfigError = &microerror.Error{
 	Kind: "invalidConfigError",
 }
+
+var invalidCredentialError = &microerror.Error{
+	Kind: "invalidCredentialError",
+}
+
+// IsInvalidCredential asserts invalidCredentialError.
+func IsInvalidCredential(err error) bool {
+	return microerror.Cause(err) == invalidCredentialError
+}
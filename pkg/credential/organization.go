@@ -0,0 +1,308 @@
+package credential
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/giantswarm/microerror"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	clientCertificateKey  = "azure.azureoperator.clientcertificate"
+	credentialKindKey     = "azure.azureoperator.credentialkind"
+	federatedTokenFileKey = "azure.azureoperator.federatedtokenfile"
+
+	// defaultFederatedTokenFile is where the projected service account token
+	// is mounted by the Azure workload identity webhook.
+	defaultFederatedTokenFile = "/var/run/secrets/azure/tokens/azure-identity-token"
+	// federatedTokenFileEnv is the environment variable set by the Azure
+	// workload identity webhook pointing to the projected token.
+	federatedTokenFileEnv = "AZURE_FEDERATED_TOKEN_FILE"
+
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+)
+
+const (
+	// KindClientSecret authenticates a service principal using a client ID
+	// and client secret. It is the default when the credential Secret does
+	// not specify a kind.
+	KindClientSecret = "clientsecret"
+	// KindCertificate authenticates a service principal using a PEM encoded
+	// certificate and RSA private key.
+	KindCertificate = "certificate"
+	// KindManagedIdentity authenticates as the user-assigned managed identity
+	// with the given client ID, which must be assigned to the VMs the operator
+	// runs on.
+	KindManagedIdentity = "managedidentity"
+	// KindWorkloadIdentity exchanges the projected service account token of
+	// the operator for a token of the application with the given client ID
+	// using a federated identity credential.
+	KindWorkloadIdentity = "workloadidentity"
+)
+
+// OrganizationCredentials are the credentials of an organization read from
+// its credential Secret.
+type OrganizationCredentials struct {
+	// Kind is one of KindClientSecret, KindCertificate, KindManagedIdentity
+	// or KindWorkloadIdentity.
+	Kind string
+	// ClientCredentialsConfig carries the client ID, the tenant ID and the
+	// Azure environment for all kinds. The client secret is only set for
+	// KindClientSecret. The other kinds can therefore not be rendered into
+	// the cloud provider configuration of tenant clusters and require
+	// managed identities to be enabled for them.
+	ClientCredentialsConfig auth.ClientCredentialsConfig
+	PartnerID               string
+	SubscriptionID          string
+
+	certificate        *x509.Certificate
+	federatedTokenFile string
+	privateKey         *rsa.PrivateKey
+}
+
+// OrganizationCredentialsFromSecret returns the organization's credentials
+// stored in the given Secret. Service Principals using client secrets may
+// belong to the GiantSwarm Tenant ID in gsTenantID and are then used as multi
+// tenant Service Principals. All other kinds authenticate against the tenant
+// given in the Secret.
+func OrganizationCredentialsFromSecret(credential *v1.Secret, gsTenantID string) (OrganizationCredentials, error) {
+	kind := KindClientSecret
+	if v, ok := credential.Data[credentialKindKey]; ok && len(v) > 0 {
+		kind = strings.ToLower(string(v))
+	}
+
+	clientID, err := valueFromSecret(credential, clientIDKey)
+	if err != nil {
+		return OrganizationCredentials{}, microerror.Mask(err)
+	}
+
+	subscriptionID, err := valueFromSecret(credential, subscriptionIDKey)
+	if err != nil {
+		return OrganizationCredentials{}, microerror.Mask(err)
+	}
+
+	partnerID, err := valueFromSecret(credential, partnerIDKey)
+	if err != nil {
+		// No having Partner ID in the secret means that customer has not
+		// upgraded yet to use the Azure Partner Program. In that case we set a
+		// constant random generated GUID that we haven't registered with Azure.
+		// When all customers have migrated, we should error out instead.
+		partnerID = defaultAzureGUID
+	}
+
+	c := OrganizationCredentials{
+		Kind:           kind,
+		PartnerID:      partnerID,
+		SubscriptionID: subscriptionID,
+	}
+
+	switch kind {
+	case KindClientSecret:
+		clientSecret, err := valueFromSecret(credential, clientSecretKey)
+		if err != nil {
+			return OrganizationCredentials{}, microerror.Mask(err)
+		}
+
+		tenantID, err := valueFromSecret(credential, tenantIDKey)
+		if err != nil {
+			return OrganizationCredentials{}, microerror.Mask(err)
+		}
+
+		if tenantID == gsTenantID {
+			// The tenant cluster resources will belong to a subscription linked to the same Tenant ID used for authentication.
+			c.ClientCredentialsConfig = auth.NewClientCredentialsConfig(clientID, clientSecret, tenantID)
+		} else {
+			c.ClientCredentialsConfig = auth.NewClientCredentialsConfig(clientID, clientSecret, gsTenantID)
+			c.ClientCredentialsConfig.AuxTenants = append(c.ClientCredentialsConfig.AuxTenants, tenantID)
+		}
+
+	case KindCertificate:
+		tenantID, err := valueFromSecret(credential, tenantIDKey)
+		if err != nil {
+			return OrganizationCredentials{}, microerror.Mask(err)
+		}
+
+		pemData, err := valueFromSecret(credential, clientCertificateKey)
+		if err != nil {
+			return OrganizationCredentials{}, microerror.Mask(err)
+		}
+
+		c.certificate, c.privateKey, err = decodeCertificate([]byte(pemData))
+		if err != nil {
+			return OrganizationCredentials{}, microerror.Mask(err)
+		}
+
+		c.ClientCredentialsConfig = auth.NewClientCredentialsConfig(clientID, "", tenantID)
+
+	case KindManagedIdentity:
+		// The tenant is implied by the managed identity. It is only used
+		// for the cloud provider configuration of the tenant cluster.
+		tenantID, _ := valueFromSecret(credential, tenantIDKey)
+
+		c.ClientCredentialsConfig = auth.NewClientCredentialsConfig(clientID, "", tenantID)
+
+	case KindWorkloadIdentity:
+		tenantID, err := valueFromSecret(credential, tenantIDKey)
+		if err != nil {
+			return OrganizationCredentials{}, microerror.Mask(err)
+		}
+
+		c.federatedTokenFile = defaultFederatedTokenFile
+		if v := os.Getenv(federatedTokenFileEnv); v != "" {
+			c.federatedTokenFile = v
+		}
+		if v, ok := credential.Data[federatedTokenFileKey]; ok && len(v) > 0 {
+			c.federatedTokenFile = string(v)
+		}
+
+		c.ClientCredentialsConfig = auth.NewClientCredentialsConfig(clientID, "", tenantID)
+
+	default:
+		return OrganizationCredentials{}, microerror.Maskf(invalidCredentialError, "unknown credential kind %#q", kind)
+	}
+
+	return c, nil
+}
+
+// Authorizer returns an authorizer for the Azure API clients authenticating
+// with the organization's credentials.
+func (c OrganizationCredentials) Authorizer() (autorest.Authorizer, error) {
+	ccc := c.ClientCredentialsConfig
+
+	switch c.Kind {
+	case KindClientSecret:
+		authorizer, err := ccc.Authorizer()
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		return authorizer, nil
+
+	case KindCertificate:
+		oauthConfig, err := adal.NewOAuthConfig(ccc.AADEndpoint, ccc.TenantID)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		spt, err := adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, ccc.ClientID, c.certificate, c.privateKey, ccc.Resource)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		return autorest.NewBearerAuthorizer(spt), nil
+
+	case KindManagedIdentity:
+		msiEndpoint, err := adal.GetMSIVMEndpoint()
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		spt, err := adal.NewServicePrincipalTokenFromMSIWithUserAssignedID(msiEndpoint, ccc.Resource, ccc.ClientID)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		return autorest.NewBearerAuthorizer(spt), nil
+
+	case KindWorkloadIdentity:
+		oauthConfig, err := adal.NewOAuthConfig(ccc.AADEndpoint, ccc.TenantID)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		secret := &federatedTokenSecret{
+			file: c.federatedTokenFile,
+		}
+
+		spt, err := adal.NewServicePrincipalTokenWithSecret(*oauthConfig, ccc.ClientID, ccc.Resource, secret)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		return autorest.NewBearerAuthorizer(spt), nil
+	}
+
+	return nil, microerror.Maskf(invalidCredentialError, "unknown credential kind %#q", c.Kind)
+}
+
+// federatedTokenSecret authenticates token requests with a client assertion
+// read from the projected service account token. The file is read on every
+// token refresh since the token is rotated by the kubelet.
+type federatedTokenSecret struct {
+	file string
+}
+
+func (s *federatedTokenSecret) SetAuthenticationValues(spt *adal.ServicePrincipalToken, v *url.Values) error {
+	token, err := ioutil.ReadFile(s.file)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	v.Set("client_assertion", strings.TrimSpace(string(token)))
+	v.Set("client_assertion_type", clientAssertionType)
+
+	return nil
+}
+
+// decodeCertificate returns the certificate and RSA private key found in the
+// given PEM data.
+func decodeCertificate(data []byte) (*x509.Certificate, *rsa.PrivateKey, error) {
+	var certificate *x509.Certificate
+	var privateKey *rsa.PrivateKey
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "CERTIFICATE":
+			if certificate != nil {
+				continue
+			}
+
+			c, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, microerror.Maskf(invalidCredentialError, "parsing certificate: %s", err)
+			}
+			certificate = c
+		case "RSA PRIVATE KEY":
+			k, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, nil, microerror.Maskf(invalidCredentialError, "parsing private key: %s", err)
+			}
+			privateKey = k
+		case "PRIVATE KEY":
+			k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, nil, microerror.Maskf(invalidCredentialError, "parsing private key: %s", err)
+			}
+
+			rsaKey, ok := k.(*rsa.PrivateKey)
+			if !ok {
+				return nil, nil, microerror.Maskf(invalidCredentialError, "private key must be an RSA key")
+			}
+			privateKey = rsaKey
+		}
+	}
+
+	if certificate == nil {
+		return nil, nil, microerror.Maskf(invalidCredentialError, "certificate not found")
+	}
+	if privateKey == nil {
+		return nil, nil, microerror.Maskf(invalidCredentialError, "private key not found")
+	}
+
+	return certificate, privateKey, nil
+}
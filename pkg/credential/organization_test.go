@@ -0,0 +1,164 @@
+package credential
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+func Test_OrganizationCredentialsFromSecret(t *testing.T) {
+	certificatePEM := newTestCertificatePEM(t)
+
+	testCases := []struct {
+		name                       string
+		data                       map[string]string
+		expectedKind               string
+		expectedTenantID           string
+		expectedClientSecret       string
+		expectedFederatedTokenFile string
+		errorMatcher               func(error) bool
+	}{
+		{
+			name: "case 0: client secret is the default kind",
+			data: map[string]string{
+				clientIDKey:       "clientID",
+				clientSecretKey:   "clientSecret",
+				subscriptionIDKey: "subscriptionID",
+				tenantIDKey:       "giantswarmTenantID",
+			},
+			expectedKind:         KindClientSecret,
+			expectedTenantID:     "giantswarmTenantID",
+			expectedClientSecret: "clientSecret",
+		},
+		{
+			name: "case 1: certificate kind",
+			data: map[string]string{
+				credentialKindKey:    KindCertificate,
+				clientCertificateKey: certificatePEM,
+				clientIDKey:          "clientID",
+				subscriptionIDKey:    "subscriptionID",
+				tenantIDKey:          "organizationTenantID",
+			},
+			expectedKind:     KindCertificate,
+			expectedTenantID: "organizationTenantID",
+		},
+		{
+			name: "case 2: certificate kind with invalid certificate",
+			data: map[string]string{
+				credentialKindKey:    KindCertificate,
+				clientCertificateKey: "not a certificate",
+				clientIDKey:          "clientID",
+				subscriptionIDKey:    "subscriptionID",
+				tenantIDKey:          "organizationTenantID",
+			},
+			errorMatcher: IsInvalidCredential,
+		},
+		{
+			name: "case 3: managed identity kind does not require a tenant",
+			data: map[string]string{
+				credentialKindKey: KindManagedIdentity,
+				clientIDKey:       "clientID",
+				subscriptionIDKey: "subscriptionID",
+			},
+			expectedKind: KindManagedIdentity,
+		},
+		{
+			name: "case 4: workload identity kind with custom token file",
+			data: map[string]string{
+				credentialKindKey:     KindWorkloadIdentity,
+				clientIDKey:           "clientID",
+				federatedTokenFileKey: "/tmp/token",
+				subscriptionIDKey:     "subscriptionID",
+				tenantIDKey:           "organizationTenantID",
+			},
+			expectedKind:               KindWorkloadIdentity,
+			expectedTenantID:           "organizationTenantID",
+			expectedFederatedTokenFile: "/tmp/token",
+		},
+		{
+			name: "case 5: unknown kind",
+			data: map[string]string{
+				credentialKindKey: "password",
+				clientIDKey:       "clientID",
+				subscriptionIDKey: "subscriptionID",
+			},
+			errorMatcher: IsInvalidCredential,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			secret := &v1.Secret{Data: map[string][]byte{}}
+			for k, v := range tc.data {
+				secret.Data[k] = []byte(v)
+			}
+
+			c, err := OrganizationCredentialsFromSecret(secret, "giantswarmTenantID")
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if tc.errorMatcher != nil {
+				return
+			}
+
+			if c.Kind != tc.expectedKind {
+				t.Fatalf("kind == %#q, want %#q", c.Kind, tc.expectedKind)
+			}
+			if c.ClientCredentialsConfig.TenantID != tc.expectedTenantID {
+				t.Fatalf("tenantID == %#q, want %#q", c.ClientCredentialsConfig.TenantID, tc.expectedTenantID)
+			}
+			if c.ClientCredentialsConfig.ClientSecret != tc.expectedClientSecret {
+				t.Fatalf("clientSecret == %#q, want %#q", c.ClientCredentialsConfig.ClientSecret, tc.expectedClientSecret)
+			}
+			if tc.expectedFederatedTokenFile != "" && c.federatedTokenFile != tc.expectedFederatedTokenFile {
+				t.Fatalf("federatedTokenFile == %#q, want %#q", c.federatedTokenFile, tc.expectedFederatedTokenFile)
+			}
+			if c.SubscriptionID != "subscriptionID" {
+				t.Fatalf("subscriptionID == %#q, want %#q", c.SubscriptionID, "subscriptionID")
+			}
+		})
+	}
+}
+
+func newTestCertificatePEM(t *testing.T) string {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificatePEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+
+	return string(certificatePEM) + string(keyPEM)
+}
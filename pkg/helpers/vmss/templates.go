@@ -2,7 +2,9 @@ package vmss
 
 import (
 	"encoding/base64"
+	"strconv"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/microerror"
 
@@ -33,6 +35,32 @@ func GetWorkerNodesConfiguration(obj providerv1alpha1.AzureConfig, distroVersion
 	return getNodesConfiguration(key.AdminUsername(obj), key.AdminSSHKeyData(obj), distroVersion, obj.Spec.Azure.Workers)
 }
 
+// GetWorkerNodePoolsConfiguration returns the configuration of the VMSS of the
+// given worker node pools.
+func GetWorkerNodePoolsConfiguration(obj providerv1alpha1.AzureConfig, pools []key.NodePool, location string) []NodePool {
+	var nodePools []NodePool
+	for _, p := range pools {
+		n := NodePool{
+			Name:                p.Name,
+			IsDefault:           p.IsDefault(),
+			VMSSName:            key.NodePoolVMSSName(obj, p.Name),
+			VMSize:              p.VMSize,
+			DockerVolumeSizeGB:  p.DockerVolumeSizeGB,
+			KubeletVolumeSizeGB: p.KubeletVolumeSizeGB,
+			Zones:               key.NodePoolAvailabilityZones(obj, p, location),
+			Count:               p.Count,
+			Priority:            string(compute.Regular),
+		}
+		if p.IsSpot() {
+			n.Priority = string(compute.Spot)
+			n.SpotEvictionPolicy = p.SpotEvictionPolicy()
+			n.SpotMaxPrice = strconv.FormatFloat(p.SpotMaxPrice(), 'f', -1, 64)
+		}
+		nodePools = append(nodePools, n)
+	}
+	return nodePools
+}
+
 func getNodesConfiguration(adminUsername string, adminSSHKeyData string, distroVersion string, nodesSpecs []providerv1alpha1.AzureConfigSpecAzureNode) []Node {
 	var nodes []Node
 	for _, m := range nodesSpecs {
@@ -19,6 +19,37 @@ type Node struct {
 	KubeletVolumeSizeGB int `json:"kubeletVolumeSizeGB" yaml:"kubeletVolumeSizeGB"`
 }
 
+// NodePool is the configuration of a worker node pool deployed as its own
+// VMSS.
+type NodePool struct {
+	// Name is the name of the node pool.
+	Name string `json:"name" yaml:"name"`
+	// IsDefault is true for the node pool made of the workers declared in the
+	// AzureConfig spec.
+	IsDefault bool `json:"isDefault" yaml:"isDefault"`
+	// VMSSName is the name of the VMSS of the node pool.
+	VMSSName string `json:"vmssName" yaml:"vmssName"`
+	// VMSize is the worker vm size (e.g. Standard_D4s_v3)
+	VMSize string `json:"vmSize" yaml:"vmSize"`
+	// Size of the Disk mounted in /var/lib/docker
+	DockerVolumeSizeGB int `json:"dockerVolumeSizeGB" yaml:"dockerVolumeSizeGB"`
+	// Size of the Disk mounted in /var/lib/kubelet
+	KubeletVolumeSizeGB int `json:"kubeletVolumeSizeGB" yaml:"kubeletVolumeSizeGB"`
+	// Zones are the availability zones of the VMSS.
+	Zones []int `json:"zones" yaml:"zones"`
+	// Count is the number of workers of the node pool.
+	Count int `json:"count" yaml:"count"`
+	// Priority is the priority of the VMSS instances, either Regular or Spot.
+	Priority string `json:"priority" yaml:"priority"`
+	// SpotEvictionPolicy is the eviction policy of Spot instances, either
+	// Deallocate or Delete.
+	SpotEvictionPolicy string `json:"spotEvictionPolicy" yaml:"spotEvictionPolicy"`
+	// SpotMaxPrice is the maximum hourly price in US dollars paid for Spot
+	// instances. It is a string because ARM templates do not support
+	// decimal numbers as parameters.
+	SpotMaxPrice string `json:"spotMaxPrice" yaml:"spotMaxPrice"`
+}
+
 // nodeOSImage provides OS information for Microsoft.Compute/virtualMachines
 // templates. Official documentation for can be found here
 // https://docs.microsoft.com/en-us/azure/templates/microsoft.compute/virtualmachines#ImageReference.
@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbd5973da48b438fe55a678fd3941089309aefa3f186cb618328091906edd9ad26624682da30510b7e6bbffab5bbbd46a49d84e32337e7082a4defbf4e9b39fff6b69c68be9b4eefeafb5d35cd5133f4ba6dede6982e13a27c1d6dbc2c5b3954fa6a5d8826bdaede32d2cfaa0d9adbb565b3575a57d52355771da27d33e38962029b91aad9bd654b74cdbfd4370d5d65ded5e6e5a0b41575a772d5dd08cd64debc1945a77add64deb59b0778a9bef7e67b645cd28f6bd32cd4259c250e7822ba9adbbff697d6efdef4d6bed0a4069ddb9b6a7840f2b45704ca375d772e0d36fb2622986ac18927ff75b6a62f7b0d9f6cefc2478ae692b8edb8e7fb866eba63536471a501cd80f9cdde71d7c691d768a0c7ffe6fb462a880641aae72765b37ad171dfe5b73fdda2f40d835286e1d766dcb36f78ad4a41347b18f8addb0822629a535744db24dc5b6cdf246519183e6b62553d70543ae2e58314a540e98bb1da1cc51b11dcd3444cf90416ef4ba601f4401c215dc419bf811feab19bbb6aee8d9728ef5d2e9b68f9a851ad005576ddbc1dc5c4d5720483c28168207d17bd120b888beab38ad9b9664ea96ad380edc705749bfd85d340b3d1baea0198add561521fb02688e1bbe08404cb27d0b4168f0a32d045d040f9266a96878e1b39cfe283b42f2a048d94799eef53afdc28bb666b88a6d08a0adc827c1969d7c310034cbd5a4e48daa0ba9a7b83a5c2acfd500e693e3892edab1f0832ef792877089a327e936f5909e80a30a9dcc13ddfb9279ee75e8d473ae4b17a4d6e9dca3fad9a7b675d0cead9b962cb88228384adbf90bb4655b0b4056312453d68c5dea675b708c4efa19d6fa729b79a31982eda7df48ce31fdb833c5f4a3aa9cd38f7b88e352cf1602d7f8f1acc39546a714ce4c395b47018e354439053c15a0c30019589aa500cd503eedcc76f4bbbcb4231f3ebd98362c1c620e071e7bcb7395b6bed3dd364d75fa9fa8df3f519de87db3c664c3891bfafa89eac18664c369d688a1b8f04a498da8d3810d85ef9b35662b8ee9d9f0576a82685cf197660d3aae690bbbcc7addc2e6c2f7b51a0bd11fa16cd0d827119822ac205ce02f5c79dcad58b75c5b900550bf30fcd4ac347c541b56918056bb862ce001b49c54a857f428004d16dc922d4a57c1dd73c552ae2d4801d249159b0b8eabd8ba66c84edb51f4a362b78fdd6c1151316de3f7b6a5d807a7fd972718ae96bf2e25c53808c0d5daa2201dcc9797fc57c7126ca57d3eab82a3b68f74eeb3692b2624f63e850308fecb169285a322ed5454ca524e6df84faec4ced6f602380aedfdc9fdb4cbadb3ace9922a18cec16f7bee8b68e6ae6ae52818967a4648f29385e8c5ccf717c7305dedc58f7f94934796a69c5dc580470b1108f08dd3162c0b6812dacdf6b123002bb87d1ab50117d7b3aeae0ed7f9eacaca59d02d707d7dcd78b105c7b53dc9f59261d04d9bb16cf3a8c91048af1c87ad0005dec7d7d6774d13c0ebf28afa12d014c3751437c2bc4a39995bab76db9154452fa7bbeb35e2fa9622bf163ecb1bbe1e68cbdbbc1692cb5b7c05789737fa7a982f6ffb3507a1bcd5579c8ef2465f7364ec4647c496631ea101fb1a8cbc7185b6a318b2ac486624667827261e16af64b1c9d5705453c3ca6dd5745c0978905a785d43baa3bdae0157d12d50a0b9ae6da5edd9578f47331c57000043a35dd7467be71508e7d7b4d4d62c417fe3e6f00cd095cdba8a21343a7a554db50f9ea8d886e2e619aab76a15a29df76b19c3aabc79f3f1d5f1a3fa699b9a2c5ddbd9eb20241c9db633b4d79cd1b019c769b237f04682c40f92db3543bfe8325315e9e0784d8e6f70072ab262b85aa31b105654156029b6d33eea4e93a383aabaae154aea9a540382a834ae634a874690fb0345e08add560cd932b546f0dafc668fa052320dd73601b846569faadc16812936a68130ed48c0f464c9345eb426e40daea1f86722c47e4573b2227a443d40ad56140389775fdb4c22c3769b9130a4b6e09945f8e28dda83f7bb625ba6d9e478621a3c280439499d0622e928825153bce214973518eb4b3cfb95938c9b94150b98befeea738469f01a9a97d8b2e1d890732170578dda0b0f87661a6fb7e5112e6d721b91da43648bf14a4c5b6ceeadb7a621c95ed55472a8afa20c48cdeb4874fc56db13b6f6d6cb6908ba82cc02dea8bd5042f266ad053f76b6e9596fd4e65b1014496b01e91b0a77dea8cda3d58412af68e9ad01e668199269188ad49061c0b4e928ae5b50b8346d249ade2b173f6ee61a66286aaf8af5c76a7f52df25c5260051a07baee2f894b365daae6207c62200281249fa46c4a687af4e05dd1b9740bf6cb949715b71dc0a6a38531eab8ecd178e29ecf6f10be26d2a77135fadf2d040861e325ba50502a39ef08e8e2febb6aa08c0552f4dab554d3e5b2d82c7669d45b5eaf5f5c6e64a61c1b62c287a55dfc5f2649931a952bb02048975ebd85891ebb6154310f3dae2ab9a08d8b857350434c755ae5bfbb809f3754308cc866ad6af05a8b0423de8a8bb9b9563ac30ab4b95092d1374c5156a154ffd76499270540e6beb922a14dd60c19256ca4af0c56bdc31a51591eca2fda21902d02e8aed1c14cbad9268543406b93643d28086a4af1264408022bfbad180f679a3e63c4b165c4500c03c356b2b2373ad21c8af519708cc75ea9f8ab620252d44abd8a8705bb2bdf2db035be3640b969592d65c575b575c5b939ceb061d35622baeed5736610b866cea07c52fdf09c7155caf7a30113342d642e64dac529f0816bf3bf3139c23fc2b91e226058ab740f2cdb505c381246a750924382f1403e6ee45477d146dc3d1578455f3d3373f39ae201ddae8dffc37d17b791180d95695821ac2dc996dcb365d5826f8412ae098b68bd9481308c6ae8d9858499054a50d6c0f5b84dc53ae88eb5b85c38f2dd3168cbcd0095f4ef66c9cae165fd8d574c57105bdb043e60e20c33a49b7da52e5e74400236b05d6a8bc30c44785c99797f60c1cbf5a5ee12800af000a61e917ef9227a9834f9ea7e57115fa800ca37686e9b89ad4fe6e29c6fd1fd3bc911fa6a464ea9656bc918b0513338f7c515b034068ebde39fb41151c55934cdb0af7f653011e7125da8ea65b402194552540f884fe843cd5522c6209b6939f35a6948d36abaa9823098651a3986b4ba671ac2ae69a873c819c2db4774ca3c60450b11a4343e5309d6aba2cd89ad9d6153b6fc8892c343537144ee4bfeac24e934c43d06c789e2dc576b53c0e69e4da111f185db0c8ed24455de1a098461b5b54552ce18d9a69bf68a0287fc5fba6d429d35674316f9158d7d3a5a49ce3ca66617cae6b4094a429966d9efde257d7b24d4f0ecfe59f1156fe336deb2516b5cdbae64aaa0200b210564d5d91b5fcd6c625e05e465679b932a6acd8c6a79d098959c9b3ed82b42729612b2f9031c8e1394b8074d5414773449761eebb0280e26a0a04dd4faea9e7a6611d76edd80723fdde3675c55515cf09adcffe4c6eadf043e30a2514649d9af067917829d684ab050a87345dced4218bac9cad02b1532c151f0d5173454f3a28ee67d3deb54fa7f6ce143cd734945d55136840a5852cdb945eca1732f89cba75eb172d026ce01c26bc28b659faa1cca54c2adc32e17b53b405dc87fd4980b8e2a408aeaad8ba60e00a59453142d6832dfdc1134d4773cdf6ce7495e05e31a3bbcf6ccba614fc486e0a337d1d9809ce47a085b6f1dc0efda7ac83e420d7abf28f292f31a9aca4e3a8edc0b52180f07411437163765572cf1128e78bc0f7e89f9d17c8ad71050afdc71fdaaa1512e3f9cf9a6c08b8f79627024d72bc9717e43d96f90e415c2d7416bccd1ce5f467c737248850620553f6a3d3f68c624f68591c45f26ca52d6ab2667b01b3542883389917d3d6b15f3d43934c39688258c0c0b5a0e94adb0e25d4a66eca1e509ccf67ff820800e4a1113a9298d661f7593362cf8ccfc74efab566bc7c3e52d9375aae8cad386ebe9e2fe8e033ea01922ce17f6dc996bae89d8b3037fc2f2d4b4f3094ef2a02d8a55f4996977e7cd15d27e012e35740b8f8b6b253ce56faada1b8ae1d2830e377a613c9a7e357960940fa395f25bcae80961da8a3193ba0bc006da766c6e2f88e2400d056ce8a141cf0c2a7107412824571dc803346beac9ad9d6cc10ef1dbe3a9f3533300d9475cd710237035171913938eeb3adec34c7b5238f847aa5700d5a9653ac1fbc2c2f0ebfd0b92f9eacb9b9fe6283f64c3957550c3771a6a8f88c1b84e7aaa6ad5dca5b487fc53760c24d0accee09dfe8aaca346625c4e0f475f06f312d869f68dc724990467f81cba1946c89649ab6ac19f8c5c87dc45647be17d997b2e648e651b1fd924d548e8ae1968c27458962bf875a52dcda673ee1aa4274881f51f80953c9328126f9f86fb628488551842f71bd849f302d3992aac81e16a2329f70ad660ae0da0e14e54e59f5d0d9b5d06ffc9e5cabd863b2859f044b0b7533295f3d2d2372b8aa5e7eb4cdaa361872a8414efc6fe25fafad9ff897bdae99c8c3ec9ad569dc6c71e574415235039ef570b9dbca5f9e0034d7af2a163162a442a16e8b542425d7261473505331259728ffaaeb1c2b261c176b7b46ccf9ca35eb542da8641ad5634d0ab5fff214dbb7045bd0494bfba22940261540d6e3a402b667a0301b95250230276d625c50b1b54097d7a870147aa1418d48ecd1ac96ad48e6ce683e3ec7b515410fe4440daa853b4aaee72820b6a52a2b1349facbbe432ab21d0953c885429b1562a150104f2c5379f651a9175bd0898b8d4a6986ebb895a52a800495d1157ba744eee9c49246d57e06a5729704a648f53142c51cc5ad5c2d44382b3b4daa358350db402c93099150b364805aaaca9f04ad7269208b4828538d154f84757055cd96ffb404dbf5db2fa67d502291280950ca2b85ec5f522fb8473fedcc8416c67ef4212449b84f190d3de16b818cc0160a6ff34af6ef8acab91b8cd4429e59ac2edbb8f11c4345aa5083e324562f65449bd46a30c152b6b541a546dd6199dcba55f2bc6fa37af576b0c829d7285c7f58657c35a10a99dd26552ce3c2ebd769d2598e67279425b1f2846a651c3ea90a81cf20542b9107d4add1a023bcf4a0ba46fd2eca640d842a05114475d90633c00b2c08154ae418756b34181a49ea41aa562e0c21d5c2c8486a15bfaa8fd2d924c186d0abec65d2b8027178553589632cd066a9cfc0db69465af880bcd995b382a582c25067d8f779022ffb55474674b8afae6902278a0550f235c70e153ea36749976b1409631ad429d6463e24d809e34ae3a1312809144156ec2277482e170b53b28c5ebe5268de585ec01276a4b58fbd2389dfdb59f546b1d08b622b8684dfa6949d60e163c0cb2aa48fa661d8a65b7aae02f61498a7d040b7b44c622b81ff7e50fcb25906fca1e2e23987808d32edc35f9ee2a596e010e888a2274f44be46069264479c55641e181682ef9cb6e8bdbca4f72c78ab19aeb22bbe46069cc5d791262d180330776dc77782075d40c70d856915b55df21331b6e9e8ad7ac00fc3ffdaba075c0d325cd18bbf3cd3556464bd157a3e044c76a4154efd84f4d14153f628ce6646df1c0d337e99d2efc6ef2ccb365fc217ae7276a34583cf81573332be319d0877994edbd176412ba6d3f602d5bc15cc1bfed77ed180123e270c63ac920c7eb41ddf7085337c8e450391704842a61ed153e4b1113dc79a42c135039eb2f025dc84c27b07d99c46afa39947cfd17239dacef91c01626c98fe29ae76d8b5454f0341ec931a85231c5bb7687a9bead489bcc96a978548353c8d75eaa49d1eea954feec51ae511095dafa82a1872ed71246e5d350a27faf586b3c5544c5d1b4d1a08f047931ac1f55177a4859bb24e9dba63d20523bc0aeb146eb23e96adc888d1ac57dc165c0568bae6d61d4ce40b53b7fd466b9e60a030e8448d3ab196a776d98608e3a488aa691e1a154ea462cdaa35450651bde45829b68be8dfba4b5e68010f6da1d435349e490c9b1dd7866c13fce51b52f85f72d584562bad9b5660ba2488273b84b5e04562c593790e6cd8a298ed37add06829f9d5f6dc97ce97ecf357f4e8082fb0dc51316464aa5cb45193544152059aaa57ca3281dfe952bd8ad2e83fd177abfa8ecb45b1c749853dfba84491dc09e5d483fc422e510ce24e285c316348e9c8060af8ad2b8e1338039615cc1bf355968bec934905131bbfb252a1a91feeb3e33ba15518ee6b89155e69d1b4315e69a19c4d5e6539649af7bf37ad67c571e30417860740f02a4e6911bc9a2333bdd6ddffb51ae705990b9a1125e5689688646cce4db96ef9f6cefcac9b32aac684e8edaed5f9dcb96dfdfdf7df37ad976086b5f3a6dc350de0d346b941900ae72e48d302ff971557d000ead84812a47c0e353d8e76515a77bd3ef53b75d3d221f2b9bba5839f7f22ac74d7a2299afa44f53ed19de7ce973b9abea3ba9fe92e4df7be74e8dbff47d17714c4319af32764f5ee5e04e0280825a2bc2fcab175f7a547d1b737ada961b6eebafd2edda7a89bd60268c6a175d7413babb4eebaddced7af37ad8d26b7ee3a142c31863ffb5f7b37aded9f7f5a824cb5eea89bd64a864d5237ad757ae403700866724bf5bfc047533a38adbb0e9ccabdabe9702c6b456add757a7d8afa9dead3dd9bd6c2816fbeded274af43f57ffffba63527178d67fdf74d6b58bfe8f6cf3f3dc37314b975f73fd40d7543fd2f82077879354b8d734544a76b52e95cd14d987a27f5a6987ee71d93eb18a6fb5b183a52917f133df737e128680032c9bf69c66f8831fb2de2b852c974a2f116b3e8e4535b340be790f7037a9b742c1fd9577ea1ec2b6f9567a55e2695c8628494d2a934b608c147f7bd1cd61ab886458e5c3853f8d7d9bcff1bacdb7f9afcb08ea8b09664f0fd6480af96f9bd82cffb60d83e18b60f86ed5fccb085880b0effb0bb260de52bb8b7bf83e46eadbb96723277d3e1f420d10b93673bea746f6ac264454993f99727bf7fe1681588eca327b2238f636520b28c270f7baa62303ebfeeed459a3a467525ffab3f7f78ec3c3ddf9fd11fbd02d2f87c54d80e108dd586673b27713ca2f875ef2f89ee7b537fea0cb5fbdd545f1c456305cbb0fc767611d8be07c7315fdf9e9ef6f7de7c18963306aaa48f5c7ebb029231d7be1bceb7e9f07e371dce3471dcd79674df93c7234bd419ff19965b4fbf04f38365ee3579024ef0ddd45850920e3c3eea3f6803b6abf2e311c565ebc16f077ebbd84b3a38c9637014b5e997e9018d5915d81e90b481c7b11d301df347491b50c278b31358d913b62b4ba46fbde92e6a6bd00ffa1bf49feec377fac291b70b8adfce66abe13b8e17309eb01d75f8f560b61a0e8e3c1a27b84cc77287df2ea8e9381ac7943cde836c716c8fda746555d23b1b8e952fd3bdf57b6a5c94b21d00b87f223dfb8b6717d4543b7d8bc7a6af00af8f3ae264c572ecb9c317c77e92f43e2db02b20a175e66ee78fcbd37c333f3d3dcb83e566ee2e1e96d47c48f5be3f72f4d3f3e6b4d83f76e6cf8fbdc57e36580da7715f2fcba0dd9775d4ffcc12754009ecc8d94c6647590708d604b66794cd41eaae7cf4bd620e530080f4aafd914d7ebb32f9ed74278f55301da7cfce72276ce73b2935669e5d06e5263c908cc38ed7812f75e13be0f2eb5b2d1ec784fa16fe1f8d0dcee928e92383df8cf61cddef88c6ea3b82df466bd001f27874e0b62b355b2f581f895ef8c2764045677913f5b91ea81cbd38ca6c8f9a8e7baac86e76bcdef7a79395c9af07164ff7ac604dc8739075e0cb1346151f7b409ec8474977df7d0e4cd4e770e0f1db5557ecceec5977be1311ae89ced466c7771900f782a3cf1da98be0980493b4b09d51323b729e758692e9be2f500b8b9f4c53f80de2be554f1a6fb4efdaec224f201c4b5a7c2687f79ac83214c7ae5479fc98ad37bcd7f83173e1ba334b9a207c04dbd8c0718aecc812b5c1c37ab35a4fc73347a417f674320312cdf8b2ce1c127c062ed3096f49c6aac3b1a79d188dd3afdaa31e901f65551e335d6e0b9e657606d72ddc27735781eb0aed71f4c811d8c571d365343886250d0e1047346d4764471779ccf8af6d07ad01fd06ed1803efb56d08e3fe457e8cef9207815df99567615780455b66674082fb36662e4b6630dbd0cc5edecec066dcf7e5b2fb49079e3c2c8e891ff72eacdef7f8edbc0c968f9c6e01aebb7c352caf1e7bcfd389751475e612c26d662ed3f1e824ac07aaa82f7770ee92ce5c243cfc7e4beebb19cd6d6716c7ce9cec1c662e17acf352d2195dd8eee03816c1bb69321703e125208181cfb3bca50c119e0fde219c10f7e3f3dbc551decef6a81f10f63349cd5d1f9d0406e2fa4538ef29a4b5e8a7e7fb1ea4b5b2780de155842b7876d1917440294cdf90f47e479a2c5e249aa1e4ed7d66cde3bd3c742cae3b3b4a745f9787bd756a5c477ecc9cc471bf8768c0c93c531fde4dd298f1e531ba07f3f71d50260338477477adb6bc2a4e1815e1f9433fb8038d797eaf61bd0b47f78371a1bbbb97c1a519ba24dc17915e211817d8de5ed21997677b14c79eb45c392aa145f363bddf4db55544f7c23534e5c9ea245dcce35377b117c68ccbad7b2ec7f6549e0eda7fd2475fe019798274ec3aa2994797a7cbf4347fdeb8f38747f8975dbfd10a88db81c36d57e049b72e227dbbcfec6130cebd48f728d807a333be4487b4c2fed19b0f116d9c9b1789464edf6df19df91cc3c746f6c52e7392a8d1729d86335cfd4ada347b8f1668a53d5c17ce9d3f1ce0ba14e750493f91ef6988b702ba08cd61276f17801f0e34651ddccfcf2cd84b7aff22d23cf57488d6c239c6f75a6701b82ee30bdb556fd59d1de5ede012dc8bfd8ea42fc0530606ef73b472ea2fc185d93d9d30e8fe596f964dd6b7502ec1dba9bf4afa3c0d2b443c9ca557595e95d933c5e8234786b8053fee37a5dfaf58db9af43d760d707415790db4190ff1f55bc32f43ab00d2f6112f99c11bc38125f983608db710be7b10b6513959677c653dd085edec22c3776ce7b5b05a8f367d0bb8d517aa38067bb1b3e870886e7caf7e3a2aa2c9c13bf713dffdef3d1ff52875570f114ec1d08fe9fd2ca501d3b08181674de8321abf45f43b08ee880047f2cce0281935f0589a464ccf696956ccb18c06c3ee691e6f47bc86c6d28ba3a82f5479a89eb8ed4c853426bf9d5dbe0de5343e36bead4fbb99df0969b959e17e9cf9402eded3c43120b9ce14b8098db701876f437921b00b5fec2e8ebcb1f4e2f3e5f763de32a4bd2d79022601ed36379e8683f47a98339a3cf7997fb0b66bdcdd3c437de0e618c9cea6c085f4c79edb2e4d693cf2617b709d247f67c4bced013ccefc83331d4a2e13f143f9710fa5c6634077a33613219d2e76199fa399193fdc19d7dcdd33ffb493f5912fb0234d1c3370cf2be62e59df180a87d7210f7a80f78ad89d2dc5ee8c82b0b57878ec2d86d8f90179bc3844bcd3743fbd2c2e076cbbe87cb167208fc2f3a44dcff38769678e6fd78374ee6aab9ee018201f128d65fe7c7f993f60e8c2a80f6641419af39965209ed94b9d747ff3d37c9d3ba3c57b414bed09a42dc4e25d52810bb23cc6f708de9f68025d5a4a5fc6bc8e98db63b7ec0c477b8c87491caf3513f3f04f8237487f26f43aa99ff391a347f1bdcf76131a3368ebdefcb6ee3922bd08782580854984d789745ec89fc93a3840fe14add5b8cf48930190b4023e9c08ecc893478b2eb79db9cc786448be643d19035fecf2163f667c783670fd60efa74ade307d67266bb9311827c30b63fe4adb09cf4884fbf931a3735bc6911f81c7e9fd8ea8afd6327b06583c7ecdb8e3fd2cd272aba8efe1c0477d4e5626b75d429ace13582497f094f5c012f5d951667b87e964e5732caf0becdcab185f446b5322bcdb207ed1cc12f8c8ac717007a17b7bb5e6d89e81ce649dba707edd9525ea01cc22bc369aa9a22ea375fea695c0797a4d43f85eb2ab0392c17452b2912bc6707d3ba33dc45f705fa78715100d2c5ecef76d493ab34f7440113c95f14de9751ff802db47b01ddc19f7bd0596368ffe3034fab01427a7be959f17245b8beef6842fff43deae34b1cb78fc68d611c79b8ab920983bf1db1985e0b71ce7c47d6264768f112f359da03e77f25855e5e14015c7e7a3dc9def38b6d79b8efb1d79fc712e7edeb91839e2b8dfadb1de07613b035c37dedf04a6b0f44b6eee90efee427af100e9106afe70e814f9920cfc978ea7e40e427fd7dc17cb88ce05bfd8d948cec34ea601258c8117ead042ba67ba93429dc2531df883f4c1b8bf17e913dc83bf6aec79440322da73a38f3c9eded4ad7be0b70b4a607b215d73c5998bceeb6674e0273320751769597c915ec3ea7c08b4596e8fe431f038a4cfef0df8f10ac9b2379319e075701bd06e19b9ab39eb46fab2734cdf36e99363177bd8079267b26747ec96c9a53238e06def27ac2c2efa7be7fbc9585c84c7ac6c8319f763be3cd425fe303a2edc8350370fc795d6ef0e5c8e5d98c816c41f50a20f71ee682f0c4b6d2c76113c4e27a17c68785b6b8fc4eeeac8d17da7e9bd72cd9d9483fbfc39f7e7afbdd7809be73d4cb1bba0629c7b403233a384172fee59d5fd57eccfcdc113d29b96ca78b2384ce5e80590ba73886f06083797f1a89935053ecf2e62b85ab3bdd7dc93979f794f72db81f53ceeabfce34c15c7238fa3191ff16d55f80dc1f10048c6ca2a95e965fbc4d85f85b409e49fd8bec7aff3e76eb3cbc82cfdc160f378684e3b1aabbd54676fdeed9ccdc44856fb6d92bb6368b7c3e98c0fef337ebb186c1e4128eb08eee472d95f8333fa56b4e7bfebec5dbe3fdfffdcb387ee2379248f1787fc19fca1f7e164a6f23a6f71fe4097f4be3b7d3c0fa793d5713a0ee5d2dac0128d05c5b1bd3dbf6e7ec7c9e3c5e9a7d2a2c0cdc9db198aa357e1f9aa05abd1d8b074e3726bc1f33a17e9d161a98f1cb44e691b928f33973e73fe4fe50bb7ab3dff783e72ec6ac8b16755d417215fc84c21ddf223cf1db2f71a0e62dd153a739319807468c80722ddc3748cc6abc5e3d53ece6025ef46e374aaefc82bd2589d744dbaf74de435b358fe5c8b3e0247a44f41b63cf3defce111ab0f88cfdeb26c0e585d73f48d2c3b49f43e007707223a7413f35b3f426e13e2c4f4998c78bb226d8a70c970e040bccf2578ff1f499fe6f46e299d67bd33d390472cb349f9e013a3f52cde9ba75f8056dd463af7600dab64526f7a2603fba8d03e01d2a61cad5ac856b8786fc6f253119ed731f0f8ed7c27d2b7a1dc15dd4d970f99eacec0dbbf7fc85493f5ccca54e797dd69fe3365aa7a401f2c737e143f926e95c6fd435146ba49e4a1e9b339ee7ba17e1cd9b948fe3f4e46dae4ccd7a415b17692ef79f7bdf2befde9f46977fe70ff33e9d3406f472d547ec25b12ddffa1f299029f381af822dd41f6c5f276be93c75f7792cea81cbddb2d13dbb62beeb719c575b17667b9f3f97e7a76b6cbab920e544e3f83c046316babf7c12bfef4b3d8f935ce22929b3b3f980645baadb40e7033991d219e15c64c2777273ec9ec0c8863e6aa3befd793d744ebfd21b3f975cee14f95d984e7b077e4c7cc4809782a2055dd396faa372ce8f09792de77031ffc1c5f3886e3dcb87c626ffdafb81f45bd7fe037ccad04cb8d19ff1d65361f7a7d329c64e4358bd7e8394aceed16f71ed30e4ede53e54b74953f257e1f125e357f8774637faa123f97d2bdd538baeff16390d8d9d3cc19f233925fb07d2ff51f0c7c5c649edfce09703b53a531c8c4b029de1bb57d6f49fd243e015adeb72868ebdbf0e089e3fe3ebcd34ad74646f6fd249bfcc4ee3f98cfeac86c07277e3b2df804ad68a08ac6ea59a647bec8ac54be3b37bead7b297f502063e1ba4cb6511bdfa7fddc913e0eef9712f7d7d8463abe6b7f9c7d4bd4f77227a13e0794301e51d3f10a883ae40b07aa681c76428c670694a48f745e07fbff9e6cf28a311cae6e277d3f3cf26caff76369b7e5e9fb33f73369b7d80ffab9cb50bf8a3f4da86388fc698e89fdf9c7b9f809e7e26aba2981a92b6d96f3fe8b39f8ff413e3533f110c7e2f9d5ce467c1ea613d992c7aa256a39fdd83fd726f9ede5ed5dac1fff87bc3da98bb99f963ff37eba48b43acdf09b60756472baca1f6ea71ce8960b32c0b48e392d8b87e752f20786cc32393baf287ed4e012ead1ff71fe70f37d0dfbc686ba685cfcb39afc7e8dfbef6d75df3f5b0f0defc93926be48fa1cbeb31e1ac5cbcadb6cadc78c2a4e56e692560147bb653282b8bdb7d4930576b89b1d1ad37090d8776a039367478e3cdeedb8705cff3d3a723640f17e3739dbd7e6f735f6cead73efff0267e697b0dd78cef931fe78dfb7d8ae9fe0f356626bf561cff1c67aa4ff227df9ebd87364ec70aae4fc6f4a4b0e8efca4402f82146d983e9b47510b648548cf33f90fc64f289e813dc79e2d8eee5fa31fba8e5e2cc6abfe67e9a4f6bf840df103c7ae6861bb38feb2bae2c7c4ceebdf61bb91cce75fa41fee666dd1ff71fae15fe12cc6364abfaa3de33a8aa977cd9df7ebf9d5d8d17a7f9cc35fe61c9e7f8173f83d1b0bf287faba35b16944312b9fd8c4dee8df713fe66cd850f965bd33f321e3cce39037f4b5d99ce63f35be5e403bae713157abf0d44fb373743b9cbe71a5ee4a95c71b578cc7fb6f38a7c806f9591e8f287ed301f2646671ddc5bfc71ef9557aca9f2ed3e9cc1fe657cb74f0f5063236466da19d7cfd815c582fbddf9127838e3c691c7f3c9fd32dbd06e4dc54297890937c03b5631ca358e6b838bdf838ffe438dcafcd0162a07c2f581b64b92c66f8237844e712139f3eb75f99e71c5da565ef34cc5e94e7dfc3ae3f390e7a71bc9878f698f8cacdfbc5da5a16f61b23ef34ca7c689b8f8128d3aa5e07ac0c0e4f8fcee824cf5e03595ef518b076e2578ca1dcdebc7a0c581ee88a3194f352d563c0f17df8739f39cbb5efbc6f1538e8bafc12c4b154c546abb52e0df3c910f787605b8e194bfa9e4af19185fc81afcf1106f13cc5337d0ad14a4cffc26f579daceca3c4d61f24f1ea25bf7fe0b70347ec0294132b970b93cc73eaccad14f02390de1905382790233c7703191016471a8b3da493a77b7337d58127ea4cd66f220f677ae7c807b97e2c515ff87c90975615f3bcbf91e4e6c2ecb3369b4479c0209daf52f2e4fec7e5ca4d8d8343f96e194f1e65f6fc1ce4ccbdc5d0dfb30c4c96c0f0477eaa8ffc54a933f34be7a70a71d72aa027ffc3b9eb20cd2c8f994dc8efee921c2d1bafc4e7a6785edf241753928f05e2278e1e51c5b8a5699a5bb29e86f7c61303f9a51560c690b7d994f1ad9ac036cb0b945b174cfe24345ee3dbba163d80cfd399fabbd6572e37ce32b88a65bf455fceaa9c368ba3a8afac94cf1890b68c2a4ee6053f2e81453998557114f87ea13c36e89c047bc5b2cc85e86fb71d58997c95455a88746eff4b7e6f9567705b8441784e4ef27655767f7bc53cd857dd27695a167f66eacb172cfe7de520163fc4f019389cb624d08795b9536bd046449e248bf7eaf04518d86ade2f55a4b93ef89ff89e8af8e6cb96eea9f2a86f64f588afe67de03720eaabcb331c1370e5ff46dee47b4d98ac28e9e117ca9b3c6cc21b99b8bb5c93268c268ec17ecd0c62b97c285f48fb7d97d101181afd5e9befefcfdf87d479ae51a779d9fd1de3ed7b6d53812733f9d3b552bb468c5ef7fe7afa13bb2e57d2a1d83ca73d557e94b7cb3c6d51956b70780ddf84f0460f8b37cad6b99cdfc4e9e696ebf5808ee165c25c7876b9e38c4360c7f9589a1f11ae812a8f992eb7056f9993baae4c3a830f457af617cf96c6bd4ef2288da2bba9767ec2f1f787c1e3fc61f4301f52dde566f4f0f4bca4160ff7eef76775bcd0a8cefc813b2f9e1fcfdf9f397fb9c1fb9bd5d0f5e0e4e329ba1a2f1317f511b5eac8516ed924d7af3177e1b72756c6ebcf8c4187d3cf16478124c76a22ffbd3cb13d551e760cd9ef58525ece509987d3dcb185b1969cfd142e69789eebc6bdd861eeff93ccce1c815dcc368fb1fd4113dadf8bd7bb101b24bfaeb5736eeede828728b709c8c99a863f38ef26ed76caefa2340d84ee1455d457aaa4979ca59ab93d496b91da7f27b5be0b7ebb32457a198f431e03cc9d8e6d2782a32cec1dc052ded7c70945782b393705faf21e17df05734f11e0b1c65944f8861e51fc5656956ad940f17c8463fa381bff88b391e04658677475fe5bc473c375ad2d2bc3e3fca2cc0c836fcbd61ffd95d9418e4aedacd2747668a3a686ebe116ed33e23d030771cc6c04b603c42e43add9de42607b1d791cdc8f0b5cbd325b2f7d05a431e3f193c51f88662bdd87949cb33ca77bc9fe7e7dd5da16637a35b465c1f206093df21cd03941fefad7d3d9896ca0014ce6701f567e9bc66d2532a93c2d45e285ab74643f5626f3e3e420b15fe0368951f622d10c256f33710bcbf2b8af53b2f12ccf3ec9dc59902f38f16cef2051f09ec9c5e932023dc0b61bc886b7dd0590274ccadf61f01f919fcc4c79b23a49975f487eb27f44b293a73d268e65a5ecf43faffb2dfadd76f372a3f7d0014767ffd1ffa56419985c0e923f48e8cdf5ed2be8f77b0d639bf6bda95ee4ea7da508f653afb19dd0b1bee26fdf8f81b5257e877e788fdfae261017c9f4a817f91944b291dafa228c8d2816268c8197f56578fb3ee23cd3187bd67780bdb7b2dbadb95f691d3dd3ccb6b9ee1e85f6c01215d075af9ec75bd834606c66d1b81ad82160f0d165e69f76356c6ac3f8b665b95c0b36acdf631b017a7114f5852a0f0bfc340667457610a7ddccefe4f26c97fa3369f9bcead1ba24b61f397befe2fc121b906e886f26f3235c9b325bf7f43c09eb92b7877d8b75c9e7b02a5d97bcefd43bae0b769ee1ba3494216362e656f17d8726f77b99dc29b119aa7f1e5e657f52498b91da4fe45fb7791c10e0fae1ce7a4ac9d208b2bf72de3180e12cbd0a5c4aec3027c8ef146caab0fce4c14be955b0f611e531516afb896a5cecebbb3871ec82a4e741ba82bc3f53992d55ba8f86b9c04adbc2c64127fae3cec2387babb789295d90cde669bac4b6e6adfd78b9f78f9def457b51a07f4a6985ec1863fbbe1c1e20d155783fa1bafb1b8f3d639356633d13196b857d5ba92f56ee1cfec49846797baa923ba51206ae88f5f0febec81919e29a24874f8f0b1c887e96c6e23bb75d4d18eacde0efddce4e731fbb7fded9a9e16b0f2483b7389a1989faea24d2c00b65e0e5383dfa9b38a9fa185d1d58506277a6f2f44623fa8ed76fef2c0ea735d6fd7ea7f8f79a4433beac837d28afcce820c2fcf20f6277e18addc5e3527b9b76d734d36382fc046f35e7687f86f276fe366d1e189a677bd47ac26853ad068c0c07bfa35c12db192d203ac6dce5e8db300ee6682f8f01cd6fa278057d5f59bfd17e219f9e9125ea8cbfd11943d82e28c938bcd11acb8083fb5f7a97047f653452d0c7fbc634c1d0790419510dfcdf3c9642813e2d19d749c4f8daff0b7cd8c33935935735b7212ff657e6ef5d9bbffe117eea497f65f6efff5c5d23463656220f693e060cbf963ac395728a325ff32becd089f14f2bed039bc8e089be00e5715fab6d141bc8e7897b521e33a4869d6453d97d452c01d87f6cdb5ae43b6ac0aa34eebdd687bed046d33802b5e5f0997d81f70ce3f36c6fcfaf778694d0e92f7c982f2dd283cffc8307e96369c25092df23f5eb05ebcd34894190b1f9a8dc439dd1f9ed0c88fa02a478c6941f85643d25f78e8791ed5f33c61fbdc6a47e9b8c3fcfd7341f73e023f82207fa97c836c3f8b6eec53e614f3a383c856d60f06ae62e8acf46f43ec6bb69b94131a7dd7c7f7f5e68d4e9fb3aefaf908a4db71d585b1ac58b7fe1f491c3c1396c67ef624ff2efb501097d6826f35f28bec0bd365f231b106f5e8c995888b78395b52476f0cb384e24cd5c96cc6056c7b7a3e85760ee02bfec9d3b7f9e92fcb223bde46e0aaa7cbfe11c46076ebb52c976a2051feeddf53611f87579433f0f47a4478765721e3fd69ccee5b430ded267291b5baa860de57343fd3fd66fa7c91ed6f47fdebd95ad54258c1cfa9aa0337b19531f6f9390d265bcfdfa86792a6ab53d908c99aad4f141aeb241c0dbe5e6cfee32b1294e6cd509baedb4ef72a8cfa68ca7e1a0e0a713cae1239d77d4cf3cdbffd4ddc436daf7e5f601b91c3f499dc1ecddecd3a9dc9906095e4ddb09d7196fca4fab593c8bb5e44cf3e5ba11ac46312fa271e5f3ea94c9b50a30f02c7499c40f0d2c288eed1db8ed0c1f8351cff573008f453f0f7020d8b117ee0f8c3d42be4c959d46befc90631736cff60e9b71ff28be2b6cc332f7460493f97c544f9b99cad12e10f5d552a4fb4ea9feabb0aed97a81ed4766fc395958715f66fec199f97d2d5a8b543ceda3489f2f4fbee454ac3b6e1d833827b5e6c0fba10df0e15dcf002c33944b71ccca981d456305449d608394dbb7a4ce2ab87787e57637f9ba21fc184f7432ff35337810e99e2eb072470ae39b23df8d8ab652e3687a069692ded7f8cd4f847d66e08bf4ac5ce79b871710947f05ac9fd09cb7f3e3cc3f93eb847d95fb7ce0e455e9780d787f9e0a5bc6bc2ea89a6eaf6b9f342ccac3eac41920da28a5ce6c059ec8d311f5e3ef0cdfc0f689109f20eb137eff8373cf2f6c99682b40f2b7ccc3ebc296378498391ffeb5114c54fbc2e6f054b94d611d5fdd3ceff72ee7abd25e316b4f788fe47e11ae0ae248df9bdfd6bde4ec81d2f3998d35536edb90c2cf8de96d9c0d6a354ec1c457437f653ea01ff8e1adf1832ee9813c8f7f04cb25ddf7781d18f276a6c63250922d4c5dfbc6eaf3304ec671adad23416e11ef0fe64e26ae4f1a7720dbaf1afb96f4b166dfd7c6afcc3ea0dc173ae64192fc01900fa8b2797abb7dc6f31f6fec27ced1fd13b2e9bbde4f3e39a7599ad6127519e5eeafccd7a12f8e32dba342987178b667c8e35d010785f917cb79b0d4bc04b67792b74b12fec3ee49ca173db31f02db33829ce7883788ec364c8e5d1dbe8d1747690266a2be02ca30cfc7008fefcc3a708f219d3e7d78b46a8cffba333bbcd7f814efb6798477146ff190be8cefc7caf5687686537c6e3d5bc38afc30c9fc4b78f7d33bcca1206f78cb7964f3a2e1789508ae19772f7607bd35db3bf0db5d7e9ca9f32459dbe0ee0dda1dd5c85192850d14c3bd2cce5020b3952c48e3d4836b203fe9091cd4b029ceec6f908fed4dd7266ebbe65880a8233c3c867394c7c0e329b084ebc3eb2347a23775f759e3ebda47c7f6c60d78fd4446909653bc1e1755d82f57ddbb3fd7f63ea0e138bd545e10af3747f73ba211adf91988ba4c094579500cff35e82a4b34061db95c77922e9ba667f722dddb73dba559c507a4e458b5f73c5a8f1f71df64f3f4e3fc8c505e29882344446b6c80c78f99db409e86e45510d77ae198974d719a740deee806e7288547b530ef728d7542f70aa4bf7d591f396bb6f7ccb32836741023a7860df354ef75447606a4435fdf44b0bb3777f32a3f826bed8a4bea61ed945f1d53191ff753d8263c3021be677d5db791d2a303d7e7591e483ae371f4a6f24c25bc3538a0335294b1d7b0af90ac6feb9e2525329edab6b79c3eda0b34e3f123a48b08f4780d74af35e30325b665b564f6072f1e573227440f491de7b45df72c7ef4fef6bae97c50d7e432cac8f2ebc8b56bc75421f68b8daf56cb7f38b691afe813d967d4682fc07915b671783f4cb22d43929b9021e6264cc94986d32f8b87e5a59ac75e6adff74b6aee97cb54431c9d1ec76e0a88398623ba20a675e158eacb862ae466f19d3763f809269e4f3cc7842e9eee4d7afe707f9a97fbcac6746b8db2efbb264539b49c3f4be9e7a47cf43e396b29b82aea6ecaf381a47281321447af5e10de1af5b5841efec8b5d43cd7d2e0f25dfb1adb393ee91d55d44746d0fed2e390bfdbc6e3e8be1bc7cddace8fc86615d9abc2bfecfa3163c695c667551e6f3cc1581c450d1363535f04bca7b1cadac236b085c4c612ceeb337e580e9b4abbbd1f9ec3a6a8db79bb7850d5f7f2abe2f0fc67e267e3d6964c03bc6a5d63fbbbaab5e4b6339fdb1eeab69b8f71f0ea584635fc65aae38a97d99ea5f86d72fed183331d4a2e2eae50b4475199cd6466c9fa88e2373fd5f62ca3eb5cb34b7346d5b6d3c5c402cdf7d1d4e6292b477dcff58765669d812f6c79551e3305bba76586c73ad5b67d5ae678b3d5303bfe0ade32b4dd928ea5bc540dbba7dc189ada3ee5f583cd738935b03b937440a3dcec05dbbf84eead6ff797d12510e33dfd33ecce7ec2babfcaeeec8af58e6569d5eb1acaba9aae69e68ea9116fa7766ea86b6377d5888f55e127428a215f1a53a6b15d4c658cb1e29df0ee79e39ac48d2fe4e8cbc313ed764a7da0cbe92d44931063e716e9c11f1b5bbf6e0c20bd0ad74dbf14739ac46b736d6ca377b047a98ebd25c5f416582ed973028f074823a84724433dac7aa23e7205b60c8ed3f464e8b3dd303650c55a907150fe8f20b327ead4aeb1f328a5477e088cfc505b9637980fdef6a33477480cd77bb1cb7872704fbdad1d4b75dc15af189fb34cffbb0968ec901ffb361e1df8a15a5bbffe34bc3f7f5b97dddf8573db2c9e591d1b96cab568746653f8ab5e3caf5af1b20867ae5e7caf740cb546fc423ddc532b2e5953d97f7aecc9dd14db77fc4ab6266f369698e7ada93b663cc46f6f780887143c7f6be65efbaef3aa3859805a3a6f444793e2f4e3cf415dda3ea4d3737c49242755557ebc3473bafc327c896c16e6eb0339bf4df447bc0f5f755f96c3485d7b4d23e2736adb32beb98d49752c481cdfd988274be42fa4bb21653ff1de77414ed7b6e1ba045d5bbade15e73cb19bb9e65c97da8474ea8c57341897d319ff99e697a15d8cf67dffc3e9c7bab111bee5ea1578eb24fe0b5e569bb50779d338694d6c41108efbb6ee55d8f62fc4f928b0514ae0b7778a643069ff0e6c0e6d6c6c839ccd29fbd6f1d7d2b16f6af9105a4fa536efc1fc057685b57121ea85b373afd6e9e5623e348d83959527d78a3d5557f744ec17eb1f53cbaf3289bf50d1e788795c3cd6980f49e793b4878d174ec8759cf81143bc74595c0ed5bce670fa65be9f77bfd7b433cbdba391e28455e89d4871b64879ba72b1a08a31167f85d8504297d1f82d9a3f903a7dd8c60b3feec5f1b732f75582433331093629d9672eb651d68e961cff53e3b7ea29b08f0347f1c0203c1cca00ff08f262f7b0765e12bc7b435f4581ed79fc76aa91f086c8f60f812d08f0b8ee2c88dd341e39b9bb5993135b040c3e9d1e22bb07b48ebf5c6ca826f9d5b1f66207d1580822dd7b8f3c4d1f3afaf7d1d1a76c484be3ebbe55ae1e3f15130e7b0754ed6799ed09c43f8adef70839aecb6314d32b4fea58475127face97e2cac59090f73f9b9357e321eda317f04606c6723cca3f4faff0263ee4b3b2183610c77c813846f2fb6aa57f3741f70f61868530b30ee50c3e59fff9b3614326e2bc42ccaf1feb4f9dcbb75b2a032ff2c3f19d512afb2cf531af1b1b7cb6611e4fdaf7fd9c9e3fdc97d28d4b660638baeff313723e9baabccdd284a3323ecbc539a771eeb57a886b79e557d9c72767a69aee6d647ba03bb7927f8b8b7b13c307c23ffae824ac7b0897ffabecebd1bcaadbab965b94cb2266f4e82430410cb02d9dc8e04ae2e6a661b43c4eee8fcb6f6ccbec0c48900f1a33972d1dd8647ff01dd1ddf0c1777cf01d1f7c07e60c7df01d1f7c47fadd07dff1c1777cf01d1f7cc707df11fb36e669045cde1c704ac356439d0790c78b03219f443e0746aaaf5e3056cc9aff38ff4fe43ba80a6cefc3e773d8d0e7733833e5c9eaf40bf97ceea6fb47c46b3ced31b61c46066794c572d7c4715f4be7fa8b7d8daae32834a593d331055e4727e3ec006ae3a90c8d74e4740b70dd65791c4b965765f64c31114ea89b23fb8df214d45cdb8b3ceefb90ef5db28bc076f691901fededf8913af902548e5ec033bf11d82887c302c51fc3fac746e31f25315837dd952aea2b55d2572f9bc368b201581e451368e085b1fc8710ae37ddd55182e713ef1f7391b78340c74f05fedccc64a6f23a6f7154df132707381f4a3218506d7b70754ce7742ca3fc9d59b6a7241eab2c46f473d4d65337ca11cb0c383ab0b16b12bb16e2b2ef43eab4f0ebd3e5af88235b3f96923eda733473913a90969d62fd5e206cf1dbc58581bc21396733160e71eda5ca90fd358c45479a04319cd6486eb5d848fa48e775b07fa67b8e828f655905a3cde3b4bec26f0837df267da7ce677e9eb640f26f4cf16ea4b8d319fc0b5c4aec302709f2d979fa199b87f2e0a578fba67e5059b94c695c5392df043eaed98c8ee2262c8f33ff9cc71125f777a53d6cfa1e476d6ca25cc0dbf9914bb547b6d32df0ed492ea68739a4435d48efc93a0032b99dbaf1a283bf86b8b2b42d2c0f58c6336272e036b4df9c4edc57daf4cd1c919e3d88748fe258e045be8b57f063a570538727fbcfc5968ae8880dbc63fa1dd1585dbbee0de0f447f2856e87d3195fd4e138172f02db338238b1fdaec09e0f1c3df2f911921b7ee44d6c943731e019a58b798cf9c175cfe5d81ea4bf50fb4ffae88bbc9d8127c8e3ad233dd6e8f27481fbb6413874fef0985dbf51c2b73ee9d645a46f31791466fbd0b787cac243039e119b9f3b96f57ff0706fcbc3457971de7e5df585578eaf6aebbede2d0e40b57ffdca93ba10e6ca73d9a4f41564f978dd5c3831de5c92625365fdf97519decf45395372be0af2f626f4f32fa2bfa805736fa0873c7274c08b54ea23cb7352a5f61ef9641d2b62e210e24994da7a50055b8f345c6672aacc0c487715ef94647d7e09fd6309fcc13b88677b078982b43e3ef6252c57a51fc6d52bc6c24de65bcbd70dac36cfc3e997f9853bcd87a5b11e1f9e0fa3c52ad253917d01b508fef0fe3155fc05de6f0ca7dbcdd17df2eb78848afb39eddbd428975bcffe3e997bb8f313ebb4909f6acf127519d16b4f812ca1168d8dc14735f292d7e1eb6bf92e91efde549fdff2798fb1fc1141f68a5dff9d11d3dc781dde7bef4795cfcd37049706f5ad75d3b2045b31dcd6ddffb5fe38ec5a77add64d6b21e80afcf5f7df37ad9de6aa9ef85932f5f64e130cd73909b6de162e9ead7c322dc5165cd36e1f6fefda8e621f3549694ba6e1da26008addb615c7f46c49696b86e30a86a4b45d45b780e02a6d5dd08ccf7bc73460c79af162c2ff65c51534e0c09f463084a4d84dcbd12e4aebaed3ebf56e6f5aba292badbb5b9a423fff7435549ca668ea13d5fb44779e3bf4dd6df7aef3fbe72fbf77e8fe97dffbf4ffa3e83b8a6addb434e74f59b35b772f0270949b96e3a31e1f9463ebee4b8fa26f6f5a53c36cdd75fbdddb7ef7eb4d6b0134e3d0baebdcb4e6a8d76eb7f3f5eb4d6ba3c9adbb0e455137ad31fcd9ffdabb696dfffcd31264aa7547ddb456326c92ba69add3231f804330935baaff053e9ad2c169dd75e99bd6bdabe970286b4582c5fb14d5fbfd6ba777d35a38f04defebed2df5e5ebd72f7fdfb4e698a2bff77f8f8ac693fefba635ac5f74fbe79f9ee1398adcbafb1fea86baa1fef76f0803aa62a315828bd66aaba6aeb44faae62a4efb64da07c71224250f118da0a175d39aea9669bb7f08aedabaab0b738d3b09e13af5e6c19402907f16ec9de206bf57a6e9d69d68eba635175c496dddfd4feb73eb7f6f5a6b57004aebceb53d257c58290282f39661babfa16101a0c8bf899efb9b701434208840f94d337e133d0dc8bf4982a4c2918dcd91061407b61b8df7f3ce843d044b85be288664ca9ab16b874704bf70ba26d9a662db68b4a922ba601f4401cece3aec141bb6fda058a861d17bd1ccd64d4bf45dc569ddb42453b76cc571da2fe1c2c52f7617cd42cf862b688662b781e6b8e10be58c7ed9bee59af18fb610b4183c489a05a12b7e96d31f6547481e1429fb28d3bd5ea75f78d1d60c57b10d01b415f924d8b2932f068066b99a94bc517521f51457b70543f65c0d603e399ee80225f9a0cbbde401d64b3d49b7a987f4041c55e8649ee8de97cc73af43a79e735dba20b54ee71ed5cf3eb5ad83766eddc40092fad9161ca3937e160547f9729b79a31982eda7dfa84abab508dce2674bd1e123043138ac17dd6d0c8c3b53f45e5e0460b655c556c8804afa98ec922e580eb9a875d8056b5359a6edb8b2095b5305470dff6b4bb6d4855b14f7084f8b0076e95792e5a51f5f74d7316d37fdca505cd7162425fdce74a2158a5f592600e9e77c155b79018ae402cdcdbc763463079417a0edd44caf8eef4802006de5ac488a71c47df20c0442f17b57715c60a2d999e89fb66686072478ad43ec1dfcd716b55df2d375a2dfe1e1d0e1751dfcd7d63de06a96801605bdf8cb335d45b66ccd70216a6cddb40cc50dfe6dabae6ba57ea27fa2d58b5f46230edf413464d9264241f0d9b3e117b49ba6831600514168ecf0bff68b0694f0395c55f46ba79cadf847dbf10d5780eb637b861b4c27fcd5967666ea295e3fc1357584770a5fc2852bbc8794c94d2b0418c7b52513ed94e3da9ab1439f7c430aff4b9a0ff7af75d30ac7e5199a040997f857db735f3a5fb2cf5fd1a323bcc07247c5904dbbbd338160ec3e9bf6ae7d6e47085b152455a0a97aa52c13f89d2ed5ab288dfe83a7a76eb90889910a7bf65189903fa19c7a905fc8258a789f50b862c6100065c3817fbae238c2aeacb90c88ef3c74842acb59b679f62b0ad26dd512a403a194261b42c967c777429486fb0a4f5adb5124cf56daa2266bb657ba5aa8a86b0b86f362da3aa95004a3b0c13ae50cd8deffdeb49e15c78d8928c303207815534fc1abb929c34142eea711ed89e83ecd8808bd06a431a2ede6a65c9b94de999f755346d518c57634444b763e776e117f861055ccbcd51f7d480b5fcdb6fd7dd392055768ddb50299c54ff6e5a99b47a154278274dff36531e76196ef27c7ded1208fcfb12b551e3f167db3c6cc85ebceacc00f0bc90fff086c4b97bbd0467527d2dc4e1eab60fa385aaed703e413371d273e71f077981b66278dfb47713898ad860315e534887dea98c374ccfb224d91db9a04fe694f291948c13f6e380bf66983ec765479dcf7d372f5b4ae921c8f8794f76176e0b78bbda48353124b37c9f310afc938d0ad4dc7c958a69395c96d973bae3b03dc7605f861900b22a35f1ba6723c14e28e90f4df9818fd191fc00e90c7a303b75da945dbd862fe0a86ed59d29601d347f0381df77538767e3d306496b9c8c3412c13aad80f64b3fcfc988f1dfabe637f0eed1ea69350de958c7f04c7331d9f8f1cbbdc2536d4d35d12ef667042f11dd1bc4797e9241583895dee500c9d3087c76abbdaf3c3ab61b5ca272e8151bdaf093ab397b3f6d255bac5b7f379c3c0a1ac772e12d5795e6fc25888f9d85709eed1d07aee0af347fa357e3b7b10c7fd0e1fc508a530f82885c77876d19174069747ad293e1b72db0558ec972edc5b9146e7718fc632ecec8338ccd22ec001e433897c9b50ae8afea1e0cb928c5d958c99aa44f24f4cfd4d97d1449da17e1a1e0fe22801491b5c82d84ccbdd7ab3dc71dbf92eed8bc00f07baa4f7ddf04cc5b198a6e35897b60b6d35bc44fe5db41d991aa15eb5041744f94cd2732dfa7c9a3bf632925fb11e03591f59e278a409ecd99227875da8038c72f650a23fd84b3aa3cae34d88db231f9829767ef0ff10ceb5b4ad616a9e253a5fc99d3fecb23a5f8377a5eee2591e8f3c7e3cf2f9c7b32f751696a2e7e27fa5728f6ca9c7ac0f70f6db99f42d9bc36996f43bea0fe6cf591b9eb46df4f279f422efa784efa46fb38aba3377dbe57cc2b82f846f14e95b45bb94c8f4e9b956be268b35e11ba99e5ffeed3b610fbe8fc8e3f93eee54ace5a1f4dbeaa17c7f570f24b8599ee7e4757a9c23f898bd6c26b3a3c87e25f543d88fe5790eaafa99d5ed870033cbf3bc53d5cfa26e3f04f85b9e17e4fd7c9c3fafeaf6d321c3f2f2bc806b570e778f957b48fa56b52ff03be82fe1bd2192c6503546d2b70bdcfb39618d16c4b3117cafb3d64bc2b755451f75f773553117a6a21fa6663f1cc481a4b3704bfebea982ab51c59e8e2af7edb9622d9eabe6b0a99ac32dc7f4e94539de1d7d1f8f2edb2e4738cb1c013f7267c25d30269c9b31614c63c23d319997df4b13427f13c23e4d08639910eec1e9fc992bddbbf5c3232d12ebf2ae543e5ef89d80f376b7a46f847ebf2f2fa47ba8f730df97e380e7c3dc5f4ccacfe6f3614e13da66e7045ae919703e611fbe337b2ee455025b9e880ec5db4792e398167c7032346bde87e9d5febbb14d5dc40f497e8716b78bcb131bcad5d6ff117fdf7f678ed77d45ee8f57d81807b2b6e7ee0048fa8812bbb3d4d83f6219bd652ca34ab90f6e3ff519f2b9adb9bee8cc47bee0afc9475a3afe0eef6e68f0e53ddb5f225f9d3a6b033cb9cc665bef582288e51ce7b21cc5dc568df31acf9fef4f4dd699d13b8fdc76a5aec6e022d48921a58f7c491ff59aadc7e2228c980b82d7b73efba17c44a2d5a5ccce1c815d3cf1dbc37bf5f3874401976365b099309a08d70cd9bbbf398e09ce40773113d991c16ffa3aafff807e98c513f35e6782ea5fd66c47e569e63d7173381f1e48a1ad3946f7439e536dfc6c6af26406cf5c23382df8e0453edeef03afacb85974a4eeea286eb0f2505c3f80eb323ebfbdb23f9da1623976b37c184dfa61a4b1ec73ec0a6cc67d78e67b6f907fa4a84fd1660b8eed7538b6f6dd15c636ab9fabbbfe5806e29c298bdf45d08f6561f89d64df9975797d2c0facefdfa8238fd5a3a4832f618cd0c1e61113b7cda74e8b42de85b89fbd48774ef27605b2b95c23f9fbc19d3f48653e5741dccdc9cce20c06d2986b913e83d598d105b667053ea13213e6e0d5e6fb65effb83aa723e45cf9f77a7a7e7a5cf3ddfbb1c3bbdfd3ea4fcf998ebce2f3b9f1f2ffdc5181f53a4babf30b75cca96ffa9bbe870464a3f6dcc03bfa32ea3a5f5a4df86072fff6ecd2e919f52425fce2e4fd95ce40379bb32c5eecc52c2fca44fddd9511c338f3ccb5ba20e92fccc85bce488aff00576d6813870a3f71d7eb302bc0e3c21d8cf09b30972d894e414f6e1fc97c81757f690ae3c951b9bef3216ca7155122f30b883a25ce8a7ddcca78ca7e1bd21c171b0a38b440343d4116f559ed331c03d236502f9a345149f16eb8b541de362b6d850602eb0bd8ef2c8dcca63c60b61b1342e4883dc5b9a94a14ff23e6f112fb99af163c693c7805288b9bfd2bc7d6017c33f8203e42985edaab7daaa14cff6021f69bdef3f8f81276f77d5312036aa2aea2b87dfce0abc26315f17c205fdf80e78668197e3f950ec1c52ff483f39065ea9af4fba6c98876cf17c7f593c94e7f322e6620c723002aebb02fc6801648883f5d0dfae34576559feafdafe5d72394d9ec865d6616ec1948c68c60f774616661647713be8c8e30dc40dbe3c6154f1b1a372b46a893af3ccd1238767e0bce6381fa2144f571a1f24e215e0ba383c3370387611d85f3d06f9a9533417899f41f1113671ecbe8caf192552e7a34c63e2b274d1d98ef39a8739137f740ef222ce446bce64eeba2bf2853f44f7de13a4898d558763cf0b8e5d9802db031b7aa18a63e699dfaeaef171ceec6db90f6b3e2e53de4f33f94e9a5f142718c508a281fe6d2cfb3c3b82f488298e198fefaecce2de067606685f1fee2d0c7c7e8963540e07c856efdb50f664f6ec10fd720d82af6000936f1d1788903739b40bd33aaabc5d1da5604ffd273625a7d166082f96e3d6244ede138b70ec059debedd47df5fe43fab33b7743b9a52bc6b2baa9c707fe9717e47fb9ee59a2dfa1b372e83cac94cbfb4ae3d096c5be3516b65ccaf366f37162698a5896449e3bbab3110c24b27ed23e70db81cad1e85e866b6e8946094d93c89a02baa551dcdfe40e27f10d314e01aec5177dbd83fd6716b315db43ba023e8c0b2b7501842537a2d978768968aee7306e73c3385727713cf2eac50f2bd9ab4406f1bcee54c54c1b7438fd6c49b41a9d5dfcbc63d949ba3c1916e099e627c89eafbadd50f6d31cae6bc7573ecaf00e0af04cf9990beec73ff26509b81ab6dda9cda707e5e3383825fd17f87e52aeef727a0ce28a9e218e99a5381eed439dcd44d2fb1d6988b98783be9fcbeb549cfd22bdb494f4be2eb067929c1fed8dd419f822cd5b62b98c28b903d8952bb03d46a219bf0a5f6fba0b93d92eaa60353c57311d12c4ea62927c97256bc58a1b7816e13c916f3bf9ac85384fde2e28910ee4d10418c8cbb4eab4ed047cd63579e8670761bb40786d331e5da4ae7c94f415e28311ee645331588c952f97c60cc9dc3917a14e2ee8848fdbaf69a60779bed2d8ec199c502ba7389c9bcd6f41d57ae765eb35d63b83875479bccbc6141f4a4761dc77f9f5d7525e210d9f417cffde45a277476ecb50c2b8ef0b5b0bf0a33e1c3f36ce027e5d2a729363f29716700ae93ba17d326f18c745ad8917d0dd1de8215276b99575307281323c8fd37390717d82b3789daf7767a6efb6ea762f42f78deef678ddd331752adbada6311338a8c0a7fd0bd2b7d5c99fae2fc23b4382635cf3ece8c07467963cae8167903dc288e237fdc457e5c0fb22dd09d6bf46be773196f7ac2c89766ae0cb0c3cc7717e9984862c39ef8538871b653b28cf2b51ff4c56ec05bc43468fc2766197e47bc0e949abf23e9064695e145b6c03e9401d38e57ae8629d35db83e3d23976018a39cec9b92f82b1d5d8bf141d5d9a07222b2f71a643c91575b0af77969bd0a75978e4431e63cd0cc66277d6857c14d2db55cbfcd2eb799220fedcce7a6499126e6dc0e9aa756fb2feafdc07613b88fc579addd5cd64ccb8758dfc46eaee67baaec513f82146ef017914e7f03ed4bef39333597b3c447cd2b45c599cdf06ed10bf13da27d21c7a4ae700eaf3187c4ad61fe2cb063281018e57c8e853100c8f515c5173d671e550fe563ec792b5c1be7f5dfcc01af691316d1ac6881d2dd749dc3e52ec7c785e0f4212abaf8416c9d85dd589c59fd3fbe475a8b747a4ffdaa4f55fb564c0b97d443ac081d45d583cdd43b68695f2c01f2f3b6d246b22da96a6d6442aea31673c8e2faed67fcef80a398284ec171696341e3991ce4660b9125d670e56d6a78c0fdfb771ac1349e2c76fc0e1db50cee46e5bd27d579a3014bfeec77e7ecfec682f8c81c7338b3dc79ec18666a850e74bd6fbac4fc65337890129f9fd6c9e388c6ef1898a7f5b612ce7c00e07e11329f2bfbf7d7a9e9f9f98c17ac5f0b3152385f14481c7d1e0c4b1e7193f3ced667ec5fac6794533bab050c6b3b39e74702883238c7dc8b7723cdd3c7757a5fd6c26366175ce8b6cfbb85c554d6d6a89b1c7097ef9f5746c3f394663954deaf5b9d3b691cf2d5523f66283b166eede1ab9c66ade37957013d98131a18f6ad33dea86eb71e8c1b30ef7479c8f284fd63bcfc2d6027560a5960d6e2616e61988ba4c09c558a4918ffa77915e21fd6083356866eb97190fd0117e0ef2a55dbe4db27446a8278ff548cf93d925d2416160a83007f632909f8c486f05790639ed4332df8039d2d36c263320b2a02332c1bb46fbbfc9f2d544f8c3f24ea5f94a348e0eef1f1fc5d7af2713c1c94286b391b81d906c5f341ef2fbc8df9a992c833ae8cee5c3181e98d8c7f8f5d657478e768104f8a338615c9e89da3cede6c303267729fe1cf0e3fe5ea0199fd1fb8ecc7600dac38d3c7c1ade77e643a22e268afd7f88644f25b625247d443187443a47d13f066e09f01bc3cbb98360e4617ac6dada6079ab7f1bcca2dc1c0ebf5db1227dee882c8370f08a9a3a53bc1dc515ed3c9e3ee0f65de0d6af6b2356f73e936815e916afa0ff4ae062741059e0213d0f927531b5e99b4d77616e52bab1b71ed3a6b398c27657e311c5d5bff71af8875c33b64817b1f1d27a9cda3451a2cbf823d2d134a60bb3b16e8c6f8c73daae7bc768de4f7aa0d3b9624ccfebcedbd18b62a4635af72e42b73e5c25f61c29ddd0db8f29b66f6ab04eb04e2013d9d61b17af8f1c89de34a0d96af88ad49af7c211bbcc219293a33354d39632a4dd3d7ebb7a0e64c9abd955fc4b31a65213fab5a67f49ea0c1f3aaa6830aa98cfc38399636cc3d8787d233b460c1fb8ccf8287fcbbf47ff8fa86f2f4bf3fffb4171f475c17115db798b30fa5f7addde0f09a3ffe52b4d51bff7de2e8c7e30f29230fab7548d30fabfff7e4b757efffa0f0fa39f0786f788a28fe9e32388fe4710fd8f20fa1f41f43f82e87f04d1ff08a2ff1144ff2388fe4710fd8f20fa57f16c1f31f43f62e87fc4d0ff88a1ff1143ffad62e88bec08edff923e1f6576f510d8ecca8fc53d4aed2dcdece199d8bcc11e2ff5d1857fe628e4234cf70f706f383496a5cb85b2dbe918e136721c77bda306e729907757c5732fad0f62fbde9f05db2e17c4b641f152449da1eac070ecdf371cc476fa35e1b7ca56a72486de470cfd4631f403fdb30ff793df2ed277fe6c15d86a267163883130925ce1e2b8aff28f09be927cc9990e655b66674082308cf43e41deef182f3e8e0e7c109314d11a92dfcf954febc1233d671cfb752e190ba437cfc698c8c5e827c5ef27c4aa9f3fdf1362c693730654c4d0277eabaa5b157f9f10af784088933ba888bb3e5854c6d1df10be95c73b5e3e4b846f3bc237b5623c6a558e841e29363c31167e39dc8471fd4971c803f8d8223fe6ce91d84f655c7f623f7eed7e4831e49fab62c43f5eeaf64380bf30ae3fb11faa763f95b917f88a58f2557b48fa56b52f75f33154c5ee277ef32b62d85f2a7223d4dd53522e878a5c22cbbafb59158fbf2acf43a7663f34c28184b3f09dfc7d540957cf5571fdabf66d5315d7bf6a0ea3aa397c1f43d827e473785655a943cc0d3026e0c73129f6fd8a746e4863da97df13ab0b2187cb85d0df85b04f17c2582ee5f7e0fae19110e77e775ee814392fc0b67cbcf03b292f002137c2f4fbb8bcdfe7c39c740f7d5f5ea6041cd07b98ef57c4bc01843c10df993d31e7004bcc3900383aa2a3510c8388cf34d2f1f793f811691ea33a0ed9e82494fbb195c51c5bc77e4581df4e229f9964612df62b8bf840ed23eeff47dcff6cb98fb8ff1f71ff3fe2fe67c6fa11f7ff23eeff47dcffcc7c3ee2feffa4b8ff732ea7476d1203bf19decac601792f5862b60343d247079e191cc531d82b4dce4703587a8634053b72ea9e29a2ed380e5fd71f4b5e07909e2341979bfac3e998b6bc2ab06795d38183e28c4e427dc584b9f0ec72278fbfc6badc500783d58ba6d7a5d2d71eeb5780d521a4e7a8e663dbe3730448eefc6143ca1110c44bd864627f68dfb5a93f7f78ec3d3ddf779f9eefcfd87dd1ebc5c84767673fa5be3fef6e3976ee2ef68fb7f32145cdf5c7d3133bd2be3fecdcf97ea02f2ef3dbb9be3accf7abfd95fd45b12c62fdc8ccef5f647696d2fff42e4fbee44c27b9bc03dace7a3272ef0ee0807cde09312096f9182a5adf2fe43d08731aa4f714f9cad33d88bb3414ff1814f312ac3a0cf2bb2f8b3f8e8985f23dca49c7d2724760976693384c708c616c14351d1b8590e300e19e552ec60e36e663691ca9248fde33b39805b1f8d5548c9d5b52ccdbfa31b9f2f171b3bcff22f2d95cb388a7b4e44959ae931cdec2e526d8a462151df8a374385ba2c1dc12e38c21fccf4c39b67710c78c9f3dc78f387e368f0b8ef11db0e958a29eb583096800624cc76c8c7f526c3163709420cfb0df9ce69725d66fb3fcbe8ac77c92f43e05794d9e5d3df3db1505efa2f01e7eb7f849db729a609d8e2b938f4bb26697e68cba2e463dca6742ccef41ccdf4188e34b8ce11af3789818b8317e94c7fdb9d895713ed6f958382807493d9f34bc0c2f55070f5f7a466e87fce48a3813970f8718b729a2abae8c1354c6f356c5ee0ef7b63ce65512df061f7b34f59d34bfc89ec2dc85b1cd8cc06e81f1bf8d4be384c4f903bead4f27146b293787906e4371980219dcce100dc611cbef23445b722c117fe4654222ca8b3219e0e00f23bf92ac2723b98b89b102f5d85ec3e5b60c2576833d95b40e1075044f871ab11409790a5ebbff708cf2e5890d64824f6c6233f8a407717f2414f787f204f6ab2b67e5ddaf8b5754766e833d0ce2d696c7398befebd278d7412c9d8a78f5f0ce463090e814caef388da347278e5da07b398823d62ba369202f8962b896c7e22b9dbb95ca2f54ce372438a5513cb35977d183b024c6341b73403417d53b8ae17ad58fdd71af49e3b38ae0a1066d5519379972998ad8f82724b7da2ecce8ec56c41f5ea6ca57e52ef0457ad5e1bacb3aed86b1009ae763a81b234fc4c865ca6343e7cb56c4d6afcda7876361fb07521ce222df5fb62e447a4c9368e0f1f4196cc66795a31944f787b1d870f77060eb555ea762bf31f452bdd8c29ad85d3488399e8fd741ca8b12cb7e6bc5fe8fe810e990c91b50ba56f93c4f35e20e6b5c97b9c8e3bebb0ae5188458cf0fe95c0475da16033e2bb2e1ed487a94eba83a263b3f061784d79801e413bb62777608f860843b13fd5965fe9ee4ce91e85dcd58dd011fc7512e50b675e3189362ed64e7264431542a7251a463b9d48e211de2216ebb320b791069f528b29b6329af90864f448b7ff5a4eec27ca29318a93cbb7a1150de05020ec8e1caca75a98a074c8c534c689fcc1bc6f18f1ac41cb702dd40caeeb9b2cebbe6cd49ce1acdeb35efccf4dd56d9ae44bb9db7b9dbe33524e7b1cab55b4d63267050814f8f1255375eff6c1fde19061ce34667547e24fbc2765507cf8436ddcc1f295bf0b1a4f7dd304e518d1c05b1bc07c50aac812f2be27995de472539096a9ced8a3359b117878c9e9590470ce1c90ddc37b722a709b14fe4b780e2df34c81d14d7a18007c7c5eba33dbfc9e7cb29cf779a1a5b9dfd4be8e8243723515e82e4c66ccfe2c8317cd37dd4a54f73f018f2181bb05ce97d5fa6473e1fc6edab94f9a571c904e14f1fe56fab9b172818b72515f214d559f726ebffda7d00a7c83fa8d95d7d75de87645d23bf9cbafb99aa2bb0047ea81073aaee9d5f170f14d61ef9bc2d63ffb9c4bf6fc9ae0e92ce5ca4cee028d2e70be1eec0e85d9b8dfb23d74139bc7ce43af8c875f091eb2059938f5c071fb90e3e721ddc7fe43ab836d7011bd9f77fe43a08d628eb37ff93731d84beefffe85c0785397ce43a7845dc78149bfd5f1397fd1d61830023f19e4471d9efebd366e57ac8b4cc6e370533c0b1abada4038aa87b0fed2c233f2854f7c0b8d2645566271ae53fb6a33c1358bb8802eec7e33542ee0aaccd44141f25176bfc20d20b9bdfce30b9043627b27d405ed658621745cad148ca6d1ae6598077a23c1e59a23137b17a3e4c4c07c40bd65cc7e87e407a8b4eda47f0bcde0471289692ceb8c29671c37757e930c5098360648ee72fb1f5ca6d83d3796cef6bca9971f2e57b6dc5764e447b421dc95023b848e11239c08bdb69818f288135149f431c33d42b6196d4ce79fe704fa4d50b39784be4c2243daf54925f37759e91fd2cc7024ff2556cfefb18373e9e7da913daa4d45f033aa493102d98f15d1dcd9e91dd4667e0f36cc792d94ef8eeaadcfe8ecc2238f995f25fe0ef9e9f9bff8238a69f9cffa2646c3f3fff45096cffbcfc172577c44fcd7f513da67f67fe8b78deafcc7f419db56514336a14e900c867a8a18f4059aee5b298980d6de9caf999321d0cbf5dedf9c7f330376f243ba82d87caeb07de31bf4884073ef28b98c9ff3f36bfc8d132de20b708ddbbedd7492df2e5aed3bfa33b9f7b34457feddd767e6f9a5ae46bb7ffa547bf5d6a9160e0259945bee2128bfc4efdfe858e528074a8ee974ef7f7ce176c621158b413e72089a68c4d2c525af4c724164943c17b2415c9b5ff9150e423a1c84742918f84221f09453e128a7c2414f94828f29150e423a1c8474291c64cdabf2e9948da78a1328148c0b0a7840387a2b1465940776c40397d05787dd41127ab3820582ee146d6c0ecc0ddce1f97a7f9667e7a7a9607cbcddc5d3c2ca9f990ea7d7fe4e8a7e7cd69b17feccc9f1f7b8bfd6cb01a9283d8c7c652b11179e81cd6741d0c24989970db1590e9512f12ae608c51ca0324a5122cc8c6c05bd1238adfcaaab26936a6618d00f45224806706df6b1852e70c5c4ebb99df61378fbd78be4f4ce48c0596b960f0a730c8d96cf3180480a908a6f03d4a44f0d44dda8c0d8b69e68209a092ea03174861468782aebcd11831507e9c1ce1317ae75e9eba6100a7c91c6b4857024f5865938c0241c9aa3c66badc164442bd28104b5ab196deaf013f5ef9fc7671e1b78bc1e6316bec8a8269fad4793ea44ea9e0be1a6e2e097c6103fe749f9e1f4f28e0cfae5950d67203d4643ff186a76506b1c99ee0d7211b94b532286c095c910349148234a40c32655fec32a76fc383971800f6b2ce057843da370f82108f37235cc53af227fb349c0db9edc2e25281d332c271b2e3430636c5f1f9ff67efea76e5c691f3abec0bb479ce71263b9bbbcdc5606260279b096c637c635054b59a1645d224d57dda40de3d20f52f9114d9ddde040b5fd847adfaaa44f1bf7e583afff1d227adfde802ad5d7285df7f65ed1f1f9f97cf58cfc7bf7ae7b765f0e930aeae7ff18cb5712c2d83a363077eb7f3ec2649f07e7f1a0f318f731799cab218a39b4327cd545fa941a18b04c6de64aedf2f287bd12f1abb47f9d07efaf5b7bfdb7a593a72660196ab60e5591bcee6edf10323ac0f52de9d3b16fdfd97a78c44cbec3238b84a17e8ea12736d03dc7c4ea8a64b08144d98977650c8058a97cd2f4f9fdedb79e8756ae3daf6cfd3d939baeadf7fb2e3147ff4243eeec776b76e0413b7ce9d66eb7eeb9c4831475528f07edb6ed1430281e74f6d1a75d42526fa1de7b350e2c945b0f7bfaf1206bee3c35e660c8a629f2e055d27c5fe7429d83837fd77f1f6c3d3a7f7ef4ee4e543bdea0b6e0ff75f2faff2d3c79f9e36fdcde7c0b7b2ebdf34b67ac22fb60ffd7efea34f48e97192d2f7cfbfbd7fef4d94f2ee1d7ef9f0f16feb2484f1e0f640b297e9a0dd073b57be259e04806e9c5d1798ea714eb7cef30299ae971b3d2ad393adcae775922c1e5f095450be7df6f772a368fbeb4f2548e0257072fdb73fcd5eecaf562caac401b74628d0068d17ce003873ab3885d699ea645d41b9f5ae4c2e8c98417beb913a325c65c0655d21a9c497cece98e3f65a9bc253fc64f986f915a4a60611d1349d257707b8534a8763a2da18f567987367f3285a5eb27bfc02bd21bf71ae8a194ecbe3f35b74a6d209985ba99df9f4314eb113e01f5eb2ffb75eb2121b5c600d487f65a854b4ebb28ff29d117d9effac4491ef591b7ebe366cee68835779c6b6acfd94b399a7bae9b09b0c2495c028874325d0701d46ebb23e1c85b2e07ee6d076d8cbd6006aaac6a097a7e7bf1c9efe7c787a1eeee7092bb91e05fd7c78fac90a2ab9ce13c2c1d8256556a2e7672ba8bf9f276cb07beaf90bba728d943c81da0885ab457dfd8b15d7df4f12d64f7f116c27ec5030515806fccd5ef9f0be5531158770e91c6a89604bca43db9fa74c16c268324789fd1d34bc5548839e31a3253681269ab3f8d6b92dca284c364ee9bfb92f7b3794971a6968cea0d0f9ed12528050fccf4882aa35fada626ee87ab924c06bcc0c450526b5381ed7546df7a0e8f5d539b7cf2f2bb25020ec66efd017a0fbb30495f80ca43a3994840bb2ffad1095a25f303b63f4e5620ed5aa9e4bda9013e6babea2d61c0bb15aaae18cb93cbdba49f220dd7e71413f6a2e0c3d5ec78bf0f648527835c0edd0721b047b47232c25a3c4b5263a3f6326bbd5274b86addc56decc6eebf9666678c58d64b7f3537e54581bd512d34ec578c915239538d3d276d21bcba180815d8f6fe5374230bb5cdec04f18056e349861e685f03637891b69728226bcef4e1362ae12ca7bfb6758f0ed9d362cf3d69e1c967847f70e0bbdbfcf8765df3310c252ef181d61a1f70c1995354454398fd049555fbb926733200dbc2c8188c1ccf09d94780bdf55b1e36cbe5d5326333a096d086bed6ee13e418da6f7099805d93e400a6ad5cde5e9436e3d7bb4db64a0aadd6c9cef9184a8c4cd83c5f915a01bc51ae0386be8ed8942755b80e260d60ad5a3a4da69e7fb49f6a82a0f173f2e1dffa8e720414b72ebc3eeeb217de968c5e93d63b417a3754edbd815c96e7e9cdd2e6ffa758bd90948addb9ce1dbad81500237346b05b48c2760129446e746e70c1dc76a8cec2d75396c0c1790cd23489dd573ff8126705008782905cdeaaff92bfb36aeee2e6654305164ef813c7208136d49043fd29ced8d4fd0783919b1ef105742d146fd004952803bf3eebd62261bb6c9dbc2c464d931ebe68b07c9b3eb3b282944cef0f408ac216227c93a6e65fba8286e18c52181a3bfa48bd97f84c8122413d7e6ee71e41178cb9e372a996b65359788769525af1f1c54f0c735f93097e6ac4631796edbc2ef9c69b7e21edd34995bf63d51d3a0be69671013df38d3f1a39aa797f6e8eae4b8011716f02079bd85e461d2ba8b4a89563e48e623361493b46eebdb1b771e24f32c7376e219a7701f249208ce81642a0c1e991a8cd9385c72850caf7767e58f626e518606797baabfd7fb33a31350914ed4f99ef7343e78954219505db008634062d6b7e86c5affac77f6bd23c25da93207ae409b9dddf002ef75c7aec1e30e1b9dffd5e936bbade967db1d3456a1b7ca5610d005f5f46bf4b858a31360664edf72d9f65e7ec936f4c7bc870d5c69cf7a70b8520f44258666efd95b7cdc661c63423b5d30ca9b126315e745c0fb93b6f78ae8d4b8bb0431aa0ddc56f7a308715f11bab0a144fea48e6a19d27a476a6bee967127ac6e86e923131a3038093ebb36314bb8c379635d66a06105ebaa74d756e28727ac31414667bb4047ca31a3df40e91aa4d9b368ec08b35a1b279451677d2556016150de2db4dbfb3c485c2b4b6c0033262e79b21636d704437e026fb433a7f05fb6b1200109432d668111516d78f5f0725c14967266adb98dbb01a328d1b7157a10a2c0a8ebae088579299a1aaee196d0069b76bf30833212f742ae43ac66a448c46f250ef61dedbf801577026c578189e64e9ecb2eb1c20e62c839b18431511d1bf78c6d6cb8a3ba597593e3e4a00d263572ffaf69e1fc2795a80472292c8af688865c16418016ca781ad29d98474e897549861053ad17127fd20a62ae7233f8bd1884f9dae8e4c795adf2f96afd60431bd006379b161215738175a49188ec9227034c4937aa51186ce7a3cdcb87d12df7e9ab61863366eda62bf4e863fb6dbda5ee486d4bd7739523b8c0a88a0b6d2841ff2981fff5efffb10ef2f320896824ddaec85be014e6b1862aca18464dfbba249cb03e512294ecdbf6b0e98f3e04d2b4910c22d813611192fb87d7bb962d4462a5d76fed4129d7587b304d30e709b031c54b146644bdde202f415fb4e0092fe06009457338cf4369536245056a40ad03395d84263563068b25b5c115258263aaec7896a00c5dcf21df2be5d394cd08d72038f2424f20f183c4a023655bfbeb1d89a8a029d61189a9275d76525b2d90c6703b25511852caaca8462ad196fdb8fc3cccca9fe7b15ec5d6dbdc50434ec0988b103e89064aba6eda1161db7288ca5b6144098a1f2a6137b3a4556a63ed99107d02a7d53c27b1dd57d58d7bc721e3de9c0e8c81a160bbeec18866f51ab2aed07806637e5f8906cc095add479f7d9e56ad9e90cd10d841a670dacbede665cb696b8b6d06e91c271aab22c3abdc6c76b6a8291b1a35454b6a302e4bcfe5822a815b2338547b225c818220a90439862bb223cf56dd74e8b6c37687c3f0119408124247cac86695e9ef8b42611fe1cb05dbb9e202d89c403598fb40726b46589e609b13da42686a04aa84e992bc556258fb042a05e92ea69542cc970331cdf9be3c5735d1eee85598383b25464248ad4fa83bdad0f5f0752eaa415d25e675e8ca7b59b2fc59af8284311d56280fd6e6be6c0b46896e8f47777a6c41b75dfcb47958777731949759b538b113cae8605aa7dcea53f1a524db8a67d9da4dafb593576b0da00d20d55ba845e37269e937afd76f6e03e04e68f4074984acab37948f2733de9c9fe7b7293fbe393f2defd0154681366bbe2b6ed81bf7045f62c6937133b7fd33b7a5df97af91e16fd73119e03f5516c7fa67fd868a2e34b06ca8d6dd3183028c0b07f7911554541b359c484843f9044aa9b7fcddcd30dc525e5694b6a466f5bc31a07d813327e0663a4cb143f615a23527a1e8b7b08439d52f40d846eac2ee23b4973de6174f4d14dde87bf6dff548ec492fbeea22768f7eb4d50181262142a892727f65ac885e7677f66279b3a49a8833a86ba011e10cdc04ca33db897ae9bd97d457f70b928fd54e87fe12f5240f93148c92ab9fa60a4c36a5e86ffa9ed2933c92343941d97a7bd482e493ba00f864778e721d62ef0fbb6e9e3bde8f736d9f3835e1014bdafb666667f5e8c2e47013dfbab479ac1945ee3dc8d3f99bf1ea5efee97cd97d62861366b7d44eb6d86dcd35989c28b763bdaf6e045f5bcca8b9eec106452c06ea7d5b31c8ccae1d8169276adcc94dcebf7d9ef3ce0b8f30d4f251f32d1379f62a9408be5fd60984beb6a0ae122bdcc4aaf648819531808b1e8f01a6ecc93b88ae9bc71a710482a29d2f2f0b3ca45ec8e018cc1e795c0a88a8787ef9b451809bce4e94c1d6b7689c4f031b63a94298c1d21fa2db5d241a8c2971501fb31205f586f8286677ec3bd451e1265ad90e45b9d16617b5d3491ca60155c1703c3d8ae47bedd9a1568b8407b23f8c1c4c83d9ad2db771868a92a437e8bd0d51cc22454222b29b5af6f0174c77abc6aa8811ccfeac7889d4833951557e9658992b3a0a55c360128d759430d3946ebee7ebd6d14325a6bdb09778b53d89f8480b0f7d84bad9467841fd6abeabfeddc0bc5ac16212d6cae23e365bf84aa18a3124689c51f6a0229ac395f18241b5358329eb715e25379565adfb66f1a5b5e056534e00a7172ba4574758e2ea768c31a485a7f3e43c6ca5b347b031553ec216d2f0632c113d23c216b007a472643cc86f3dd8e7487f44c8d61061d99820f6b1196fe03758441802768c548e8ca2c5ac1e31b6b03124c6e5b19124c16f7a46f06da66443eed67231c96688166f8f335ac6cdde6c46666d45f9dcf8e04eb3f75ff3d9c0fb5467defbeb0dde92dab8203a1fd508c1f4900b20405da9431bb2fb4d9a3201d2e7344881217786c4fbc23eb4bf37764806b804b5d50ee3b8d198b254f4d64c7d786318207115abfbf17464948e96ee8d2de8080a38f137d32c4e7043ec7459881105e74a98e0b8ead453262e7d806e1033c54af8e9355c436fd9e98760fc9a43a74609557f6da19d5541ddf988865f6de1ce1a7167c91e34ab213cb007d97b1a15edf1386fb3ee2ee506aaed6d17c0b9bd3d78d2ba323051217dd5ff67df182342d414beb83c9b591f1e73f7a454e298f32532a191a655274568d476aef9eff77db2e1c4c6c3be5736dc1ede7cf83d5497a6957e3374c43130fd30b2d515729fc274ef9d001ee6d854e8bc99527886d364c9583ba9f6a33185677ee8210d3fad8b0978b7854e839e302f93cb311deb4a004ffef5ccb7f530ce968d1c01ddfc91c3d12d1fa925ddac94293ca9656a30ef97c214704efd4805a55334d3e00a1b60b4a126b530c3599854f959753ecd407dd289049ed1cb938ccd9c302e509c84a8b3c093552c8f2d773218f8a66105cab8fd6f6a956f24f87b5b6f75bde3db952e74091717d5f7b5eec614c5b3f8ddc5b0fdf8e4e58f4f5efef8e4e53ff9272fffe77f010000ffff010000ffffc264908760eb0200`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffec3d6997a2bab67fe52d3f57378a65df637d2bed72ead673cb2a27debaebac10224403a121a8f8d6f9ef6f85494040b0ece1f6a90f5641b2f7ceb4933d6487fc5f0d1b6b6ad71efeafa662a639f247487541c5c060f61e58ba008e8e853e50135980514bd8dd73d0cfd8aa3dd4048b5226e8547108aaddd586ba492df66fc0b4da43696277b509d051eda1a6036cd4ee6a9f29ac3dd46a77b55760a98845a5a85490b1912250bbab4d2965e755190306b5dac3ffd63ed6fe73577b6180a0da03b31c14bc4c11b0a9517ba8d93ceb7f146422434106741ffea764c5051b593b6409c8504c8a0d56bbabf5690f1364f36279633eaab4765733b72a52f8e37fc20ef2002035183a70acb5ceff962d754d805a01dcdcaa8269d10d82550af19b56110143948ba16368516459349fa807b2c54c8054d781a15c06bc504b8f20a1aa5a00b343968da9213b864252b5d781b5950143b6606ecf48a4323908365441477a12ce36d78da6b0c3a657071d304db0fcb631ac23ce9a9f91e9f183ecac316717d965c8aeddd5a06ef2bf54372d64dbc29a0086e209ea11fb000603d84096a021904c20d8664182cf68d0724d46a30701f80579a902c4a686acd3bb12cf546c707a4150d1126f894c456cb51aed580221d864189e52d6d8b41bf7f55382b655d6b1371dc48035738b4e6fd860c8320011646a6143cdcd10641917e4da9999901a360306f346e63c1b19cca2a62bec1a1feb1feb190067ed4ae7243b3c2b5750a15e04413028a2206355a74a0100d410dc16e42b96ac166427473e2bdb0645f969dec880d8034bb1ab80096b8c48519b93dc759e9d60b7b36c9d14b749275b54346406b6192a2ac00710d618b00228abb012b606c4d6a76280667176ab211601383223a8008011bb9000cf2fa80104502b20af20d316f8c2482d055917e0a0e95c8050a98264a780d13da89c652000d1805d3015a841dc8c5cac9b242339900859c90ec35918b66b279174a5157b49f26c8a45938816bc8fbdc4d16c0d34126f09164b72549a81d2fcc2486cd962c43eebb004c0a1558fcd7efe26985b7ca8ddd514c0800c6c24d8df88a0587887ac746a48b976574306a48a2f26c24701d84623fecea935c574caa7fb440a3680e5c653a0bd8bbfaa548ebf6ae8107fdd704533f59e55cd6486f7c6953dbb18849aec02c41e5be80c6263476a41326397e80c13e9f1d783ee5598eb715ea1077307f808044ae99926fbc815c5405d34b1890836d007950ae1733eb4ad6c3faca9c58103ddd21680c3346ae12360981a82aeea4c10eb8dd687fabf3ed41bc9dc6a8421d54d87a188643b2019a45723a6187644e88f0ff516af9b62d8d5881888eda9b58dd5a8d1e08482f46ac42c6453c782e854af7650af28e706043ff1fad98e6c438b2b7cb46a936d462da02606e1de23e9a7972216a8f305b03eb10f32a132ef7070e44f59f02afd001c462d6433217c280b27000590f2c09c0baa41f31a69155120c1a53114c0506960464b83ee00c14aee048da364d96de750cc02d05fe0636063603364e9d8506cc1463ab7d177cd24888ca865fc4b3091b5b5856f0e30184e9b7f10195b4018166400b774bd4ee7da26b0907038704540d889a96c6a216af3ee0a2ae0ff4b02296087a0aa795026da0bb689f62908d5c21b407640d8ecd90735d5cf0ad6a1060c7beb0a0e5bcb34657aa21d304cede0adea1f4ccf1d92c85fdb066578ed460fb9463230313a3064704bd933780560625b00a64930f44653d83500317d85a1120ddeb98e79353aa416ba1a191d806e92ebf1b1b1b680cd2c0732e7540db12a19d3a23bac20ebea7a588820604715a88ccf28255cb85f810f094606b3110b575ea4bc0d5bb0a18674f44622cc3591f256fecc277c3dd3e6d3bc9693f329be81bdf389be9de7f369bf6522e4537dc3ecc827fa962963559a22961229f315dcb17ecd2b230836321405411afad6bf93539a83878adf9568595a53456441a33683c4e1dac2cd08090c19c0606fa3a7dbf86d042cc010c13a7e633d2c4ab86470cc737db02a29867493dc8c8ae05857f710e65e5e4232b4d0eb6808aa73661abc8592804da0df985cb6dd7825d9b77178062961ebc8c8321043f6f7a1ca97e1ef4739c318bb39f94838fea872048a15786d616fe390a0765835f05be66840c6b6ab8c0d97b95cbdf336ddaa09188eeaedafd84e95e9eba159484106c395643c47d4103191650b3bddae32753c54c6ccc0bb5c058d001955c6a1708bac5f73d33ab9817fabedee5c0c81efc672b18aac37210bdc6d5559cbcba003097514488d3556df48287a3c6d38bf819cb74373e601aa4a0519de26c25bc944ee748bebc6cacdc8d9ac9a4654448b2f01e1f6ee2de87175015926a5e46d04b7c87d1b81d0d9ecb13c95af5814f20846a1128e456e44524126a1ae8e8c5bd5f144f01a15ba90b261fbec7c237ac15cc3d4b8dd90874bb37d237a9e166440746372b71e9a8a16401129dd7386dbb7a576ebf61a4047b6096e362e81cfe766d4fc07d5a28e79239a41de8da8f9aa6ee0aeba11cd9d69dc8ed2ad1966671a901a0682150d840c9a3662ec6c0ba92a91b079f68dc85c63fc84f42e99fa99fb59b17c88ac0226f26238edc0b8ca873af0385764f1284dc8bb0816f9130b97bbed1ff6053d3782f09e2ca50a38df95bca0fd26e00347703170a4510bbb4f9e2d737134b3d14276c8477364446881cae785c086423492a63c549430ed5815ed52e39368213f562b2cc42a57d68d038a0340410148a74655f8622f7811927081050b71cb444117e30ac8003241372021848175d713e251cbc8781b09fab62af8c16825f14b312aefbc72dc5176342fd6f142e07b0c2688b5d01103a5c063cfacc8f3edc105513db940a104e36d3a49c28ae025644c2ea2e7ab10d6d800041f91656f91c92e79302e10e366950131c19e3b1a02032282943713f515b31b91f3f7740021745f8d563451f82a7df220bf05b79099cbe0efcfa35b722884bd5809588096a354c3d85bc03463ee94ebb075c42c0ced30fd3a221662967b91048f0aa6fa16b9f923c1bd55cee5ca84c648f1be2aa3b95905677254fa817733ff851a432e00a16a6e1eb380617315f53284a03196b6fae80742d5b5ee45a99d9fdef2723d29946e3efd603300b782f7379d273beb352054d0d0d9b6035529f787332a3b6bffa108c0a616cb18484a80a10a9e11eb85db0bc47232418a4b4a81f07808bb0c8c000cb7149ce258597bb3d9c03c48df66403f1b21aa122f5410eaa6002f669fdc9e0a5eaf4b0387e1d925a11d23cb5ecd47d801e2a01ce8b5734cabd47e96e3e0f45ae56578a15eaa416d86a1f0a7898cc77f0f77e245484875139f4be473c053e04a1ad4c2840041770ec90c1e388921b5cc606c3f9cf163168460f34314a8005683a420cbfb019b5d0231816523eb2294e5f1c225301b02c32801c62c488ddd253046b7c82802e2119f251ae08195a89a07975128d61560612ae8c84a87a672940f9805ce8974ae0e540ca901b0c5171213590c23bbf07c6561e669c2e8c0b44b8232b0453ce2220b544326b81119618dc9b9ff354dce3f3d5a064640ba8c94529067675173e06ca6d0b3fa3166f0150f23d3a287d4baad03c69869514709e6e55fe1aafc573c7a4d3edf5dd631831a22c48b79d6a88e146ce541f0b10ce30c5330544196c1a3f7b99aed58d699b7e70461a135773ea5d6391370bd6aab7b6d0ccf9ec5f31121886164f17c46f55433b86f263a03134fb7a88e98861c3b88a7fbeb24b5828cca0811ab55c7e430e7cacb3926ef2d22a8341f8eeadc444607f34cd939878aa6868c99ecc02d621fa9a50afbbda052e0306a20f51209af42b940a645e13abfe7fdec53352a809e33ac7f7c1bac91457333f20e7dc3332913a453d94a2d2f7ec6660ff85ab1478069c8d2416ab9f581cc733742f28c793cc391a98d191554ca902f57a810d48a0ab283098f3b366c66814091a6824261f4e00d6930b9684ca0d0b840a327d1e031bb37da072138bc676ea17f30343ff3d4ff16cc83b46d4df0cf7400920631108bac5ac80e21c7a74178baa7cbab0eb3f300c4dc0c4133039d3d9d8d150364a59b8e4c30b49df51a1fd2f97c26686785f9a9517fa4b36dd78002b2ac681f2a99690b8e715e9267ecdb08f2e849192bd8f2bfd4710ee399446b6ae999b98e8121557c128500461605ac23c10a1cd9d4ff4487fdf1e01ebdb329ded194e0040d35b7ea476c4447523eee1af1646cac3feeeac9149c82e11e7d3795e6029d7cf44a088eedf27f02b4a077f25363de02cfffc55dee115b0219275e6d60c4df656cfb2109a714972140d4745218391225420d400dfc111cc83d25d31de287d4048b41ba4be4984efc758d093201d3084e5678ad336e03c693540a2ca82553425f6d3ac94ea6a183892c1c8434c4d269028e80a36b21151dcc78aa9eea2b03317e642b515b6a876efc28c9a48424de2dcadb9a91144530441969f2810690ee23cb31b8ed280046750cb3726038cfce72d001338dd26d569e9a494b85de92999515e8ac19e94ccb4a3779cba330bcb36cdbcda466bb36048408041bce210e6073e1866922091b2a416b82552d31e427f3249e840dd54e772e5fa9d2ef81ab3896e6d7081d104429aa416583f52c4ae7247cafce29890fb7ff7727c6331c83b74c432098735e0ba9af0d602a601a087b6ea4d4ee6adb3fec8f98fa61b08a8e6d7e0243d83564c4402327db422ae6a233385f540e2a8ba069dae7f87e623e38cf1153398e8259aabce8784a028e693cda343a1a75213bab128993d8bb0bb9d904281f64ff104d419e780959cce809d917298decd40c8a419698d55d7c3f1caff9414794332490524bc1467667a43233d12d7486a660db93036ece20a21d3ff5964d30668565e6071102597d9fc8ca42e54a40768d82ac0c2493120cddec3c4b0650d86527669512646550e22707152793a312595954130059b4fd20113b0f3d38babecb4d2fc63a2ff134841fb83bcedb143c9dd84c005c8b97eea56aa815aa1c444f9c4ed3454f6fc53f9d167d1b99f0bce835bd5399ec79cfe9006afc135e6ed8dd02fae60082997b092c7442140105fbba4520b13d9d0230db23159927a78defcb38bb464930c13122af8f5212e7528772a5e5625d4f40c2370759ae092ca01775adf7d9a922004f452b020874b3cb103e9b833280c8c2fe3e7625e0f01335153042975f352caeaeab46f5fad9cc4240f7c3f42aa005235a8c672312c511e6c184bb5c79f95c9914424762315010af5508146c4215c2440ec842a8b505f4c2cef6a0b0c16c7611ea029378307c2f00851f9b2884342e8da70f85d8259060dc2f81d9885dec2d4f71462a86a55a10ecb415c2243e78521232faa25d21fc1ee08b5dc3fd1e0530c1f4288008032bb2f299862de52f1358cc15d6fcb048b81d50b49ae4230576fa09cf17acdccd1fe9c299992ee7249895758a1029ce3d5323328102697ed1fcbb023925c18a28a48dc5cbb09589a70caa2284121667217aae215a05ab420373cdd60a48958a3bd9a957a08857169536890bf0429bb81270f96ae5d9d50528c5e67611629e155e1ea74a61162a5b4891295f809667e117a114d819056839fe80b218150acaf61e5cc6285f449eafa1002570295481add08200a37c7512be88ea1815aa96c0ab50c17c67481156e4eda8087e5519b9ad898c479f4c5298544628acde25ccc23a9ee966b16ce2a8d8883b1f782b3c477a1678f0e1c2ccf42872363357f70248b372f9f78fecf0bb1739b92973e82cdb7b87ba520224f87e471930811f98b15959e86c6ef42189b779706e1d16c345ce94a4a197460a427bf3014ca016f57db4f155982f04bb1cb9406b642103a24c90588cec5926a72b405494490dc3a22c775e79406b42f741707a2ecc294e283b7f8bdcbc56f2743ffe3937978bb16f0e72625db0f5f798c23747f6ceed199c5d22cb2a0c8d0d80789a2df008def898f9a9dc5f96184a3fd93bee749e1c6e8ffa7520548d8703f057dbb5fdbc60e330d827e4ff04ffa3f7c123b353370e44cffe66bbee1bcdfc9fa03b84616e958509df1cca90e285370647837c4b3c8c87883d72256a8bd10658f1440e14b6254a8c35e52c4d0036c43833278cccc8cee1412ad4c8cdb6d7bb789eb77d1a241888e1b0253c88211c540eec7f12c0dbe3a676b8b6525bb0b1ea8f05b505c78f87e15bf0c1bf684bbe76573b19b4d1beb8ff20d8aec100df853db92e822701aa34f616eed087ef61e5c3f7b0836dacda1f03363a9df5f810a1f18fdaf0681f6495030e97eeb2a0518f97c4090f689686e52b7b30c9cbe044c9a5e14fe2b604bca7999703d500bf58a464b79f4e4a96008e98fc947f35624c1a5521e0af43553062df4f2981762680cbe094ad930e8c40c29601aed23fa68514aee5959c41d1b7faca56263c5e56967ea53e0f9fb1117c68a5044eb479541a36e28872487b2407f136e5814fceb66a68551783102fe271ae15796a35b2aea490cd6d8133d726fc03f1fcc10ff13a45e504c1383ccb8fbfe16137a700a720aaa67657e3b24e6040de5b01d3f909a7d0b7c4bb1ff819de5874570b22fd4e4ffcebd38d4fc9f73ffcd76f9e5ee5c7e2f007df63be4386e21d0b380ff4f443e2c47a39289312b7d1acb72e407ba4f9f52165e1c25b220a80a3c10aefe429037ba1be5cefe0b7072886ad23dbf68fcde601a6e3592fc28591fc4580a730d73ca820da352b9b87a0fa97af64e5e604a2e682c6e35173815261a917e182e8d43d025b7ef7d62bb25974679be110e22745b7b4f949632f60955f7a57f966bb31bfd22eb8762ef3c6bc3e1d5325952ca8f4a37f8d539fce83a5e8a1d6f8d8b8affdfdf7df7735ae6356b9aeef213a327b5a800a3f30257897d779bb380ffeed80bc340531808957b071bab0ef63b0d963e323aa3db4daf57fd5ef6a3c80bff670dffce43dfec597e6da434dac8b757eef84d87aadfff1d06c3e349a128fc0b3ffe2e6ddc31a101b79cb142fe233dad51e3eb5eae2fd5d6d68d0da43a3d1b8bf6f8877b509c1c6b6f6d0f00606d51e9a4db17e7f579b61a5f650bfabf583ffcbbffe328152f79ea70aa756bfabbdc46bd9215bbfd6f7f5f627fe4ae1d6ae3d34c4fa5ded91619d57e305c1da43e35f6d51acb7ee1b7fdcd526364ff954ffd468b5c54f8dbfef6ae30468ab5d6fb65bad763304adff7d57eb16536b7cbabf17dbf54f1c74f9d75f8ee1d848a93dfc6ffdae7e57ff8f37e61ab2b26f5dac3ab657ded2784531c1ad8eb194f39b1dab5ddf187048f2fec6e4b58b6169e7f72d4677d8040c5bedb322a9b35337bdb8effd9ebef77bfadeefe97bbfa7effd9ebef77bfa7e937bfa42617bfb9bf77ebfbbf5c238bef30f895c564b8abe1a9256598a324f8d0a76156e73583d3c5a9e75ea2e2af1fd785d89e375b73d48f77e6aeefdd45cf55373beaf307363f297d98bfc05b71d2bef32de7e3ff1163ee3373b7fb39c73297f6e39a877afefbbd7f71fe6f50d1605ee82deaa95ea1278e1dee002fedbbf76bbf650437baa0ebbc32d1427545a34b4e186623098d6e160fce9abdb3eae448dc88b27475ef49cd54221f262ee28dd96868cb92bbdb436b258df85b8d0fdc31d7f7e6a7c7d7d3c783f714a60ffb0438b06918de94c5a34f672bf57975e5adfa0d87686eed0eee24775a84f76b231e5300b69393a8245dbe1f518bfdcefbf6e1e9d713780333a1ad47b4c5a4e0934c6f84fc3fe32ec3eaac3ee08cbfd367e16db8ed2ef99b23e775f39dccbf093df3e0ef3889501d94b2fc34f436352873a71a4b07c9f06a7ab49fd5e7d95c4e3795b6939d9409dec953ed9c978f869b8f5eaac81458b40dc71568b0619f6a51dc49d3ae8cf54b0501cb09c9ab278ef0cd59056a7edb5a3db697f7d0cd2f489ad2c277569391a4dbbdfb1be64ee8065af21bd7446d36e672779f524c7615f6948cb497dd80feb312caeef5631578b567dd65434a83766ab85721c6ecc7fc5ea5547cb0ee1e3278ba36fd262521fe27d304ebcbd5322e9bd863c982e568b43233546bcaff7506f8b603125d0ebe7d5fdf8e9793f9e8df75f5f95cef36ccc269f9febe36ebdf5e7d34afcfa3adb4f364f8df1eb536bb21975a6dd6154d6fad9a7bb7e09cb1f99b24eea60d1b36783d14ed189c76b60d132f2da009b53d7cbbfd086212104be697c142a2da7545a0e55a5af91613f3e779e55b01cab30566769f1ecc30d24028dad2ae9c4854d9e4698f4728fa37a0cea5f82ff61dd306c4e7750ef19d2acb75989ed866c4cfff4f8b7521f3488d2ef6d57cba996ec3b7f8ca13871c1b2530fe7f22c2cf3a5a3adc4c94e59b4eac37e4b93173355d2dbee7030a5d24bc794c496e9f36c711b149db8ca60aec94f2da20c941dd4d9776fc33c2cb3db71a4e5b4293747d6a83956656fad09e7d44c959a73c2c762251e1ab0e9f171114f8a6039aa2b8b9efdaacfeb8ad876417d624a83616c7de36bdfb405fb33fc271e1d9501e76388a339d97dc4f2625e5f2da69ad27f4ae2751fb1d49f1f57cd910907de7ac469cc783de545cf9471e7f3cb6cfa32ec8f6c599c58c3c1884071ee2afa7c7b5acfc87138904c684c1babc55e95c37aba97c6a84594274553faf3e66a495e95c588f75b304e54bdb0d69dd15b893d1b2c26bb59738e791d9e45b2853831b74ad19117bda3d29fbb6fa5e38d9578033a46c7792b0dd06f1f95a748967c068ba97b712ea867bc68298b11817a8b28fdf9f179de19cdc4f946598ec8acdf76953cf9a41347e99ed749eab78e0bbded48cb711e2fef56ba4956cde737f3f2f4a9f53a1c983b599f1f03be4db465d8efedc14b4793f56795b71deaf323cce6df2f2779371257cb91b95a8cec641b466ce5cbec67a8cf75b054793d267edaf0d416c35b9708241d575a4826ea72391ca4e161bc1c575a4e76ca72b4f1ca21413983b03cbea6f6f660ced7fa49d0ee21d7b5c4afaf8f2dae6b25d7356f5de5753a4a8b4903eaa48ee66d03eaed061c4cd6509cd795e563a2cfa3b1dc36cc5573b483625b57baad9758bd76527fbe97fbed96a7030ec6097c2e9b607fee2a7d4f0ec6f984f31541830e6fa327bba64b49930773cd5be7b76d5f0626f4b908efb812db3e8f75f99ad74aaca5a7fe8be0992c4ef9da82c1a2b581fa9c498b567db5d8c7ebcaeb533fe9a2099ef6f3f134d47b791f526530ddc323dd7d6d4e36a03f67ab97165b2d5a9a24faf4bfeabd4f7c8e7ce57aec4ba833f78e5f8fc3fdf875c6c69f9ff82fd97fbd2991971d7bb59c92afba7994c5fb4d620c793dbaa38d2cb6eabc8cb93e77a118e80a9b2767dcf574e354bb8a74e4d84f8f64e66bc41f33c5959bf33dacf79e5fe27c96859fbd2685f33ef9cbd29536bc5f566cfc79cbfbe5bc0d17f5a76239cdd7ade1206a83aa2c2744ea76307ae1b26ca6be2ec806eaeda32c4af5afdbb02fec5d24d71a13b26ace5db09cb6a6cdd14e59768ebe5c6c37a03e215f133cf898d29563bfd35a18fb8d36f260eec99f97d97395fe3d833baddbb1df45fdfc512db90ec77ea3adb490346571a8cff59ead2c6679f5bea9fe7e45df96d4ef33fb204baf2aee033c92f87a7d6bfe9d8b1ae1ba7d684b26d68d6ec7846ec7efe32597052dcedb1e5f2bfadc452f1d1d2c474785a72d1a6fe5d572bae92df8569f68729f6ce4c6a4b1e2ebd3d3f72aa7a1793a39f9cee544b2ff7bb747dbc1e6f473b8a664e88fb15fbe0e982f5303fdb239c7d2d2d3df892f23fc35529a7776d028b18ec575c4d82fb487f2db98a783658e697add0e6d0dbc10273b599f684a57dbaf96238ddb4bdcd7f4a5abc4d763e3cbcb5e1db98d40971b9dc9c7914b94212ee4ab741d3cbfce90b0938e3723db2f5d65021613576e4e7692f1ec44f3cb6d47b665a07b9bca800c7cdd6d6c7ced76e2fd41476271db47eed65cbe64c9e69167bf66b531f49d0d09e3fac766b57ca6b0df73393dce63d0558dc8b6dd92a791bbb5875dc8e6a13d94ae771756ae83271bf148e67abadc9cbb2b713e92baaa718dec1eb97b55d17b2e58f4b0dc9f1fbf74950b6d87e697793d6b5dc72bb1b7e57e23b9397a969ba33a9f4b93cf4fad49e8a34cc08f88d29f6c43db69b8191e27c76d265d6f7e2d0e44e905f3090f0fe3cfc346e4fb4cc08f1caee74e97da9ed7415a9eea327e7d3c8e3f2774fbf0e797319fd4b9cef9ba98f3f579031bf1f2c6fbf1cbf0cb05b9806363c2750bf9ac7e71bb256b2d48da187f86fcfe550cc7939d8d4bd2b7f6a866d83a726a8c431b2d778cb37932cbd61ac969fe2fe237ae7f9ef4f5a2720ebb95d88be4fea279d2317d5a8ff4cb4b8bfb657c5b8964f224e7877aa19e17d8678a4eb6dc3ee5ed51faed391c7408c467ebe1002c7a8ed29b3457cb119bf77b0674a1f9d5e8b8725332a5fedce57323ab9c4cf974d1368cfd8c535fce8cb99db085337eb974823912aefd527faeaf96735b7922ce4a6f37647dfaa22c0e24731dbfa6de05badc342cbbdb71bd320753ba5a3eab527fee8005f755f41cf4d231657dc47da2dbe160eaae16920e1663e742fd425dbb2e73d9c6650ca639fc11fb19810cf2e4f6f465b56819d24b495cdebee6d494759f67bd75ad37d2645df1faf90bcee1f3d30f87fcfdbc986ef93a021b31dfc81575b89e0ef77d731f10f779f0bda76d099ca909f5f926f2d96f437e7abe8c6b745cb0687b7a01efabc9e7c7d62453372fd0d1f3d7e44b6b6ff8c37228db4f76f9bf95e514cbcdb923f5460db93fbbd0168fe7f6d27254f7f8377fcd29f2d93d85b6d470e095c9f73234a5dbd1e4fe61a734c7ea6ad16a0dfbed86d27f9f173f6f5ef46cb9df6e96e8ef2d588ec8aa198def89a732f597c48fafb3a6dc1c99ca60cbfd9af5f1e76de3dc2e89fd425f6db7b40cba5a5e3c877a2ef9c5e6c6693ea88a48eaa04f9c600f2dd07b862a0cf614be96e13fae1ff4db1b59dcf331f85662cc431dd0d33d677acf91c459595cbeff59078b56a0d75c31e7c2f93aeb6d25ee9b6b4ee2bef8737d2d73cfa740373bfd383f1c953e7156de7e7eab23f5a7dc963bce062322e9e49ecff14533e177a5a366b85f7688f4db2a65ae16930d2f83d37e5e1c6cb999e797fa8ef229d317f783e493313982a7a46f63de6f477679b097f8c3f4b8600c82bd796d97dcdfedb0d56242bd5810b75397dd8e2b2d7a1bd0cd8db150437e1c0e02ff50f7bed418c9cde96e25b6edaa72e51a9994e2fbf43c77c76f956b84a56d0f2a3727f568cddd7a3e3323c716af2effcecb63297ef2f64d737d3c89df88c73110d81cf3f5a6e3adcd7936eae987a53e71794c4cc8572f8bd65be4e4f167cac9d5b263bef6db9af434d2e47ecf5971df0fb7db2ead6f1e1f770834a666ae4faffb581c2f13ea26fd9e83783ccc4b7adecdd484cfd2ed74664fdbeabaa331ddc06e793ebffd3c1bc9a1aff6cb20256344d658e97397fb22a4e5a4337b2281af63eecdd57cdf5f76ddafd719ff7173eff8e7ebe3cf9d7b9e3c527adcbf989e833f541e0e469aa44be6caede8506fb3e1d3a13b1c4c77c37ee097c61d533626f5d5a2b5e1716955659cd29fec7faa2e4a58cadf3eafafc46930bf8852a16e997ae3f3d2e4f3752c8bbdedb3deb3bd7e8ac790bccfb9f89c737fe69c9396d38df474d8ad16d3ee6a71d0647d12d885f321d75b7ee4bcf3e2bdba9d68efca9b738311e17a6860077a7b0fc3be575f1cd517bfcfc1f41c3cb3ddc4ac3dd5ef682b8a997bd225f5de9bf86b4691fff932ee1eea64e7ed1772bff166dce2313c45fd92dee3bdb0d71cfc3ac5be93d3be0fc99281defec12cb2b77e84df265813e37332b4edce75536f2de9766cbeeeaf4eebfe7fa57e9ada778bed79c2b7eb9fe7322c2f26e5dd4eccb613b9dcdcff02baea32dc73f7f5fde71f3927fdf8a8203e81eba62b51e3e7848e197233186bcf47b3e17e5569395665f13ef0bb7ab2e9f80ff7a97a3ed5ecf8f7779f6a9e4f757c54f7e39fe953d57dfde039758ee247eaadb0dfde9efb4867277f687c6ef6db4eb03feec5b9f078fdaabaeb175c5efedc5e36569af32575c5cc38c9ef29fbde286f7fba7edae4f1503f513ff5f7b4eb134d1a482614dbee4fb5137b1d5716f91aae1165395695fe1f2ad4e7da4a54d5e7536cdb15f26d545f3533e3ceb265cc77d8675f34250dea445be907c2e5533a56efdd56fce9b662e3d7988b9edfdcfec13aa8b7b715df03e46790b93f1cf4e78d944cfccacfaec9fd791dbabf83bf26ecef779fcd2fe2b369fc649f4d300f5bfc4c5c0f0d3cfee1e7857ee0bee1d91efe33dfbff0cfe0a7ecc23eafe78c49a778ebdf423eca7a7b2bcde6f790c3f1d898efe7b379dfd72fbfaf7f9cbc659f2367de2e9f69293a59fe9e4b6789ae3a4f993d0e275b352d439ad179aa9c732eb9638b5762db91fa241a9b85383ff0ef2d40f72cf63df7fca07fc645e1e7fd0af876a4c13e497cc3e65c6e149c71489ebd2d2ae7742600a7cf16f9b4be74b78edc6f6f029996db378a17df5f6063c4e2febdbe6b4e77f365672f2d87676782a622d16463faaa883d579e4f35a93936bebcb462e7418992c9d779be8dd2eb7dfc9c3b8f99cc399752d95f12c54847b2f6c7c5b784653fabd02b93ef1bf4eafcbb30b2cefd301d4d36b62a88d6994e1dea3d5dd2c9a6ba6c1a7efba9fa22a6e5e562a66ff28a3a6cafa613970f4f12f709ff50dded79ffe7ebea67ea6ed139e8d7e6bcfeab9ca709f618c2f334bb53fcf9fbbcf809f3e26abde9c453d5fdeb5ecc72fafce20ff5afc7e4c536b4f347bfdadc88e6c370a0984a5fe3df804aee8ffdf7c6245799afe5ecfc93de79859dff4ff4b773f9f4fc33e5d3118ada30616f92e96e9edaabfce171ca7e4cd6990f30bec71cf7c5f37909dd8ea12ce6a938af801f71e718eca3578f19c1f4a7fa21c69b61f9f24bee45677dffaca4bd5f42fedd76effb5738db33cef8bec88f9393fef7b2d2315b2ffdb9260fa6f459d4c84a64793e829bcacc709fcc8fc39da95e9dba9d537c27ee5069d1b395beaaae827afdf3f4c851c7fbdeef2c15fb8a6fe35bff82ff2be6cc2f11bbf11ac44984ebce8f9c237ccf3816d75f70e62d27d6ea3d9e23239ee32dfb48ff44fdf2d789e748c4e1747fa42ed9d94983337d91c474c3f8dcdcc9d8f7157afb3c837fe0f713cee7c066b538982bb17dcdfed075fae2f9f7aaab94f9f3f7a436bf440cf1e7d562cabf7bb8fb65f78a9f4e715ebf47ecc6a93dbfd1fe70f4fdb7ffd2fde15f612e46314a3f5207ad12cff8127e536f30fe1dced558617fbfcfc35f661e1e7e8179f867f25b90c35f35a6d1fb66e5d7c529dee8f7908fa91836c2ff3f979b33c53e97771fe71b7c9ce3e36c3ffea9dfd7f375c797ac6faebabfa8eedae771ba33069bfc1e991993a3fafe0ef394b76dfeaaf0fbc7660da20c46e6aa39f97de291dfb44ff9d37d3a8df1e7f1d53e9d6cbc8ed2c565e8a4f13bca597fe9ed8632e8349441e5ef8fa7ef74cb8c9f0cbfef93d36f5839dd3750fa1bc7deb7ccb3bed33ba857ff0eb771e17bcea76ffd67f3b6e1ddf7921983ace47d33fc897f573c2b5ef06cbc12ef29bd0a27655ac658e4dfbf97d9ff691919adabdbecfa9eeb79a38cef2b572f3733d6f26cbc33fc9d46de19daea7528f4695dee874c1f5cb63e3a124ff7ec55f0e55dae43669cf81575c8a453b20e9936d01575c8a453b20e59765ff6bc4fcce5d232efcb8535e8bafb250aeb72e9db68a5faa5e27d3285e353105b9e5197679a694786f22baaebdbef08e3eb7c5d9ab7eb9eae346f1fa5e5b491f47de4c4fa93d3f7eaa1dbde4acb8e2d3789772756ea2ecc629b539fdf43df1ee1fa4ecf5f737c3fc26bd3f70165ae91c664c37dbcc30d55873a71f8bdaf897e4cf399ded849fe1d8aa6ac4f5cc9bf975693d3b6bf71ba9b2b639cf16810de03c66319b4ba3278fc7177e5c6eab1f2eebb9d3b4a2f31e607ffcedcfb0cfd7b94e0c98cb6bddf4ff53bdc4ff5ffec7d5977e238d3f07fe13aef604cc8347d17483090e00c9b6dfc9ef7ccf11663bc3ed884e53bf3dfbf53de174936597a7a9ee90b77074bb6a552a9547bfd7bea53c5b46b11f193ffe2da75c033ab0cb78ee55d3dabd1b2ced573be479e29e5b305bf7f9bd4624acf1519e813d437a9e62dcdf3dc8af73cbc779e3990971616c7401ea9354e6e3524febaba4025b820ea2785e3759e968df801749dcedc5527eb351c270eaf52dd6f3596b3aea60dd4bf5ac4b59543fec45204f0b79b55e2b8243eacc1bc95215704e43187ba93e13e89d68ae7b90b416712e6052ed4abacf242a47dfb6f8a7babdd83421507619f1c5561813937131cf970bdc33c2f8bde33cdf50b9ef8b57a104f1c22e40c144d9bbbf8b1d6d64e6d50bb93289314e95e13b908815bd77f97aad604fd25ffa4f24f22375f04bab755477da76847fcb0ec03729125db8bcb0a78122bc8eb17ff8beb26df1bd27841290f3f51dde4e135b251a13e7d7219ca9833c0ef6fc90d52bd7cac5fc8c77d636cb8883abac6bd31dbdd9f5e86d4696650507f2f873b28ba7d6fac6be864a17eba81f56b44d875efdfcf7f22e1f24e3eb44287a2fdaa3eaac2bccc5b8cfd6adf3c8f3f7c8fdc14d28d1e926e0cef9bd4a9afb3cdcd97cb019de2cb98bb88fc5cdf3866545fe4115b1f71ab3eaa5b95e1ba1bc1facc9ad44d75d2057a28d3d3ff802f050e3e691da55172366178926a7d42e6e561f0387b183dcc865477be1e3d3cafe614fb701fbcacb60c6b509dd9c3e6c4ae1e4f2fabcd79be46c79b15ce10243c90faf11c5f8d8401d878a945474d6acb66b57e9d59006dcfbc8ab69f3983cec63e791bca8a6b504eee72b697cb33d8e7871d473d773c6558cf1be464a0905ef147b7994f738e965cb99f9be6bdd011e7ff51e5a7907772ba7e4cfd0faee1fd13d947aff0a3dd325c1bd7dcd43f4386988c1bea9a863fb8ee261d74f067519e070acf94ad6c2fb68a3dbfee1b25fe85048bdcfafb39f8b2e0472bd3f3741c2a6321ce74e47b123c2ae29e69cdd55d739af07cdf70df54f8cb7b048f8f3aa708f8d8602f024d59d0234a14d4ad26ccaedf1fd1b921fcda1bff88bd91d146d84f23dc33385d5372453237c0b5b1ae0c4df3ab3a3304bdc5c13f3ebbd07318a178910476e958621fb56d0c8f82fc54bc6ccb94196e2d810da5cb514bbec74a7cafa332e0e7e8ea2cea39243f14eb6619ee208ed93f6483b40e393d27bea63b667dbf7d08b6423d7f43f66541ca06193fb202bad35143bf884fe0b333ddc0153859a27d557895681b125665fc2bfc2ed3ff5a1bd98fd5c9cc5de4b893fb29edf9b81e248d0b14b21c65af0acd51aa50c85b98ad79b18efb323d2b845951661f17ce2c903b8f22df3315aa7fa8e4e97242fda62574239b8ed0652d75cce5e21d0afe59151afbdfa33f99baea7871542e3f91fe64f718ea4e9e77883c96b5bad37fbdedb71a77db2deb8d6a61f15e5d06fdbc7a3cff54ba0c442d07e53cc8f8cde52df2cc2bd0c318fe08581808dfb4585e6f0ee377af2b45f09f42db9f9f1af194363256fcf3bfe3207d89bfe03b22f87b8d8116a9f4a897c41924ba913afd53ea37abbba53e189c700687622cc3e77f23a96381f267fd02dcfb2cbfdd86eb95b7d173d7f936375da3d81f58a122beeec3f398bb35f36ae0d380f0995daeaff34340d0a3cbf47cd4cbb6641cddc2d772adf8b0267a4983a7c1e6cf6ed561459e46d0acc40fe2a84fcf9d529d6d6c3c5386eff1be4ae092f97e94fcbdabf3cb7c40ba31bd19cfde0036385ff7fc3c097049f7c627c2a55cc30a0b97d4affdebe1829c670c97a71a1a62e4f0179d33b74eee2bca00e9fe409fefa8752ac8204d7ccce3f97dc8ffa4bace255e8cf4fe4cff755ba60111ad1feade734e9786c64fa02b04d911c5af5a012577b823e8949ec64de449f390b3ab54f1014f87eb7d76b3cbd8a4b1beec71c3b3243b0fbc775b8e67c2f952e5aeaafc8cc02facbf395167823b17926b1ae7d95b7c4e4ee98a6eb6ccd3c5f4064d57deb33e887522c664e36319d0fb21778574365c8b0aff83e51530fe7d253a40e2ab507a93e6eb8bf6256d00cf4cc75ae3df868dc5aa932389724fddfe25d8311074b7d999528b0335fa18c4657f7d2c724187b824e9e173976d99c4384b877dd9088b31477d1afe7dd9de4961f75fbc77c8b412ce5ad6521cd1dbd0dc48b6174799b60eb10e1c4fd3936bece79e47d8ea2c9692bbd32dc42ea3e5b4abdf779287930670bfd7b533d8b2b8b36a5bbb585f59b04144792db907b9cb0672977d9c1b9ff3de25cdf5b8d0377efe59738edbb8a12acc3ee79d2647830e7339e68c89d100478683dfe1bc1785292d857c8cab97f8db380fe6087214d0e23ac957d03f6bcb4f5aaf30a66704f52fcf6b9b732481a514c7fc2418abd6c69810ce12328f14cb10d83992e64fa65fa9ed1ac5e71174440de8fff5b9142afc29665c47b9a81faba553a433fc278a618fe7749dbe0abdb70afec0357c2fee2c22eb89127cb8524f84c69982bdae249f57bf875eeff2fe28fcfec96d8d95fd8bd26dc73ce3b56340c86bb93d5c7c5795d6a1f4f9ef8d9346cc09b77f11b8778d0e9e048f0afe5f31866bf4f3c43541bea7e118aed6ddd7e412e85939dfd6aadcd1005715a6f7d118faca3b1a7e3b95991bebe10beb027a10ee2cf2bd9db8d41d25e3d35fc5b85e5a6207079d0ec8a9ca186a83f648df3d44f0e69a8c1fe9f351bb8636678bc2d4825ce03999311747a178cfd9b97340e8f6df33c61f0d63d277af197f59aeb97ecc004b867b55c318492ef1cd8058b33426ecd9b6cce7f81d08bafa84e41992fb29ddcdeb0daa35ed205e8135a8e3cbb21caf90f171a08315e8305ffcebc61ef91b988330fd127f92fffa189af1ec27ca2f706fcc96b7c7288ea6fa4c91ae63742d991ffc3ccd13497317883d29e8edc77ec3b802578fe2b2f560b69a90e2b213bba43eb16a78411be6303237c2624bf613adc470ebeff78940c3e513e33c7c991e99f36c3ffe82395daa69e17c66cc523ede130fdb94fe0da7ab2bedff08bfe8ebd6100be39c6f35f4fb2c5fa95a1c31fb86043920879366307672b68ccf876f5ca7a2d1bb078a33dd6ae5331721afd7fa20a0fd72cb7b779ef914277afc7b926d3b1fbb1cdbb329f0a72df73bc67af8c4e69d7c6756fcfe2458a73edaf7583bb85caaf1933d33987e997f3a55dad35646571399b3e97813796632bc329fc552f127e57edd0457e33e5c32aed278b1768d0a0eaca42e97f83584f475c3f7cc8d3045e760b44bdf31adc76a9c876512fcd8cbdfbf20fc11ca7deafc34cafd871b9edd837ff09ae9bfc95f8adb80fff74e8293e57a54cf6bc8311d40ecf65ca6fb3ed6fe55816bf1b950062c8ebf88afe53328cc2568fad373df486091f2039dc19b4c9f2ecf67c5af813b0a8e519e93467310cfb10fb0f9a57b00f6c950c5d29885337d93211f973d779aeed9ec994528c74e8678bf9bf2b331fe38cf7436ff25377890e99e2df16a4789f39b2be76fce73cdbb72e3b8760fcc15bb6f88ebbf11f739c8ab3fb51ae3bc15f5ff00ae833f83210ae0fb75223f137f2b4fcb87460d9fe1643c342eae4a3bd6f12657f2ed4dfd9386557d18faacbbc24729b7676be844998fc0edb36afe9de2d9fd3edf2724af838a09bfffc1b5e7d9bd4af41520c55b96f195ddabeb0cb6a8ef21795b846ea3610ce13f33be36b52bdd1b381eb04c63f13e854d62754bebe47cc9fe8a697f537f42186746aba23cd2f7eed3b297ed3d0bbb3f8bb966f0be0d39fa7c35bf8df241ada72988fc6ac418d05ff4e1b3e983add8e05bc01dc4476b3ea7fb07d1b61c55986e531d28c91706fbdeabf703938d239b331a9f71be8e04bd0549c748844fee8a7cbf1aac5bf68d25dfcc4f89d45ea59d75cf21ecfcc965a73248ba7743796afcc3d6192d7f9060f48e3871d0d16b4b527c368eaf43ecd3224febc9b61ad6eec79e31c965b36f2adfa3629cf145bee7a88c5ea14171fd45bc0c965d9087eba80a7312fd43ae492ae794d6036298a29ae7c0f70f923842177cb79f18f64d195b53d95e58dab02cc75807b133edc05e063e7df2f0e83518fffbf62ce89973b2dbfa116408d11381bf4ccfc75a785cb787cd9c9cd7c8d790702614ae2946763f7ec11c2afa86cf9c47b12e1a4a5649f09a0b201f776fc9f74c51d0cbe3cced27d06ff5b2f78eeae87f0537c2ba2d316c2a722eb4417e109e6e8ad796fa6c677880955930ebbb86f97f2e6cd277371c8b25dbe179cbc01c55c63a88943507f888f6c857e8759377c07a182291a741f91b5f21eb673a82bc9ee2e3b4487749e3ac3d773f722ee3f1b6a9ef7d24e36d6cacbe20b9c01edb919d04e6274bb6554aaaea83629b0556c79dbba69eec0c3a2ade76823e27ad6027d3bddd4698bb353c405e8fd578cd1378fc88f3a658a71f212376c3ba52909b0362f78edada3a880c770b708ef555406b0ff198e7d7d234e53db4a31beda31c1d35e2bacb0de004b867811c7006df9525df03bf16c80d1d9ed1ecb2c9f3bd8ecc4f2dc5ecdbeb047777ae3eabd987f87d44e0fd08cf21fd9411efb92ea732126f0c49c864603c6e5d61ebcefc7c603f815dc8526ceeb0a1d76e73d9da32c33d52d5b137f0af50bca765cf53321d0f828e22e99bb1b1473b89e60ee228b4454c453cfd40da5ed1fb22e797372cf99635d2d99b87745cd99c42bb8dd2f18fc2b2e78923044f54c697c2efebfd75f3f5a0dee17f58d4e5577838c47a34cea942fc2e32bf5a13bf5c25f591aff966e89fd1e07d400f713eeae99e41c761927d19b2da841cb136614e4f329cdcb10ff34b05e615197b6ebcece6d4ec8c7d6742a3f3e3d02716b1c670c217a4bc2e8ca5b96e08cb7f94cebc29278e11f97c922bc7174f762e3d7bb83fce90df2bf2c40dfa7e2d4ce66e4d2c4591e7cbfa27f7b3bd96c3abaaed065f0f2493cb6d8edad08b57e0cdc551dfc8f8e15fb596aeafb534b8bc18df523fc767bbb395ed9113bd7f7ed884f16eebc386ee0769de2c61f616faac86feaa7015e1c7315ca03027a8797a901cf64d360afea5f17cd8482fe12cf2b690ab7c21b563bdedba940f174d07f3e7eabb6bd8d4faedfdf01a3615587c623ea8fa73191dd754e977851f5e0d8ff88fcc9f8d822d9907f8105c53ffbb3a586e84e97923984ddf9bc64dc4b11e1fce6534346ae0d424af38cef72c276f9765c43c5e87b2c0500950798592354afaacc7534fb5a116f2dfea7b56b0752ef9b93ba5303e16655d9283f0fb2bf73113bd83a5361b53518ffa95f0873ed3cee02c0922e4f3aff83dcd0b32d6116723abe04cf1b95388d757e86b62df2de50d2b4b35f07b2a8de15adfa7b27db09437e973fdce14dba281f7a8fafe657ceff4dc147fb267eaf23d9561f673fa9dfd0d70ff90dfd93be09dead2eae11aebbaae8569124f98ecc5b2ec8d388b1acadeefccdd559161aa63aa8913c19d11c49c32e577c670c4ac333e2e8c742634f35bfb405efb6bf2c6f3dd1a7ca2834ee2c358596f1c1f11f324783d0f921ffcb1b9f54b3e297858d5d1bac91d8f7b16afdfb8160f3fc11f05bb0ec9652829bf053ea2a70c1f4de011b66fa10ed55cf4406720f1383cce5d4e1cb38de55b51b6ba5a58906950f92ae84aef6b78e206cf91fc3cb0fcc80fc1911feacbf209f3298c3ff5fd10eaf06aba93bbdc21b4477db61f8be393fae5ed8b05df44b4fd771dc938b13cf6c48c4c71b86d6c5f7f1ede9f9e96b8f3fb9db40ceda780f661a985c5557b3647bf9ae5f3c29f2bcdf65c037a57caa17695bcd08cf6e0cf9f0fe8fed16753eadf6105585bfb0ff735f9b4b1a432afda0c2edc2194b7d722d0180a68cb92bb375e6cd051b15613bc823db5b14979fad1fba0296f1ff3e925b924d1936eb72233774bb67c1cbd0c7d16664b135b83a570e1687ea376e27989c711348f5fb54138899cd3d897f1d37d4c6a6967ee3cbe72ad13992cd3bf90ce869cffc4579f05255bdb7ad325d8dab2cb50deb1cf63dbd83bf735d627a4d364bcb2c3051b9b3baf68711efbc5182fbb1fce3f3e357a4ff9f92a4f6664f95f2677f5fe20cdf5de6858166cf3d7f8828434ee69d9abf1ed67e5d9883a14f117fc86221d4c3ebe0375ee22cfd90cbf423e7cc9cf3f6eabc2e6be691443e8e5703e9d4fe4f31ecd5fe217481f97a181ff5d9a7bbd4daf94f301853ba43c58457d729597abae4563db13f1bbc8f898ca9987e01b73f9176abe39e21ed9c706f389e95bcdfb90f9c2b1f2518e27075f8dc985bd98f5b2e6707237dbcdba2f73b7110d12e6b5736b6a7722e5d922d5e92ae5822ae798b31e7f86dc505297334421b4bb594aa70ff9a55e45a697e6df2a9c57190d2de42458e7749fa5dc46453f5a72fe4f4314b69013e012ea424d2ea4c3b10ef08fa82e760f45df0d0578ec385651e27b07d0b30d0decda1b32df3743fa6e5b874d771ae56e62467e61acb0ee992f02829e4eccc4ef2184e34f971bea9afaeaa833736aca0e2bc974cfbae64c1d1a4df8e55f36fa2fb2d1e77c4867d7f0004f757341d0cf4c6f8fa91951b79e38df13a03f9add3f106a5c67faa94a1cf1e2a074bc37d926e98ef1b4921d966965eecac5d2c33322e8b5ec0add20c928887e3fb95da1114e0feaf01897c30668cc1dd018e5dcdfe2e098cacd39db799967029ce1016796b19ee14cb67ffeddb8a112695e25e7d78f8da72ed5dbc5eac0abf2707a6660759f389d7be3dce0d335f778345e7633f07f45bf6b786fccb9a9b5a1fb67714cae67a31dc9fa1265bca152bffd33520790a7b9efb54320ee17651fe4f385e7aef78fcff64c3ddf9be9801af81ed8fead72be45e5bd49f123a43f501b77d90b6979156eff60fffa705ef5efabd75be07511531a726af4431d8440673a384cdedc3c8ee2f3e4ce5d24ae25f7531ee0e3f58df72ac42c41be6c86bb0874e493fd4beef82577fc923b7ec91dbfe48e5f72c72fb9e397dcf14beef82577fc923bae913b808f9ed7cfc7b68e79dcbad2e661a90c6b12ea49946b60e4bed58be41904cc87068af627f7b3397c3cfe338c1ddc4a7cef57cce7b5319fc3a9ab8e17c79f28e6539fec1e4359e37987f0e5700a340393eb690a713b46bed65f1a6b34aee1638757f3c9f99c021fe3932b7cf13574aac023bd6d6ccfda74e755f8c5f298c88b5b953f515c4213f06764eeec9fdc7d569d8286b0bd40ed47907be73cc4477387b87ef3c7e03c776be0dea85ec07643b3b0e7d7129fd47060dfc00f090173c837158d7f94ac51942b56b6175bc55ebcaecdd1786d21651443029f42f0191e4e8780d7ebeee24d81986c24ff38bda8c220b2f153513c37379e6e455bf43654ff208f4dd83394e27056ed99f2fe9cce894c85e2eb706b4a92b17039a257c9bb9ebb498d586eb0a1231fbb6b72d7022d7b195247f6dc9c2fff401ed9d279428a7719ed4017a674165e9687b5e8b307b805fa468eb108f1a5783c44bd2fd787b02e80236c471987b9912ecbd05eceae157b648bb6b55bd13d5f8bf3cd94fcacea7014cbfbbe2e3f3f6e0835df6bbe9ddb9fe579ee25527c634e769b8c1bd25f2ba0e40e77045dc0d3b8491d4af39093edabbc296eefa3f432d8bca628fa88a405a9cc3da593bc0973c8475fa61198f39b246b97e87fcc03ac935ac0c2ec6d937b1f9636a0e5f6ac16d3c30cf29004c0bfabb665a9e4f734cd178d938189b412fb2ea40c889319076a03d990e8bf39190785f3fc7a9f3e88939d420d086ac35b872476b15636b282c678d34426cbfb06fe2b724b257cc41ace987e477616ef85fb1578fa23e5c2a0033ed350375514d85789ef39a17cdce97725fe646ee8d1591c857ac344ce2dbc07131ffb2faf9b087513239951b9b86fa93cb8ec051bbeb715e9e8fdcff6e84e15a6d6b3cdbec9cbc48e35ba3c5f60ddd6210d9d3d3c16e137cae4d667dbbbc8f42da28ec27417c7f650457cb8426644c90e76aaeb47b737952d7ec97065192ea98bf3f970b5d9039e5e35b67d3dd5cce1dd790030f423175fbf38285dc0397c2d9b9496590156ff49e22ff174735ea69bf8787e1b6222a7553d1341df8eeaf7ba24f3c0159af363ed174f4dc6ab1d89f269133be4db868e64110cec1171ba5bc2da8731596f353971f03221ded783aaf87a606baa4c1de0b5ab67ca4f667fc4e01f9c4110fba950fd03727fc7fdeaecc3a8e7aab9709bf006b9cb5aac57102771d91c6743e4bb60bf3eaccc11bb48ec54e89887e43212fc43c7c7d4c91708ba8fd0a955fb15e58ceb65849af3391fdb84df37451e3dcc97dddbbf8c6707d4fe496d5a426803f5645b0df9b5e7c8e7a9118f8da047f5e3ef3691ebab74ac4aaf6acededc379f8cfab9a478d318feba93f2dc681bde57afc713121f93fbe1ff0375e8504fad9b9627ed3527687dff7fad3f4cbdf5bdd5ba69b192adc15f7ffd75d3d28d607b907f535cbbad1b9213f847696fb7a5cb61affd8feb697b2970f7edb7dbef6d5fdbbf198ad6565c27d8bb96a5eddb7bcd770f7b456b1b8e1f488ea2b503cdf62c29d0dab66438bfed7cd7810f1bceab0bffab5a2019960f7f3ad110b26e372ddfb868adef9d6f9d3bfaa665bbaad6fa7edbbd0bfffc3330c2ee3445dffd4f87fa9fceef2baafbbdd7ffdebdfbed779aeed0d4b7de37b175d332fc3f5563dffafe2a59be76d3f2cfe1c71eb4b7d6f7bb1e45dfdeb4268edbfadee9746e6fef6e6f5aac653866eb7be7a6350b3fd8edd2d4ed4d6b6da8adefd44d8b89ff17fefcd393542afc7ba1c2dba89bd6323fde816546e3bfa5fa77f0d3554c1f7eddb4ee03c386512c35a5f5bdf37b9fa6a97eeff7bb9b16ebc39d3e4d777a7dfaaef3d74d6b46ee9a4ef5af9bd6b07957e1cf3f0fcec1d7d4d6f7ffa56ea81beafffe8295df6a7b18d603c0abd5debb6ed0b65df56069d72d75eba635b13d771ffc2105dbd6f7a60875f54762a4cddd797015c0e29bd64adaeb5a10fdbd70dda0349fd64d6b2605cab6f5fd7f5bbfb5feefa6b50c244b4b9124fcb5d024c0c2f0158c3b322ccd87eec9d77ed35d78309a68d8a2398aab1a8ede8eb1173d6ddb50f6aeb6dfbbfb62175bda9bb214687edb33756d0fef7ed0bcf0c5f2e1d5705b372df91c687eeba6a5d81efcebdade5ef3fdf66b3cf9f4867e31a20e4e20198eb66f5b861fc437b453f8d7feec056efa475b8ade1bde6d2b86078890fe56f38daa2f653f3445dd167e151a55bad7ebf473372ccbf00243c9eebc1a9edfb9a5b21b5b537dcdfdb2a55ce7ad676ad92fc309b4bd23596dd9dd1b8e8e6d68cbb24168f5918d8a1b625b10d2986ab306e4ce3bb7df3abf51bf51880e9579955b8a0047b5b675c526f5b00c89f406d9d06d57257450b69a6212dad5bdac139a8b2b8f6af625527b1937103d8ed25ef5afe9d67e35348b34e72276559b0be85669b62df29c6ccbd4484be6187ea0913e107568bf1a5240e8b5270ec2df4a74ef8edca14b6eee75685287831c581aa14360f9c417403b61048aa46c09af5735cf6f031d74f7aab6afe9a778879a1ebaab6af28180e8612f0c1988bb6c259fb0155cc73a235a0ddbb310b7f792834260b87d080cd413fed92f3e64abbddc8f22ce9650b4f8e05eb9cdfdc83fe66fa54ee15701c58a185546a032be04568e6c05965f0158a1c3a947e5763ffc6a7ba6716adda4876deecfb6e43b9dfc6f59f2b52e5dbe73775bb86338d2fe9cbfb3d5f2ef4f0ef3c2ef74d0d886b0dbab25e93eb98beb05353d8ec65eabf480b7c74779b1e1ad305d2f244821b3015f79b583abd912dd950fafaf92e5b6b7da5e2bb6955916526336295bf27c7257cfd4a323bdb64fdb0f5417de166f42f8afadec9570d5d32f4ab251f8e94b4efeb76cf89a1214ee9c034db2f4f2ad84e6a43795ada46ca56ff13eca6ebb6fda5ed2b5f63e50dcb7428b77c8ff7c352ccd9382ad65045ae1be1df8eebe3024dd95f6cab67827a15de55b7ef19e76f2b4bd6183c857b8ef16fad925a8385a10ec25a5302ed74f3024bde5b99655f8bd7761567b4d71f705a094dfb5d75e2d4d09ca53df1f1c20b76d29706d4341b528fade3d78a816ed64045bd735516d3af25dbad2f615c94135c5688ab81f6c51f73d6fefbeb62d49d62c5433c89de8db8a64596dcb700ea77c075f7ad5f6865bb86538baa5bd5a86be2daca41fec15d729e0991f0077eb9781eb9f9d0218e077a0f9c5b7c523d24e9aa2396fa8a6836314c60aafb0dc022686a813fdfb56d88b070766b6d5a4782b853374dbaf217482f05ef42acbd5539ad1ba69c5cb11431ffe6b478c7dfc6790b4b6e3dd98fedd0e0760476738fcd7b60f56607852b8c1c21bff39b881a67a7bc30924393cad1c0d1a1d2d686f83c0cbfd19fe4e36467a3337d0cabdb6e42b86816c815f34b645716ddb75b0cdfeeb5bdce66881918c114e056fef86c21db41df6808ee15677fd70514305500845f82fa541ad9b56bc25c3bf74ede4a57fb4fdb31348b0e631de667fb5151d3ee55b86128a3431ddca70324645b817611f205db6bd639c6addb4e2f71e1c4371d5dc5fed43f0dab92bfefe16fdfccf21ea0728d5ba69bd698eeaeedbba6b498efe9bbbd7dba776cc3a44949aa69af5f25cebdce952bd9adee1ab81196dda2fe150089dd3254e24bc267d6bc60b78a03a7e5b757c5bf37d49c70d384534f8473f047e937edede3d9d6b3ad2edad272926a197a13a12a6d93f27ac3caa1510beed6bca61afb5654335f6070b37bdb06bb0971cffd5dddba44e09aac10b9bf473a2f71d35c904c5cd4af3835465e41c2c2bba95ea8aa25bb350b5060ab7ebf464a1f2ca705adf83fd41bb4168eb4285d5cc554bb7dbbafb5ba414605c4edbfb46a8ddeafcd6b96d81f20fa841a6136e3e96580bf76e6df05f372d550aa4d6f756640af99b43849b9667c2ba5a842e75b379b59472ae0fda649f33291a6006dcf08badca3c169f8bcc78974d17c2ac52b3e41f51d9c9b91e87bee832bdd155666b4d1ea15cde200cb59f3059a8fd84494bcee90aa4b61b0ea68be1601b964a4ad30672e6848114b314f95de328ecfd39675a49cc6499a9661aa5905a8725c5b6e0469537d7e7cc602837899c8986544e6a6a8a02bb536ceb98a5e84f4dbe194c98c86567c26463998c17ee4698eb10da0e6e39e2302a315570db19de1a08d34a3236825b5d66364fd28916cccd3698e246e646586c2ba66344592c8eef798ac0599347eb71c2f46d18bbb81c382acf5dd4e1203535d5ac47180ab57a2c97d7ffdab1af6277cac93836a365e31fc178260c8466cdf52c346ba26769f40647481119cd7b74998c73a91df9b91ea6e68b4b832d84c54e1cbe1b5711a6770c8eda7d43b2b99d5a7019a97559fabc507a041eca76df8432be327d7a90997e474c528753087a92a34322cf76149bfb03e18e854c13f0ba7c3f1eccedd1455c6da8671e5282f44d919feb1bfaf4a6f2f36013a71d9d30e178f3fbd614192bdc071bfea887f35c4638a19c0786b61c1c4217c031cc1dda4741a1dc1b1956171cde2725c13e409f05283d05b85c1cf35117f9d14e1a0e929032286f0734d853c7335deeaa87c94864d79dd961320af7788a5713a607217209eddec974c752ce035b12a6973a3aa5da9d8b427556cb759c829bc79e4d068c73a257f647e8d6250ad3f7e0d7ea13cebbe146602d76370717cc1dacb9083080b10c3be036694bbca24767c4e04d34525a1ee1f0b0802787ccbda0ea9a3b7120bd420025c0c085b31647f0cf27e9b0d1cf4b7c8ffa105d8dd32687e701631d9430e40a4a7c3698ffe3c2136d382767051a9aa4620edf398ee76144cf2ae7415762b8434cbbd33367c28c3a2a03bcdac80ff13de6d594733486308501cd9d95730d8ec6fdd65dce00b7e6bf8d7fcae67d8952adcef5e57aae6f84999e0f2d168703489d1cc4f04853ab4e98d4354e8f5daf91f83634d2fd65e4436b726b8f71715482d9835e747174c440e9b22b95191d446674161f4f67a5c37a9a5d4a779b2bb527508fc59437c5b613a92de589a16d38cdbe3bea0f66ab82eb65211470be1abdaabb09a19dd436ad79761a08ddcd9930ee0ba18d22b5d5bc9792b93e3d33f03061978436d273677cdb0b610d5e46e4f1bc309d1a589ad8b6c5c323a98d8037f3d38c0ca7c759881fd35748cd2ff3df48df21acc7fc34b3eabe336dfa1d02cecc4fb34edd77d8a6df21e0dffcc492d7f371b65a34fd4e878ccbf3130bb0c3e3dd63ed1a92daead605daad3e94010864d218eac6486abbc0dacf083062897b63f1d0744de784b645cd379aaee7a2662e5ccd77b886dfd950427743da0bb7e4f6751d5e8d6ad67454bb6eab1a58aceae6b0ae9bc3ed86ebd32c9eee8e5e98d145e86e087b7943a08f9b13e12c6008fb86218c89219c13e3d992d086ffde98b04e63c258c6847370325b6db06bb77c78a465e2b362a0e0c70bed049aa7df92da08df7d995f48e750ef61b6c3d38095393bb363fcde5c99339af06e7eb6223c6b6dce847578e1769b98171fa8793e141d0e940f81aea6fdaa849c1778d672c87e26ffbc335d4d2e84c4b2ab69964046e6e2f27e9532379eb0ec790a958667fb93a19ac8b597670178e85990e8ffc06d3e4de744e8872c5d5b3f8e701e953278368cd7acc82f59d867f6de3994a12ac0b67109a6509e0b757f4929be9c7cf22bccf39f1ae6994fe151d21f1064e84abf4c26cf5d8d75f9b90ba147d6dd529ff25a5f195a52ab97cd5d76b2ef177c12eedd342c6fb1d38f8b87fbd3fc721fb0d468303f5314fb303f3eafccdbc56e13b0ab7b7ab65a9f662b939e99a34aa99a18ae95f1c774b7702f96e5d935658d643b4c2b623658cf82deae0ebe913e9010f282b6c95c337e5ee662fdc357bd7f8dd2af2161e3c94ee11cce5dd340e27b293ecc50df03facb8f6e53bdfeeef138bbbf02ce1d3158805e72bdf0143a28e91391e3dd2ace74ab2daf82c74aa1b79c02b6b9cfdffbb40ce5b0baacbb1e7386cc58bb25cdf5beea3bab2e3b95f991237283cec63e790abdb5942fa031d11ee85f967c0742fbff08f5a15fff9dd5b2f3657be20f85b202b0937f256d8ee7438bc2340a3d16e2f0dae6736a4c9f27cee20c7bee1afc5957537faca3b97e0dbe7276e74115584aa63b8f48dd3de23bb15de49ddfeb59ea282dcb657ed9bc8481a3d8a3c856c6583b6d79052e55781e9c4d11e480d121d49b37c4bf24e575ed1eb24767c51ef5ae188bce5f462ae29b353e08643bcd5c10b7127fda6e6ccb9784456f328ed3228cb90bd84055e65bea8350b6ade46dd6d995d8109aade31ad6be3bf5d4aa5c5185d97bf6a835b5c42ee76f84823c9abbc0167f7a5369ce8cce504caa89782c6b9b7354feb42dcaf385cb5877076fea70d234e5131e36f09e478e967836b431af99be2ff1ec55671b66ce557f0063fa28f2270bca247c069c160c17c215787e74bac4b49f0f7243757fa1d36911790ca64fadf8d12de0d486beea1c6b8e4f0655d4910def9bd8f8c83e416667ab3156a0f2942e31a06738f526e3c159e2596bc25887c9885b4d98c59bcc9cb66013559891270e07b6dc9de811ae0d3cd909f52f901ac99f0ca9d38411df94d8ce2ad3b7a00f80344166f2de2bf6ee530dbee2ec83b96bba5585852b77a79e664732e09c1b4cd1b6c375d176985d509a2f80122ce27a345f5653674089942e367546e4477796f829d883cf6bbbef8b6b90b9ac8314a6d3e88db935f81ab9c66637a15e56faed869f05eceef17636a4a899fd787ce647c60bd83677039bbdcc6e67f6c29ced16bb777e6f1aa62ecca7e63bf7c1a69bb305f72e510a7cb6b371f238a37bcf4ee99e699961daee6e261b2ae77e2165ff5ce02889e99f25c18b53ccf4cf327db2160c674b7ccf8b7522614a93fc9a8629d6e81ed05e4315166f8a357d9319ee11d2f0cb76e4ffb2e87051c95e749a404309e73f8272948e6c77ac2ce519e882d48ec4cf5d6c7af26eb8bf2ba5b0a7dd701c5ba5cb7a22dd03df0b0a9f72253a6f16c2165258857e94ca1993161f9d56a8a0df5b71ec74c5580755d88eb431a4528ef84cc4dcb1a97a90fde09e53942d4a698cd8580f642e79f8ee223c3bb1dfcdd33b33492fbd988a0c94cbb1286dcdddaa0c7708f7a129be29e6c9931daea8732e5fe139c34d809ec80c57d0b5447a222cbd4f68c15bcabfad3b9e6c17f535ea78da1151743eb91cf0995b401a0abcbc9a5c0ea44885d2b8ebe3ec323f63fb22cfe1e40a65090acad688fc62250a0b0a649258e7881d67e2c7d7e83e42ff824c6113c9d3592abcb5659653e22ef9b93ba50a38f320d39da32a2cace7aee829cea2b3e14fec86675d48ddbea6d9adcc7030af0b2afd474e1fe35474c2a53318e022836e9919ede234e963c5ee435af8545e22e922c274e15c52e2a3481f55a63f93bb2a2a3551b8b7d354a5613a130a914aa73ed572951e0c7e6f96bea9926a7797c0bc78d611d20467fc5b916e27e7deb29ffaf1acc04f8fb10e22c7ee36fcc95ad31c21ed323eed50616d39aa595ab26a4ab45c3b697eb16c01695dadc0138d2da485dcc2589e18ac3de04ea67b967256bca7ffcfde957527ae63ebbfd2eb3edf2ca6a24ea5d7ba0f152a9050816a4830e0975e1e14703cb66dc65f7fd7962d8f922c0339d57dda0f3e2785a52d59da92b6f6f4bd1e0e3f5fcbfc19dfb9701a9b28e5fdc6511d2950f910317f7a6a2c7a3fb2d0eacfe17a25b5d55e34a79ad1b1521dab6b44fb22636cb3fede4607efb15ab4ae4f2fcb6be71ffaa89f5f96b1cd6199ead95fec2895aa8653240114fdb750cfece5145ee1e8ea29e95359eb36b6d5283da69c9fb7c715533bf5727a60feb73b240578c64ec93ee38c757778582fa7f85c8631075f4a864c93e88963b9a5ceb77bc919cebbf3a77b8aaa2cd7a5b47d14df5bbc8ec6bd691f52f2aa89cc269970368cdb7d6eda35eafd0cd6d2e8b8550d6ebaedaab94af4878b762871522fc2d81cf4e53850565397ac5dfa77277acf59a67c052f3c9cd4eebc03fee8027463bd6d6dbee6c39ea48fa1f6e00c8a647ece9a8b75d7c5b29cbd1ae446611d5b019291d17e5967c71a17ae3c666890f6bd7bb416a3e3761ddb43631800da398cdb5eb0eb54cc37455e7a1aef65db0ab8363ae0f9ac6e8bc52b1db88fc39d6cdee7f334ecc1345d50ec6b30928ea0a7d44ea5b3a9a8678bd677677a507b3387769e621ec6efe743ddb63ef0b96ea573f63278c0f0f960abd797c7807de722cfd85096c70064c3ecd8c53280a5ada4adfa3471abc7c7049f88376d740ff7bce079343dc9cb615b5e10dbeac65153fd8f93f493bef716f545cecf57f3926fdb6b1dd813644fa5428ce61e63bd9c834d0fdbc238296663fddfd49556d32a68899837a67b75f5d0817d882243676155c8f72fd5059cfbd22c4e55c85f07f8197fe46d099c737531055964af2eb0cfbb086de08f0e22b11b90f697a439e58f29d43595d514fbf82c46c3b3d603fff6f903e89c4027a02c33b22bfb0e927f9ce959e1a7f3248fa165ec8ef43bc4f71a3a86e203713a56d578837d353ac396d31779650a8c77e6711eb6fa689387881b687b65741fcaafdf68e9234bfc89754fa7fe59eb6ef6eb54cf64c9c37be83f356d26f5a1dc838bcf6ae672dfb3eedd55f419b20be107027303febfb6b23cb26d76e4b16379251397515927e5a7b312fb7754ca4399f2956748bc67117b325b5688eddc8b7b5bb685e946f278b55c047666311e75f277ec0aba89ef5f25bf71f53d70d6de9fe730a6b494b8c5c79e6ed7dd2db6b7fc32c6aff272684a70d6326d2999c74eceb08c3d403ea9dd4e34fe55fb3ec880714c93fc083e1c81c07e99e3e704b649227a42e67944b75d8bacedf7d935730167c8f051594d7d067c27b175e37d12cf5b358c27a74d88ab9a639fcaacbcc2e783b4ceebb20fe366af97530ba0f064db72747cd78d7d0eb3314f979e0d199d4e1dbdb96a5b1f626bb9a6ce3a7d0c79d9c767f1abf430527b63d0899820fb3d7f7818feac724d2477202b5cafc67d01dd47fe0198cc4bc6bdcef85f390fcaea81c485d63bab2bfd5eab9e31891b149dcfcc33f6629b1563fde57d3f44f605d1b3b9d67e52b71c0bb6a9061deefbe18532879db1cd58e2770cb960e38aef59d439033bd63c5eaff200dfed6877858c8dab7fc67bc908fb0fbbe34ea8c777a49f75c786fa3b8507686344e77911ffff44368d7dc173b664ce5ac47ed1a6b29a57c822055d1a9b1e3dd6c01c76f4d176afd9d6d7f8feb3a7d84ef3714074d892c23c625be943d6569a81e8a2efab0ed7b7b90c6ff3e742e409ebc834b6ed3d3f465d119b3df72e0e7b3340047bda6818bc2e67b5f5ae3978e85102a790c2012e2cf3e740bfd03ec4becb656c675c1f82b744d6b44ce021d05bbdb493bfbd189a2b8eeb87fd4423798fbebcbc4d8e2fd2c3eb5c92c773498be161acddba6b1dd6cbe3581e80ddae627c215625d66767e281621be3c67bb12d93c54703a362afc9edd3f5a1d873d02c55500d0210a603a3621dc431206fe057600fe17e9ad9fb6a43c9d5b3cb94e567f1b888dc3888ec136539a27c26c4fed9f1fe5709c35e588fd9bc2be39305b2dc012dac9d3c92befc1c58fa8b93ca7022634159dff5c7a2578eb5a8331692dd79031dcc05bc908fc19242804befbf2efba6bcdab83fa5f64e8f690b8f45470e67f8ce28300eb7889d2bd6efe5f39e6019a67e1b11c4e2407c0eeaf97be7e604ece31f049a533b6d0be73933a70655a72fc2df8baef5557e8d74ec09dde1fd64614d1cd0ef2d9ec696bab43aaa14fdf65312d8a3927898fc1d9ebb3ea9f734861f46646f8dce3a90750782fa179ade65301eaaab8776296e317d0c19740b387789f4348beae0f35d8e7da429be01956b491edd7f285df0a3ba0ff465c7c2f3b0d0072f83ef878be6d29eef21979e66c97bf5490a6589f4f7b099be7de79eaf255dd2c6a59563c8dff119cde47977f39fc9d71cfe268f7dec60fbc28fe723ad6e797ff86bf3b4d2930cec4f3e2cf3e06470d1deebebcbb1a58ea436659d1c273f1abefe64bea6fa4e96eff0c518070a6da19840ae7c42e2dd7f011f627e393fe82ff6d05497d60edb4cb15e4eaa714e8bc40f5edea74567fa0c7471ac65691e986344b5f1dcae6fc46eb2d8656d4ec2325dc9cfa5bedc4df2afbdc199b6021e0d0eabd7fe9e7cf78b1dd99f2ee8133b96912b73133911ef47f87c9d0cdb3b95d8c35efb67a527ce5769bc68c68e75fb3e75c9bdafc63889c54866fa25dbc340eb2e4479b7e80350fddd9f2bf7076a4f32895d00afc357cda3e843d2b3ed915e67d9abdd47ecdbb67ab584f9462c8e5268cdbf45fafe398ecf10e68f8e7490c1de8dc7fee8ad7b66e5fcb1622529df18fbf4d6df2faacfc5c8c758fc3b53ffa20bc6b8520e4a7dc0eadc19ebc4fde5fa0777462b92f7eacb6c1aa35d904fc6ed3996bde4cbf2c99cc1d76abe92bd750ff4637dc253427aa58c3fda74bddace34dbfa10d1f1601976d939e84fa65b9f8f8aed819ca585932bbe3fe36b46fbee9f545de5ef834cb59520447e700bc4d4affd5e9f8398dabe6bf7efbafdb7f6b7bff77a7feff46ae2a4f6dbbd9be0a446bdac8793daffd2f946104dbf76bf327052fbf7edde7dbf7fdf2338a96d3a3e6a965ae7ebd7f67dafdfedde1a1fb538af9f018f4a6923e6cf061db541476dd0511b74d4061db541476dd0511b74d4061db541476dd0511b74d4061db541476dd0511b74d4061db541476dd0517f2b3a2a47f1db80a336e0a80d386a038eda80a3de0c1c7539c44085330c363aff110505ea8fe539cacc6d57fa8035b1b8c11cd7003ee50353da9d6db49e2e04a624f5adc4e9e177f176b88e92716db418ec51848775db3ae94fd2561d3c2481c082fc4b09c463f1ef188064cb63972456e38f79525e2cc174dd73ed41b7879e3a1a42b2174834b38913b36df41506a46caba7878f28e1db22dedb93c4679f0f4269871d000f05f04e7935cd9ef9e37921d16026b0682c0f364e0e0cc84c0034f7eae87e2b3fa6fb9506c95a073a7654d5c081073b6b61c7817db22f3e0e4df9696c69bd69ec30705f289f0dce260e210978d24473a624a823937c3993980c402ef3fb5bfedd2be7dd5b0edc250772357be381273e1e2b4028b9efaaea5601587200bf1e3840530f15c0850fd34a20ca05e71d1b306cf6a671de6d38efb615fdd956818cf6d9ef669cf99d1d27af55c098ec7ecd7f44fcb1c289923a7b6e3b95c098dc764ec2ed9caa8031b9ed9c45db990eaa8031b9edb485dba902be7c93f900843faae690f7ae6a5e44014dc7157de4be3bf101126767feda9889ce29074071d6ae6843743eab002dab80523b82ed74f11ec8590bbff8ef87957cf55631a76f55f3b6a802c6acfa8661d537fc1a01efb3c110e76fdbadd6e1826b8e38fbe388071e39e7ad1b5e9f3ed8e7c4fcfcc879c769efcc99a733a72f67f63908e098ecb9db1ca7769b5b575d3d73dff3803539e0a2cfbf46ec76dfcc09ef1cfa353b3f73f60000d69c73d666ff070748f597f4c105ed5c72413bad7597c8d138491ab96732925c67ef182539ba941c2197f0ba98d822bda3e493d5a789caa3c400a97ee629cf6b49e20a720f343a58f67d59c6bac0fcfae2277fb0a52f5a246383b3f130d2b345c0136fbd48bf41753e77a6e0c08def42cfb6b5536de994de4b72f7f6b89dce5e26206e38a014eb2bb76a8eef806e0abc58eaebe0bb317e22208ff0fddbb6fef470fe657c4bf4a32f3649dc0ff467bbf5caeb68f662b7eede870940e46ab29f7c7c3f4e5fdb87097ef240a5d2480ab5d171ab8f16bb2849766efce3ef11d4a7a6758cbc6335654c451223d0f588a572e99df99a2408747d6ea95c71aec5744d9927bd7fabddf1bf003c20b7ae07df39777146bf6fa98bca3c4427913e19dd4229704820a90153675139be55491c72e39ae8383735fadf2181939f479f9be8203736d62eaf8bcc3c76c753ad443773a4b4076311ae57db049c68f2f6fd50679c0b09f3c441cb6a8dc7f4ac0ca533d69dde7aed3bd4e4a79fd54e8d60ccabf6186a02ce4f6f479abe489fb526d2a0cccfdc9b1949ce6b7c93f0fe9c2424acc33f6f60bb025be86c09720606648ebef573f8552c0033df8eb5ee49906cea92f626eb821d55e46cccdb03c4e6582c38f27a5e2a27ac6f4026ff8d41267f56cc2bcb86d000d53540750d505d0354d700d53540750d505d0354d700d53540750d505d0354d700d5354075ff1540756a6f5a03d4a898648fb35fa7ba5f7edf9304fb1148b166e680c9986325d91d7cd7824487b26d7de1afb578cfeb815ee13e9cc77a0c260fd89d1f59b03311da6a74cf223ebc1d2dd621548e699c8413db54a407b82782ffa81901b663a0eed47e668c31884a455f603c018c8b7f7f2bdce3d6edd042ab092301ef773179b3fc180a497cc819ef62024681f1ce3c909c7dee82ae69d92549f337ce4b77bb57978b3df3ae90e54f384b4edf765a6feabe7453100679397f87fe6b27ce1e50a59baa0108c2ba6309d1e7df0dcf44ff2cb82f401d2fb20d64fc9e2beb50f4028c394f6d0fd9f2dcbd9e3c86da956dc133337bb655d2d5ba61e736673b79c6ddd4e6514db75ac6ac3a93c933de6bed38d96de51e30fe88cf0c07be7d614b5b79a803e8a6c83e13fb744bffc8f8828f34fb3e8c938b56ecfba0334ef43d18e44b60bfccf173c99ec23e8f18a067026bfba97dcd5c98393b2bb37f72742e2d60de422af081609b386e012757ad014e9ad4695b3b584bb23dfc90174540cefe39ef7b7ee9d99091a3933d9baf2f81a4c62fcbbeb7e683845c229fe61f3bbe632cacd9dcbe3fe9dde1498e937157eafcd207eee5b07f9ed02b57a74479c69ef678c9b8d719ff6be7c13a90f8a07a67754d1d73f93192b81cd1f94c1f4359ce78eb0f62779284a9322d612af7a9da078acf18c7bccd92f8b934be6fb69c9b1087a275a264a79cb3836277add76f96fef7a27255b28d001dee7b0e7dc69dbe01536bc0d41a30b5064cad01536bc0d41a30b54f005363fa437489bd688c7d2e22b991f8f7b769eb27f1fb795dcc221bae685fd9f1d3d43e8b9e37957c437ced22fb4cfd39eae6e3e609b8c3e702ac316d9871ec7b0a1452630ceaf937e6fa63d9787f76e65b65d93fff7ccacb19e4ee4ee260de9ec6a027ff2ad3011c4adf80c14e6e0298c399ffbf2ac0d98fc7c364505c73e41987c4c78780e50f8cbaf7519e1ee7fb7f106f7078a404a6f4fda7703db61d32abb3db3c5b636bbd9caf34db6a736defb19f258983029fcf67530ab5a739cb4f14da32412f4700b7a87e114f62fb5a6db0bb383f4a0120c854bb5300805aaadd63475d4a3827c2bcfd1c3cff581cd8f7049aae91e117c51c7758f32c3d620a9e0667a23e1a7aaa3371a9763e4a4e073a2825ff7c80311b67f87fd53ebe2ec01773a0cf345b0a959514c6bf31ee04d433853c86fa24c1dd6f3399b9c2f5d08135f6d3edbabbc53efdb0b6c5f4cc34fdf277631e017b70e619eb50095f64f6123dda1757cfa57b0483d778c0737578f6bf0bc08e3e06dd584ec2b2602e7675387e033bddb80339cd3a9ebeecc4bf956540b62c489e71a02f319ffc3b81d6d1cf9e923dfd4f05ade3f6e93783d631faf6fb41eb18bcfdfb40eb1867c46f05adabee536217fd8f06ad637e371d3c4efc5b8fc68ce48c1a121b007f0dd58c11f0e40175ddb172623265de321ff1ef332c1b8cbc9a7fc88fc741e1bbe9c075f13c0e8c0afb006b7c4bb69ecbf781456c8fa28f1193d7246da49fd6cbb9250cf00671110ee498abe6a1d8d7b83efff6a2d82caa9e68e652f57de43cc5ff1fb67fbecfdcfffb9340caf69e730380b26effcbfd27e293f56f824f167592014ff6ad1a9dacf7e5a6e8647f7ce977eebffe716b74b2ec847e063259817ecc900d2a59834ad6a09235a8640d2a59834ad6a09235a8640d2a59834ad6a09235a8640d2a59834ad6a09235a8640d2a59834ad6a092fd56543286a6f72f874896f580ccfccecb6697b13098658fcfbc55a1222bad3db7647bd8519fe64956d1bc55a4e0a56eaebf4c1e6787c962727879d31f668b4938fd316b4f06edfeafc775f7e56d71987e3c76266f8ffde9c7f8613e782e5b0d12ebd138f5b84e22d1e208f3bae3e060ebce136494d3bbc33eb1d0503c5ad9591653ab8aa13b0fbb7977d89657fa162deaf54904c526b6647fbc4a0fbf04a2b10a5eb210b9dc592e1efbc9f7bec411b5ebb635cb4767c5192796d3f102508daa3332fd2268462fbd9466129dd495ce942c6c993668d998c6ddd85a56f43ce7a2ed24084b8fe4b7f0fcd28bb3403e4da8def80c7ea27aace89055f2518748d6de7a6511cb20c9e696f5cec9ced7833c9a4344c1595e4d1f168ff988199c91fbd43e4e0690913bad4ffb9694bfa859037b2f6f8f07d88bb055ae46667776144b3a9ff4e81556540de973b0a78f433eb37b656679065ff1b35195323d65a23af493da930e3f07e62e8d22e867f88f198d73f34c4a497fd3bd84950d288d361a8c07ebd5d45b67b2afa67b6465f4648e37d5d1710f9ef9c08f6f4bc826718f3334cd9f2c88becdb751dc8f9fa8fb5b3e8285acabd33d65ad256b291f61c5cb1a52de678b515f02fc94644249f62e2ded4b6e8d962257ed74bc44234bb27dd3a919e13f2fb22bc7178072379276f2d3f41faa91ee05711fd2288d81ce9ac3ccbe9da0945971a453e5de91e3f7618edff9d165b675205e323a8e96c1d93dcb5ef2597e248f6d99955977c5a28d61ed7bba3d6ccb0b38df8ee91c9be0f1b1dd636f1973de8775aa2c29e809c49b1d9f1b8c288cbce74d916fe1bce17abb30daa4ccdbe482f6d339e57afbccdcdcbf19fd4af73356f6ea8c07c9f3e0a1907578ec105926f1acb6e4839a434e81bd503ea856b237bdaa3da92d2fc65bad2b99055ec032dcac7bf4000da3c46f342f40473ea8e6345000ad64083c34dfaf717475716dc5482c9de96241e4fbdcbbf158e94acb89f15c27428e7646e4a2f525d82b7b1a258b305e67a75c998d4b1df74b3c7722d70e44f7edb8d065236d007c78a85e18512b1b17cc2d0502f51c34427f57f2cf08e05f7fd391871c1d39dae9ef7f13ec38beb222bf851cdd730d27cc3b7ac0c78093c7fffe0f1830f4b2bf47ea4ec133ac945b7db7944d8de29eb901adfa07d2ea34127d5acd0a868698359806a24211d3085ba0b98cb4b015052b7a89cb59ee66c329b38f3419eaced1ad6bec53b141c94676be5ce0bd777aadbde1213fab55260acb5bbae96c91d2f8ed347e3b8ddf4ee3b7d3f8ed347e3b7f49bf1d5d09155509502bf897d5d27d638ffce2af8472c693e5f65e3e5ab0cf7af96c5cf5afed0344fe79b4adac4b103a7a7b056620164a4b92ec7710146371d1333c64190ebadbb82df237bb74a09b77efae0f8563d9326829bb70ebfac659090dd769d91b3b6c75db9dfe5dfb8fbb7627ffb61e61cdb5bd5d881292f731c9f8f77ac4742748087d83f08176076ca7f58838283cb8be99e951a7031f19ff5e8f1831a4a5fdba8ffb95bcb901c1afd0bf60a7069a6f783007353f39085decf69421f905938c7e1722168bf39cb211b13bd57255685d39c35fb4f21bf74ed985ae8f82b045fe102dd75274c5122f0c4352af34f4685bb38a6619c235742544c2854357b8e85eb10c9db940b35568f7b6722970a52939fb4d942044be6d387ad00a90bd477e6bdfcb175191eb3b7fb43ce49b41eb5f3bc5098de2f54f438ea958a1d15215cd74dfdf8b6f034ff151eb7804dfc168d3ccbe767de406305c7107a2ffe50be9ca1e699b2d2ee5a1432bf0d0a15062e31b1f8ab5575a1f87f06e531867ddb0b5ade204e6a9b50bdf55b770f5447bc5f1b647bcdddf79581d927bff1e386e68bc9f923f989764c533d031440edc94f16db8a57846d0523ccf32343c9bad7d47b1bc4860a845030677e75d5c5d737d74716574546ccfbabcbee1bcfb4a10fa3b2ddca5dde8d625e3f9eeded0917f713f7c642125483a50bb7ee8ba161cee17d4d72c0339618042a24841fa75b55b81b64536ba924878f2907e2d7fb2095fceb46c9a9772329be215eccd267a3dcfb3695fb310d854af581d6ca2d72c19bfd612f1f54498afa18e8d7a5ebb422b408eae23cd25baf54f524a437122f85d588d2635d5acdcdaba41a8593b90166e46a815224771c2ebe8d981711d015f099165d8c695fd80085ac3d9ecbcb23c589714f1dfbb0d95d6cebf78840cd0f25a16450abd8c466bb32b5d0daea1d4323cc5be3139fabdf142b2d771388554cbdca9c877508882cfa10a1bebe751a65cc66e4e3e391cffac765aaea16b9736761d87c4bd33368e71cd1a8dc904419db9015902c43b6c74ab77c040556c5f097675962faee6231d39a151eb8c878a5b6479c80f5a7b3ba8b37470d530f462ed729d6a2444a9561d573391ffef69b4ce1bf06f65ee16494c7155e516a8ad6a4b79143a9ae5ee74cd75de8dcd9584923f5383f315e4702862490354970a72b011e15a32893a3d0a4cbc19b920ac2711f168c11640ccbbb7a007e202f23dd7b5ae2368a2d3750488b219b3bcab5eb029b00826ae123bdfba11491d79967b8aa3656f4bf012119a4bd9099238db5bd08bd79ae13ab79b72b2350737a287a5204743372677eba9a97903e091b2b1323cb82db55b7f2f64e70a3ce566f312eb7c6e462dfa83448edf8266fcee46d42251375657dd88e6de736e47e9d60cb3f71ccd751ca4d5bc20506806280c4b26a4ba44c8e705372273c9e587d0abbaea53ed5999f71af2394c14d9fee3cb15bbd4d173fd10f9913ba70571e11c7d2277bb33bf0515726e5202ffe5eb758a8355b242facd958f15c1fcc28944ddda7fc57799cad9a45723ecc0aeb6531164016416c02eb0e4104d4ed3d6162956b83dd7ad56f5f1f96a841feb35466a89b5756387e2b8604b5790ed3a75cbf3b5e0bc4aad0a16e4d615f182e6d76d21274e5c712d891671acbb9c10782d23e73a12ee755d889cd104eb0b312a3428c61da2b359d9c70ac7f74c99d8d7c246a122543cf3779cfe855327f6ea61162227187c537a12d62c2e70c6302b625d45ebdd7014cb38233f3091175669302a88c1b5cad10ccbc06a784d71346421fd6aa29160762372914d47b12cf7508f56b25060974e35c8d7d4e532b348fd43d9bb8541818c62adc22dcddfe9f56a1c7cc5f332ea94cb6adb28f40d2d20bf5f46c447a17faa2401eec4ae6da2137b26405bb5abee0cb98cf0edaaa1cb7cc589c9d9b87730ccf010898159c07237cc77a1af380188a8d525480aa77c31cbddbcdbd84bad1cbd85dfe253a8f8f9ee5d102a9ad9c2ff15cf94b771372ee8c343c8a6d722f999980502d70f291389d3cfb4f02516bbdbb72c7f472dc26fa95004fc210291322dc5390995d3773ecd364b2f0cb13a41a8d8a51972373865cd9d667b2dadf275aaf6d48df777e1c2c43d5bb0f4cea1dd57d915f68ab5438cd2efbb7351a48e5eed764671afc22fb0abd7c67183d0d05abf3ce47cffc7f3be5b5952736dcf289fc8e582a9e34ab1a86f5896d2b277c7fc0b709c3434d7f7e2b9bd2bf123ad442b80200ac429bbd52cce2bfc28415855c453fc00f995a57ccc0b55c5204ba023502c497bc62d16ba26727885c0e353e003703181aee17294460d5b577cc36dd9c82fbaa642953b238c9513c5b7b6b23134d7510c1f36120ff9a181026e7c25f765ba60e2347f224543c544e071412bba459e722332ad77c32aeb5faf48598a6c15e942254bb1a81549507325c3d0811dcf40243f5be12d6409dce9f1bafc27d995ff99f55e53cbd665db08b52db22cecf3bc756d04380a8c123097c4cfb050c6d591ef80f73e88d93bdf2f697bd2127152c2c23ee72920579936fe46127b967d8f2c0b8506820c7077a16b173e037433490c4cf677dfb551b845bb20f6a7fb677a6ac52f6a574858ad7e4d2853165eca3561b420a32bbb1c4e25094abd92b0532e952c0dd508d59d66a210e7cf3b1c2009ed2e741db4a922813b942bf4ffec5ddd92e3a80e7ea2894fe7eabccd167148c2c4366eb093f654edbb6f0904fe030199deaaa9da5c75077d1fc6181b2424b104f54ad69778cf5bf1dc8c02e87ec0daf06d48a62aa38258d077bd9b65b05c9ed4e6f362053f9f0cbe154fce861b572ddb7c6e2da8df9b11d631e64bc178925a0cb2bacac12663858817db2a599d46d180df71a707c570212dabb3acfd3fe691e2cb2517138a5c4e68729e1a96090e3178afbfd73630342e9cfb5fd531a4d6b7cac674b0660b8174924eabad872f37e2a3192731336510708c0a7c0aca58eec95d793f9e1a51ebf172115f5b39bc09b7ddc56ca9ef8fad1832a0565c29bf0fb5166a975977458a24b8a4335bc65355624acb642ecb3540b4bc5268c8963645873e7c4dbf4c6c8a094dc1081ad9dfaf07d1f99094c3e363592cbacbe1f1bf7589d860c0a23f6dca26d63687c73192e9fb36980f3cfc599adcdf09c04b128037ecd7e4f3fefe4b69c1df99c2df99c2bf2553b8ac84c4c91eb386dfffaf0f425a37d8732b344496548f8f131fd84744acf855c0d489f14579a850857daff77c5b188783e4b8918c67316caee7c35356b8e106dea63e342a210e35621589fd4848c3154878c8368886901d53e463a0274e764af90897066a44d131d45db01f2e2e10e8c8238fa496529d4517ee8c8d3048577c473b0b6de68129f210f903a2dec2152eb4b0a01c3d04427dbf1285a830c7875b84a200a9978da8a7b04c9d585d3dc285a1aba0285013440e9ec7e0885a8942b5ae00a1baad93888ed13174fd112da759fb2bce8ff00798e3cca6e01cb1b902bccadbf65219b5a0c9e83d3147d3f9ff7e973f478bfe5e352e5ef495de29ae76df732dab6fa283771dbbbbe29f236bc430a560ce084181705f97822cf6740898365579f564def84e731e1f993038e1c3597dce999c5487c2a225d9d619547d8e5c4d3d53aca5bad6a49da20026528202e0da2c8db0c39ce500b912761fbb08ec52d414309cc9af8c053be3d7aebc7d7a509cb5d64daf80864f94e669de783fc218c6ed72c5e4b098ac9c219106a1bf1609c24d2812e30d9024eaa2584b76b641896ed0431295182406037b01dc259b20915dea795a141f52107cee2998e643b2b7ccc2995f459d7507b8d3466256094f32913ea31d897f3291ec1ab07b10187c3d088473ac08c9879b50e7bfe020a4a9ba40b088db0ea0be267112eae933cf4eac60c4f76be1a0708291548744b387082ddd2d2382209ccd93eadf0be4cd0c46d5b05516d3d8e2ca370a1545c8d038497a54112d6115dc60546d2d20155d6ed6535fa01c5fbcd4562526784e272e02e7372ba65713145adda688312d3c9f537231c5732f42a9f2042da6e1531442cf2068117b402ea3e04261eb419a917f8998ad81a0a049a1045b7007c8c86fceca1651ce2868da8a57d0c0b8318462796b4721fca56b44efc62b8fb69af564524c209b9762926ddcadcd16e266bc8a6e697c80bb70a733eee098b83058ee3d6783d2d6389086a490ff48bbbc1711e9461dda89cdefba3d6740307f470eac8280193de4a2c3a3d1221b73cce85e3ba471de98b256f4b62474ed8d037a76a5fade6f7c91f20a7739a2a00b57bcab7910b2f091dd09a1deaae69450769d9243f4bd32a04b239fe89c1ec5cc7e4261f99d4fb1bb8472ebff1c95c234f639f271d10577bbc7e47e8d2713b7d7c170f19a95738d451094e90a3c7897cfcc9682bd6cf5286db10977da17bbedd1e031b6f0534fdacafe98336d6b29ef82ff646a59f8071e740b60bb7dfaeac9b752575a5ced0d485d8dd61fe6fbcfc375bfdc0ebdfbed1aef7ebb0ed6e2aa0f388ce6588f1f9e06496dc0db87ab3cb0fb74e7427d8f67725c80663616beecf892e7707c71367e9e6e33f066659e07bd31385824b3dbe748c90cb01fe4b3fc65e262362aa9c07e874a188bfc2919b4dd049cc3c96d53cb3a9c6173c025fdd32b7e86555ee61be473f5e536c68597e5d65fd4e7ee7fd161a2950c8edf3ccac6fa1191477af213fadbe48367635b19adf463e0787e8cc3aac82cabb97ab186f0684363ee779c950e0e7b033b3d150e3ae324b8707d5bfdb68e9fdf72c43a5acc4307626fce50cf43bd4f5a7f9fb4fe1f3b69fdef7f000000ffff0300ab1e91c4082f0300`)))
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"strings"
 	"time"
 
@@ -27,6 +28,37 @@ func ContainerExists(ctx context.Context, containerURL *azblob.ContainerURL) (bo
 	return true, nil
 }
 
+// EnsureContainer creates the given container unless it exists already.
+func EnsureContainer(ctx context.Context, containerURL *azblob.ContainerURL) error {
+	_, err := containerURL.Create(ctx, azblob.Metadata{}, azblob.PublicAccessNone)
+	if IsContainerAlreadyExists(err) {
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// NewContainerURL returns the URL of the given container in the given storage
+// account, authorized with the given key of the storage account.
+func NewContainerURL(containerName, storageAccountName, primaryKey string) (*azblob.ContainerURL, error) {
+	sharedKeyCredential, err := azblob.NewSharedKeyCredential(storageAccountName, primaryKey)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	u, err := url.Parse(fmt.Sprintf("https://%s.blob.core.windows.net", storageAccountName))
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	serviceURL := azblob.NewServiceURL(*u, azblob.NewPipeline(sharedKeyCredential, azblob.PipelineOptions{}))
+	containerURL := serviceURL.NewContainerURL(containerName)
+
+	return &containerURL, nil
+}
+
 func PutBlockBlob(ctx context.Context, blobName string, payload string, containerURL *azblob.ContainerURL) (azblob.BlockBlobURL, error) {
 	blob := containerURL.NewBlockBlobURL(blobName)
 
@@ -92,6 +124,28 @@ func GetBlobURL(blobName, containerName, storageAccountName, primaryKey string,
 	return blobURL, nil
 }
 
+// GetBlobDownloadURL returns a URL which allows to read the given blob, e.g.
+// from within a VM, for the given duration.
+func GetBlobDownloadURL(blobName, containerName, storageAccountName, primaryKey string, validity time.Duration) (string, error) {
+	blobURL, err := getBlobSASURL(blobName, containerName, storageAccountName, primaryKey, azblob.BlobSASPermissions{Read: true}, validity)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return blobURL, nil
+}
+
+// GetBlobUploadURL returns a URL which allows to create or overwrite the given
+// blob, e.g. from within a VM, for the given duration.
+func GetBlobUploadURL(blobName, containerName, storageAccountName, primaryKey string, validity time.Duration) (string, error) {
+	blobURL, err := getBlobSASURL(blobName, containerName, storageAccountName, primaryKey, azblob.BlobSASPermissions{Create: true, Write: true}, validity)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return blobURL, nil
+}
+
 func ListBlobs(ctx context.Context, containerURL *azblob.ContainerURL) (*azblob.ListBlobsFlatSegmentResponse, error) {
 	var listBlobs *azblob.ListBlobsFlatSegmentResponse
 
@@ -110,3 +164,25 @@ func ListBlobs(ctx context.Context, containerURL *azblob.ContainerURL) (*azblob.
 
 	return listBlobs, nil
 }
+
+func getBlobSASURL(blobName, containerName, storageAccountName, primaryKey string, permissions azblob.BlobSASPermissions, validity time.Duration) (string, error) {
+	sharedKeyCredential, err := azblob.NewSharedKeyCredential(storageAccountName, primaryKey)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	sasQueryParams, err := azblob.BlobSASSignatureValues{
+		BlobName:      blobName,
+		ContainerName: containerName,
+		ExpiryTime:    time.Now().UTC().Add(validity),
+		Permissions:   permissions.String(),
+		Protocol:      azblob.SASProtocolHTTPS,
+	}.NewSASQueryParameters(sharedKeyCredential)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	blobURL := fmt.Sprintf("https://%s.blob.core.windows.net/%s/%s?%s", storageAccountName, containerName, blobName, sasQueryParams.Encode())
+
+	return blobURL, nil
+}
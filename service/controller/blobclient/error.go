@@ -52,6 +52,15 @@ func IsBlobNotFound(err error) bool {
 	return strings.Contains(microerror.Cause(err).Error(), "ServiceCode=BlobNotFound")
 }
 
+// IsContainerAlreadyExists asserts container already exists error from
+// upstream's API message.
+func IsContainerAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(microerror.Cause(err).Error(), "ContainerAlreadyExists")
+}
+
 // IsContainerNotFound asserts container not found error from upstream's API message.
 func IsContainerNotFound(err error) bool {
 	if err == nil {
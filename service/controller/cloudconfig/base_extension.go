@@ -28,6 +28,17 @@ func (e *baseExtension) templateData(certFiles certs.Files) templateData {
 		certsPaths = append(certsPaths, file.AbsolutePath)
 	}
 
+	var etcdMembers []etcdMember
+	if key.IsHighlyAvailable(e.customObject) {
+		for i := 0; i < key.MasterCount(e.customObject); i++ {
+			etcdMembers = append(etcdMembers, etcdMember{
+				Name:    key.EtcdMemberName(i),
+				Domain:  key.EtcdMemberDomain(e.customObject, i),
+				PeerURL: key.EtcdMemberPeerURL(e.customObject, i),
+			})
+		}
+	}
+
 	return templateData{
 		azureCNIFileParams{
 			VnetCIDR: e.vnetCIDR,
@@ -54,6 +65,11 @@ func (e *baseExtension) templateData(certFiles certs.Files) templateData {
 		certificateDecrypterUnitParams{
 			CertsPaths: certsPaths,
 		},
+		etcdMembersFileParams{
+			EtcdDomain:  e.customObject.Spec.Cluster.Etcd.Domain,
+			EtcdMembers: etcdMembers,
+			EtcdPort:    e.customObject.Spec.Cluster.Etcd.Port,
+		},
 		ingressLBFileParams{
 			ClusterDNSDomain: key.ClusterDNSDomain(e.customObject),
 		},
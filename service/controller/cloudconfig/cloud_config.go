@@ -9,17 +9,19 @@ import (
 	"github.com/giantswarm/micrologger"
 	"github.com/giantswarm/randomkeys"
 
+	"github.com/giantswarm/azure-operator/v4/pkg/credential"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
 )
 
 const (
-	CertFilePermission          = 0400
-	CloudProviderFilePermission = 0640
-	FileOwnerUserName           = "root"
-	FileOwnerGroupName          = "root"
-	FileOwnerGroupIDNobody      = 65534
-	FilePermission              = 0700
+	CertFilePermission              = 0400
+	CloudProviderFilePermission     = 0640
+	EtcdMembersDropInFilePermission = 0644
+	FileOwnerUserName               = "root"
+	FileOwnerGroupName              = "root"
+	FileOwnerGroupIDNobody          = 65534
+	FilePermission                  = 0700
 )
 
 type Config struct {
@@ -65,6 +67,12 @@ func New(config Config) (*CloudConfig, error) {
 	if config.AzureClientCredentials.ClientID == "" {
 		return nil, microerror.Maskf(invalidConfigError, "%T.azureClientCredentials must not be empty", config)
 	}
+	// Without managed identities the cloud provider integration of tenant
+	// clusters authenticates with the client secret of the organization's
+	// credentials, which only credentials of kind clientsecret have.
+	if !config.Azure.MSI.Enabled && config.AzureClientCredentials.ClientSecret == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.AzureClientCredentials.ClientSecret must not be empty when %T.Azure.MSI.Enabled is false, only credentials of kind %#q are supported without managed identities", config, config, credential.KindClientSecret)
+	}
 
 	if config.SubscriptionID == "" {
 		return nil, microerror.Maskf(invalidConfigError, "%T.SubscriptionID must not be empty", config)
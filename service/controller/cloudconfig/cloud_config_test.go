@@ -0,0 +1,81 @@
+package cloudconfig
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/giantswarm/randomkeys/randomkeystest"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
+)
+
+func Test_New(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		msiEnabled             bool
+		azureClientCredentials auth.ClientCredentialsConfig
+		errorMatcher           func(error) bool
+	}{
+		{
+			name:                   "case 0: client secret without managed identities",
+			msiEnabled:             false,
+			azureClientCredentials: auth.NewClientCredentialsConfig("client-id", "client-secret", "tenant-id"),
+		},
+		{
+			name:                   "case 1: no client secret with managed identities",
+			msiEnabled:             true,
+			azureClientCredentials: auth.NewClientCredentialsConfig("client-id", "", "tenant-id"),
+		},
+		{
+			name:                   "case 2: no client secret without managed identities",
+			msiEnabled:             false,
+			azureClientCredentials: auth.NewClientCredentialsConfig("client-id", "", "tenant-id"),
+			errorMatcher:           IsInvalidConfig,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			c := Config{
+				Logger:             microloggertest.New(),
+				RandomkeysSearcher: randomkeystest.NewSearcher(),
+
+				Azure: setting.Azure{
+					EnvironmentName: "AzurePublicCloud",
+					HostCluster: setting.AzureHostCluster{
+						CIDR:                  "10.0.0.0/16",
+						ResourceGroup:         "godsmack",
+						VirtualNetwork:        "godsmack",
+						VirtualNetworkGateway: "godsmack-vpn-gateway",
+					},
+					Location: "westeurope",
+					MSI: setting.AzureMSI{
+						Enabled: tc.msiEnabled,
+					},
+				},
+				AzureClientCredentials: tc.azureClientCredentials,
+				Ignition: setting.Ignition{
+					Path: "/opt/ignition",
+				},
+				SubscriptionID: "subscription-id",
+			}
+
+			_, err := New(c)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/giantswarm/microerror"
 
 	"github.com/giantswarm/azure-operator/v4/service/controller/encrypter"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 	"github.com/giantswarm/azure-operator/v4/service/controller/templates/ignition"
 )
 
@@ -175,6 +176,39 @@ func (me *masterExtension) Files() ([]k8scloudconfig.FileAsset, error) {
 		},
 	}
 
+	// Highly available control planes run a member of the etcd cluster on
+	// every master.
+	if key.IsHighlyAvailable(me.customObject) {
+		filesMeta = append(filesMeta,
+			k8scloudconfig.FileMetadata{
+				AssetContent: ignition.EtcdMembersDropIn,
+				Path:         "/etc/systemd/system/etcd3.service.d/20-members.conf",
+				Owner: k8scloudconfig.Owner{
+					Group: k8scloudconfig.Group{
+						Name: FileOwnerGroupName,
+					},
+					User: k8scloudconfig.User{
+						Name: FileOwnerUserName,
+					},
+				},
+				Permissions: EtcdMembersDropInFilePermission,
+			},
+			k8scloudconfig.FileMetadata{
+				AssetContent: ignition.EtcdMembersScript,
+				Path:         "/opt/bin/etcd3-members",
+				Owner: k8scloudconfig.Owner{
+					Group: k8scloudconfig.Group{
+						Name: FileOwnerGroupName,
+					},
+					User: k8scloudconfig.User{
+						Name: FileOwnerUserName,
+					},
+				},
+				Permissions: FilePermission,
+			},
+		)
+	}
+
 	certFiles := certs.NewFilesClusterMaster(me.clusterCerts)
 	data := me.templateData(certFiles)
 
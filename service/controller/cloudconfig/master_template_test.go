@@ -0,0 +1,110 @@
+package cloudconfig
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"testing"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/encrypter"
+)
+
+func Test_masterExtension_Files(t *testing.T) {
+	testCases := []struct {
+		name             string
+		masters          int
+		expectedContents map[string][]string
+	}{
+		{
+			name:    "case 0: single master",
+			masters: 1,
+		},
+		{
+			name:    "case 1: highly available control plane",
+			masters: 3,
+			expectedContents: map[string][]string{
+				"/opt/bin/etcd3-members": {
+					`MEMBERS=("etcd1=etcd1.a1b2c.k8s.example.com" "etcd2=etcd2.a1b2c.k8s.example.com" "etcd3=etcd3.a1b2c.k8s.example.com" )`,
+					`PEER_URLS=("etcd1=https://etcd1.a1b2c.k8s.example.com:2380" "etcd2=https://etcd2.a1b2c.k8s.example.com:2380" "etcd3=https://etcd3.a1b2c.k8s.example.com:2380" )`,
+					`INITIAL_CLUSTER="etcd1=https://etcd1.a1b2c.k8s.example.com:2380,etcd2=https://etcd2.a1b2c.k8s.example.com:2380,etcd3=https://etcd3.a1b2c.k8s.example.com:2380"`,
+					"--advertise-client-urls=https://etcd.a1b2c.k8s.example.com:2379",
+				},
+			},
+		},
+		{
+			name:    "case 2: highly available control plane with 5 masters",
+			masters: 5,
+			expectedContents: map[string][]string{
+				"/opt/bin/etcd3-members": {
+					`"etcd5=etcd5.a1b2c.k8s.example.com" )`,
+					`INITIAL_CLUSTER="etcd1=https://etcd1.a1b2c.k8s.example.com:2380,etcd2=https://etcd2.a1b2c.k8s.example.com:2380,etcd3=https://etcd3.a1b2c.k8s.example.com:2380,etcd4=https://etcd4.a1b2c.k8s.example.com:2380,etcd5=https://etcd5.a1b2c.k8s.example.com:2380"`,
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			customObject := providerv1alpha1.AzureConfig{}
+			customObject.Spec.Cluster.ID = "a1b2c"
+			customObject.Spec.Azure.DNSZones.API.Name = "example.com"
+			customObject.Spec.Cluster.Etcd.Domain = "etcd.a1b2c.k8s.example.com"
+			customObject.Spec.Cluster.Etcd.Port = 2379
+			for m := 0; m < tc.masters; m++ {
+				customObject.Spec.Azure.Masters = append(customObject.Spec.Azure.Masters, providerv1alpha1.AzureConfigSpecAzureNode{VMSize: "Standard_D2s_v3"})
+			}
+
+			me := &masterExtension{
+				baseExtension: baseExtension{
+					customObject: customObject,
+					encrypter:    newTestEncrypter(t),
+				},
+			}
+
+			files, err := me.Files()
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			contents := map[string]string{}
+			for _, f := range files {
+				b, err := base64.StdEncoding.DecodeString(f.Content)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+				contents[f.Metadata.Path] = string(b)
+			}
+
+			_, ok := contents["/opt/bin/etcd3-members"]
+			if ok != (tc.masters >= 3) {
+				t.Fatalf("etcd members script rendered == %t, want %t", ok, tc.masters >= 3)
+			}
+
+			for path, expected := range tc.expectedContents {
+				for _, e := range expected {
+					if !strings.Contains(contents[path], e) {
+						t.Fatalf("content of %s does not contain %q\n\n%s", path, e, contents[path])
+					}
+				}
+			}
+		})
+	}
+}
+
+func newTestEncrypter(t *testing.T) encrypter.Interface {
+	c := encrypter.Config{
+		Key: []byte("12345678901234567890123456789012"),
+		IV:  []byte("1234567891234567"),
+	}
+
+	e, err := encrypter.New(c)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	return e
+}
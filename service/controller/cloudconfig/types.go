@@ -5,6 +5,8 @@ import (
 	"github.com/giantswarm/certs"
 	k8scloudconfig "github.com/giantswarm/k8scloudconfig/v6/pkg/template"
 	"github.com/giantswarm/randomkeys"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
 
 type templateData struct {
@@ -12,6 +14,7 @@ type templateData struct {
 	calicoAzureFileParams
 	cloudProviderConfFileParams
 	certificateDecrypterUnitParams
+	etcdMembersFileParams
 	ingressLBFileParams
 }
 
@@ -44,6 +47,20 @@ type certificateDecrypterUnitParams struct {
 	CertsPaths []string
 }
 
+type etcdMembersFileParams struct {
+	// EtcdDomain and EtcdPort are the client URL of the etcd cluster advertised
+	// by its members.
+	EtcdDomain  string
+	EtcdMembers []etcdMember
+	EtcdPort    int
+}
+
+type etcdMember struct {
+	Name    string
+	Domain  string
+	PeerURL string
+}
+
 type ingressLBFileParams struct {
 	ClusterDNSDomain string
 }
@@ -53,4 +70,7 @@ type IgnitionTemplateData struct {
 	ClusterCerts certs.Cluster
 	ClusterKeys  randomkeys.Cluster
 	Images       k8scloudconfig.Images
+	// NodePool is the worker node pool the worker cloudconfig is rendered
+	// for. It is ignored for the master cloudconfig.
+	NodePool key.NodePool
 }
@@ -44,6 +44,18 @@ func (c CloudConfig) NewWorkerTemplate(ctx context.Context, data IgnitionTemplat
 				},
 			},
 		}
+
+		// Nodes of additional node pools carry the labels and taints of their
+		// pool.
+		if labels := data.NodePool.KubeletLabels(); labels != "" {
+			if params.Cluster.Kubernetes.Kubelet.Labels != "" {
+				labels = params.Cluster.Kubernetes.Kubelet.Labels + "," + labels
+			}
+			params.Cluster.Kubernetes.Kubelet.Labels = labels
+		}
+		if taints := data.NodePool.KubeletTaints(); taints != "" {
+			params.Hyperkube.Kubelet.Docker.CommandExtraArgs = append(params.Hyperkube.Kubelet.Docker.CommandExtraArgs, "--register-with-taints="+taints)
+		}
 		params.Extension = &workerExtension{
 			baseExtension: be,
 		}
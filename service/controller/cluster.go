@@ -3,7 +3,6 @@ package controller
 import (
 	"net"
 
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/certs"
 	"github.com/giantswarm/k8sclient"
@@ -24,12 +23,21 @@ type ClusterConfig struct {
 	Logger           micrologger.Logger
 
 	Azure setting.Azure
+	// Azure client sets used when managing tenant cluster resources
+	AzureClientSetCache *client.Cache
+	Canary              setting.Canary
 	// Azure client set used when managing control plane resources
 	CPAzureClientSet *client.AzureClientSet
-	// Azure credentials used to create Azure client set for tenant clusters
-	GSClientCredentialsConfig auth.ClientCredentialsConfig
-	ProjectName               string
-	RegistryDomain            string
+	Drainer          setting.Drainer
+	// DryRun is the default dry-run mode of the deployments of ARM templates.
+	DryRun         bool
+	Maintenance    setting.Maintenance
+	ProjectName    string
+	RegistryDomain string
+	Remediation    setting.Remediation
+	RemoteCommand  setting.RemoteCommand
+	Rollback       setting.Rollback
+	RollingUpdate  setting.RollingUpdate
 
 	GuestSubnetMaskBits int
 
@@ -69,18 +77,26 @@ func NewCluster(config ClusterConfig) (*Cluster, error) {
 			Locker:        config.Locker,
 			Logger:        config.Logger,
 
-			Azure:                     config.Azure,
-			CPAzureClientSet:          config.CPAzureClientSet,
-			GSClientCredentialsConfig: config.GSClientCredentialsConfig,
-			GuestSubnetMaskBits:       config.GuestSubnetMaskBits,
-			Ignition:                  config.Ignition,
-			InstallationName:          config.InstallationName,
-			IPAMNetworkRange:          config.IPAMNetworkRange,
-			ProjectName:               config.ProjectName,
-			RegistryDomain:            config.RegistryDomain,
-			OIDC:                      config.OIDC,
-			SSOPublicKey:              config.SSOPublicKey,
-			VMSSCheckWorkers:          config.VMSSCheckWorkers,
+			Azure:               config.Azure,
+			AzureClientSetCache: config.AzureClientSetCache,
+			Canary:              config.Canary,
+			CPAzureClientSet:    config.CPAzureClientSet,
+			Drainer:             config.Drainer,
+			DryRun:              config.DryRun,
+			GuestSubnetMaskBits: config.GuestSubnetMaskBits,
+			Ignition:            config.Ignition,
+			InstallationName:    config.InstallationName,
+			IPAMNetworkRange:    config.IPAMNetworkRange,
+			Maintenance:         config.Maintenance,
+			ProjectName:         config.ProjectName,
+			RegistryDomain:      config.RegistryDomain,
+			Remediation:         config.Remediation,
+			RemoteCommand:       config.RemoteCommand,
+			Rollback:            config.Rollback,
+			RollingUpdate:       config.RollingUpdate,
+			OIDC:                config.OIDC,
+			SSOPublicKey:        config.SSOPublicKey,
+			VMSSCheckWorkers:    config.VMSSCheckWorkers,
 		}
 
 		resourceSet, err = NewResourceSet(c)
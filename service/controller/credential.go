@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/giantswarm/azure-operator/v4/client"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+const (
+	// CredentialResourceName is the name of the resource status the result of
+	// the organization's credential check is persisted in.
+	CredentialResourceName = "credential"
+	// CredentialCheckConditionType is the condition type holding the status of
+	// the last credential check.
+	CredentialCheckConditionType = "CredentialCheck"
+)
+
+type credentialChecker struct {
+	cache         *client.Cache
+	eventRecorder record.EventRecorder
+	k8sClient     k8sclient.Interface
+	logger        micrologger.Logger
+}
+
+// Check validates the organization's credentials of the given cluster and
+// records the result in the cluster's status. Failed checks only get reported.
+// Reconciliation proceeds with the credentials, so that clusters whose
+// credentials went bad can still be deleted.
+func (c *credentialChecker) Check(ctx context.Context, cr providerv1alpha1.AzureConfig) error {
+	check, err := c.cache.CheckOrganizationCredentials(ctx, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if check.Status == client.CredentialStatusUnknown {
+		// Transient errors are not attributed to the credentials, so the last
+		// known status is kept.
+		c.logger.LogCtx(ctx, "level", "warning", "message", "credential check could not be completed", "reason", check.Message)
+		return nil
+	}
+
+	err = c.recordCheck(ctx, cr, check)
+	if err != nil {
+		// Recording the result is best effort and must not block the
+		// reconciliation with valid credentials.
+		c.logger.LogCtx(ctx, "level", "warning", "message", "failed to record credential check", "stack", fmt.Sprintf("%#v", err))
+	}
+
+	if check.Failed() {
+		c.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("credential check failed with %s", check.Status), "reason", check.Message)
+	}
+
+	return nil
+}
+
+func (c *credentialChecker) recordCheck(ctx context.Context, cr providerv1alpha1.AzureConfig, check client.CredentialCheck) error {
+	// Get the newest CR version. Otherwise status update may fail because of:
+	//
+	//	 the object has been modified; please apply your changes to the
+	//	 latest version and try again
+	//
+	{
+		o, err := c.k8sClient.G8sClient().ProviderV1alpha1().AzureConfigs(cr.Namespace).Get(cr.Name, metav1.GetOptions{})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		cr = *o
+	}
+
+	now := check.Time
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	previous, changed := key.SetResourceCondition(&cr, CredentialResourceName, CredentialCheckConditionType, string(check.Status), now)
+	if !changed {
+		return nil
+	}
+
+	_, err := c.k8sClient.G8sClient().ProviderV1alpha1().AzureConfigs(cr.Namespace).UpdateStatus(&cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if check.Failed() {
+		c.eventRecorder.Eventf(&cr, corev1.EventTypeWarning, recorder.ReasonCredentialCheckFailed, "credential check failed with %s: %s", check.Status, check.Message)
+	} else if previous != "" {
+		c.eventRecorder.Eventf(&cr, corev1.EventTypeNormal, recorder.ReasonCredentialCheckSucceeded, "credential check succeeded after %s", previous)
+	}
+
+	return nil
+}
@@ -7,31 +7,43 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
 
 type Config struct {
-	Logger micrologger.Logger
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
 }
 
 type Debugger struct {
-	logger micrologger.Logger
+	eventRecorder record.EventRecorder
+	logger        micrologger.Logger
 }
 
 func New(config Config) (*Debugger, error) {
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
 
 	d := &Debugger{
-		logger: config.Logger,
+		eventRecorder: config.EventRecorder,
+		logger:        config.Logger,
 	}
 
 	return d, nil
 }
 
-func (d *Debugger) LogFailedDeployment(ctx context.Context, deployment resources.DeploymentExtended, err error) {
+// LogFailedDeployment logs the details of the given deployment in case it
+// failed and emits a warning event on the given object.
+func (d *Debugger) LogFailedDeployment(ctx context.Context, obj runtime.Object, deployment resources.DeploymentExtended, err error) {
 	if !key.IsFailedProvisioningState(*deployment.Properties.ProvisioningState) {
 		return
 	}
@@ -48,4 +60,6 @@ func (d *Debugger) LogFailedDeployment(ctx context.Context, deployment resources
 		"name", *deployment.Name,
 		"stack", microerror.JSON(microerror.Mask(err)),
 	)
+
+	d.eventRecorder.Eventf(obj, corev1.EventTypeWarning, recorder.ReasonDeploymentFailed, "deployment %s failed with correlation ID %s", *deployment.Name, *deployment.Properties.CorrelationID)
 }
@@ -12,3 +12,12 @@ var invalidConfigError = &microerror.Error{
 func IsInvalidConfig(err error) bool {
 	return microerror.Cause(err) == invalidConfigError
 }
+
+var invalidDurationError = &microerror.Error{
+	Kind: "invalidDurationError",
+}
+
+// IsInvalidDuration asserts invalidDurationError.
+func IsInvalidDuration(err error) bool {
+	return microerror.Cause(err) == invalidDurationError
+}
@@ -0,0 +1,137 @@
+package debugger
+
+import (
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/giantswarm/microerror"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	PrometheusNamespace = "azure_operator"
+	PrometheusSubsystem = "deployment"
+)
+
+var (
+	provisioningStateGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "provisioning_state",
+			Help:      "Provisioning state of an ARM deployment per cluster. The value is always 1.",
+		},
+		[]string{"cluster_id", "deployment", "state"},
+	)
+
+	durationGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "duration_seconds",
+			Help:      "Duration of the last run of an ARM deployment per cluster as reported by Azure.",
+		},
+		[]string{"cluster_id", "deployment"},
+	)
+)
+
+// iso8601DurationRegexp matches the durations reported by ARM for
+// deployments, e.g. PT1M23.4567S or P1DT2H.
+var iso8601DurationRegexp = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// provisioningStates tracks the provisioning state reported per cluster and
+// deployment, so that the series of the previous state can be removed when
+// the state changes. durations tracks the deployments a duration got reported
+// for. Both are used to remove all series of a cluster when it gets deleted.
+var (
+	durations          sync.Map
+	provisioningStates sync.Map
+)
+
+type deploymentKey struct {
+	clusterID  string
+	deployment string
+}
+
+func init() {
+	prometheus.MustRegister(provisioningStateGauge)
+	prometheus.MustRegister(durationGauge)
+}
+
+// ReportDeployment updates the provisioning state and duration metrics of the
+// given deployment of the given cluster.
+func (d *Debugger) ReportDeployment(clusterID string, deployment resources.DeploymentExtended) {
+	if deployment.Name == nil || deployment.Properties == nil {
+		return
+	}
+	name := *deployment.Name
+
+	if deployment.Properties.ProvisioningState != nil {
+		s := *deployment.Properties.ProvisioningState
+		k := deploymentKey{clusterID: clusterID, deployment: name}
+
+		previous, loaded := provisioningStates.Load(k)
+		if loaded && previous.(string) != s {
+			provisioningStateGauge.DeleteLabelValues(clusterID, name, previous.(string))
+		}
+
+		provisioningStates.Store(k, s)
+		provisioningStateGauge.WithLabelValues(clusterID, name, s).Set(1)
+	}
+
+	if deployment.Properties.Duration != nil {
+		duration, err := parseISO8601Duration(*deployment.Properties.Duration)
+		if err == nil {
+			durations.Store(deploymentKey{clusterID: clusterID, deployment: name}, struct{}{})
+			durationGauge.WithLabelValues(clusterID, name).Set(duration.Seconds())
+		}
+	}
+}
+
+// DeleteCluster removes the provisioning state and duration metrics of all
+// deployments of the given cluster.
+func (d *Debugger) DeleteCluster(clusterID string) {
+	provisioningStates.Range(func(k, v interface{}) bool {
+		if k.(deploymentKey).clusterID == clusterID {
+			provisioningStateGauge.DeleteLabelValues(clusterID, k.(deploymentKey).deployment, v.(string))
+			provisioningStates.Delete(k)
+		}
+		return true
+	})
+
+	durations.Range(func(k, _ interface{}) bool {
+		if k.(deploymentKey).clusterID == clusterID {
+			durationGauge.DeleteLabelValues(clusterID, k.(deploymentKey).deployment)
+			durations.Delete(k)
+		}
+		return true
+	})
+}
+
+func parseISO8601Duration(s string) (time.Duration, error) {
+	matches := iso8601DurationRegexp.FindStringSubmatch(s)
+	if matches == nil || s == "P" || s == "PT" {
+		return 0, microerror.Maskf(invalidDurationError, "%#q", s)
+	}
+
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var d time.Duration
+	for i, m := range matches[1:] {
+		if m == "" {
+			continue
+		}
+
+		v, err := strconv.ParseFloat(m, 64)
+		if err != nil {
+			return 0, microerror.Maskf(invalidDurationError, "%#q", s)
+		}
+
+		d += time.Duration(v * float64(units[i]))
+	}
+
+	return d, nil
+}
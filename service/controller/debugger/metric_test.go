@@ -0,0 +1,109 @@
+package debugger
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func Test_Debugger_DeleteCluster(t *testing.T) {
+	d := &Debugger{}
+
+	stateSeries := testutil.CollectAndCount(provisioningStateGauge)
+	durationSeries := testutil.CollectAndCount(durationGauge)
+
+	newDeployment := func(name string, state string) resources.DeploymentExtended {
+		return resources.DeploymentExtended{
+			Name: to.StringPtr(name),
+			Properties: &resources.DeploymentPropertiesExtended{
+				Duration:          to.StringPtr("PT1M"),
+				ProvisioningState: to.StringPtr(state),
+			},
+		}
+	}
+
+	d.ReportDeployment("a1b2c", newDeployment("cluster-main-template", "Running"))
+	d.ReportDeployment("a1b2c", newDeployment("cluster-main-template", "Succeeded"))
+	d.ReportDeployment("a1b2c", newDeployment("workers-vmss-template", "Failed"))
+	d.ReportDeployment("d3e4f", newDeployment("cluster-main-template", "Succeeded"))
+
+	if n := testutil.CollectAndCount(provisioningStateGauge); n != stateSeries+3 {
+		t.Fatalf("provisioning state series == %d, want %d", n, stateSeries+3)
+	}
+	if n := testutil.CollectAndCount(durationGauge); n != durationSeries+3 {
+		t.Fatalf("duration series == %d, want %d", n, durationSeries+3)
+	}
+
+	d.DeleteCluster("a1b2c")
+
+	if n := testutil.CollectAndCount(provisioningStateGauge); n != stateSeries+1 {
+		t.Fatalf("provisioning state series == %d, want %d", n, stateSeries+1)
+	}
+	if n := testutil.CollectAndCount(durationGauge); n != durationSeries+1 {
+		t.Fatalf("duration series == %d, want %d", n, durationSeries+1)
+	}
+
+	d.DeleteCluster("d3e4f")
+}
+
+func Test_parseISO8601Duration(t *testing.T) {
+	testCases := []struct {
+		name             string
+		input            string
+		expectedDuration time.Duration
+		errorMatcher     func(error) bool
+	}{
+		{
+			name:             "case 0: seconds with fraction",
+			input:            "PT23.5S",
+			expectedDuration: 23*time.Second + 500*time.Millisecond,
+		},
+		{
+			name:             "case 1: minutes and seconds",
+			input:            "PT12M3S",
+			expectedDuration: 12*time.Minute + 3*time.Second,
+		},
+		{
+			name:             "case 2: days and hours",
+			input:            "P1DT2H",
+			expectedDuration: 26 * time.Hour,
+		},
+		{
+			name:         "case 3: empty duration",
+			input:        "PT",
+			errorMatcher: IsInvalidDuration,
+		},
+		{
+			name:         "case 4: garbage",
+			input:        "12 minutes",
+			errorMatcher: IsInvalidDuration,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			d, err := parseISO8601Duration(tc.input)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if d != tc.expectedDuration {
+				t.Fatalf("duration == %s, want %s", d, tc.expectedDuration)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Package drainer drains tenant cluster nodes before their instances get
+// reimaged or terminated. Nodes are either drained within the operator or by
+// the node-operator, depending on the installation's settings.
+package drainer
+
+import (
+	"github.com/giantswarm/apiextensions/pkg/clientset/versioned"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
+)
+
+type Config struct {
+	G8sClient versioned.Interface
+	Logger    micrologger.Logger
+
+	Drainer setting.Drainer
+}
+
+// New returns the drainer of the type configured in the given settings.
+func New(config Config) (Interface, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	if err := config.Drainer.Validate(); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Drainer.%s", config, err)
+	}
+
+	switch config.Drainer.Type {
+	case setting.DrainerTypeNative:
+		d := &nativeDrainer{
+			logger: config.Logger,
+
+			gracePeriod: config.Drainer.GracePeriod,
+			timeout:     config.Drainer.Timeout,
+		}
+
+		return d, nil
+	default:
+		if config.G8sClient == nil {
+			return nil, microerror.Maskf(invalidConfigError, "%T.G8sClient must not be empty", config)
+		}
+
+		d := &nodeOperatorDrainer{
+			g8sClient: config.G8sClient,
+			logger:    config.Logger,
+		}
+
+		return d, nil
+	}
+}
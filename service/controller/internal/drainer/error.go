@@ -0,0 +1,21 @@
+package drainer
+
+import "github.com/giantswarm/microerror"
+
+var clientNotFoundError = &microerror.Error{
+	Kind: "clientNotFoundError",
+}
+
+// IsClientNotFound asserts clientNotFoundError.
+func IsClientNotFound(err error) bool {
+	return microerror.Cause(err) == clientNotFoundError
+}
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
@@ -0,0 +1,242 @@
+package drainer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+)
+
+const (
+	// annotationDrainStarted is the annotation holding the time draining of a
+	// node started at. It is used to time out draining across reconciliation
+	// loops and operator restarts.
+	annotationDrainStarted = "azure-operator.giantswarm.io/drain-started"
+)
+
+// nativeDrainer drains nodes using the tenant cluster's API. Pods are evicted
+// using the eviction API, so that PodDisruptionBudgets are respected. Pods of
+// DaemonSets and mirror pods are not evicted, because they would be scheduled
+// on the node again right away.
+type nativeDrainer struct {
+	logger micrologger.Logger
+
+	gracePeriod time.Duration
+	timeout     time.Duration
+}
+
+func (d *nativeDrainer) Drain(ctx context.Context, cr providerv1alpha1.AzureConfig, nodeName string) (Status, error) {
+	k8sClient, err := tenantK8sClient(ctx)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	node, err := k8sClient.CoreV1().Nodes().Get(nodeName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("tenant cluster node %#q does not exist", nodeName))
+		return StatusDrained, nil
+	} else if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	started, err := d.ensureCordoned(ctx, k8sClient, node)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	if time.Since(started) > d.timeout {
+		d.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("draining tenant cluster node %#q timed out after %s", nodeName, d.timeout))
+		return StatusTimedOut, nil
+	}
+
+	o := metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	}
+	pods, err := k8sClient.CoreV1().Pods(metav1.NamespaceAll).List(o)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	var pending int
+	for _, p := range pods.Items {
+		if p.Spec.NodeName != nodeName || !isEvictable(p) {
+			continue
+		}
+
+		pending++
+
+		if p.DeletionTimestamp != nil {
+			// The pod got evicted already and is terminating.
+			continue
+		}
+
+		err = d.evict(ctx, k8sClient, p)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+	}
+
+	if pending > 0 {
+		d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("%d pods are pending eviction from tenant cluster node %#q", pending, nodeName))
+		return StatusDraining, nil
+	}
+
+	d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("drained tenant cluster node %#q", nodeName))
+
+	return StatusDrained, nil
+}
+
+func (d *nativeDrainer) Reset(ctx context.Context, cr providerv1alpha1.AzureConfig, nodeName string) error {
+	k8sClient, err := tenantK8sClient(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = removeDrainStarted(k8sClient, nodeName)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (d *nativeDrainer) Cleanup(ctx context.Context, cr providerv1alpha1.AzureConfig) error {
+	k8sClient, err := tenantK8sClient(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	nodes, err := k8sClient.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, n := range nodes.Items {
+		if _, ok := n.GetAnnotations()[annotationDrainStarted]; !ok {
+			continue
+		}
+
+		err = removeDrainStarted(k8sClient, n.GetName())
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+// ensureCordoned marks the given node unschedulable and records the time
+// draining started at, unless this happened already. It returns the time
+// draining started at.
+func (d *nativeDrainer) ensureCordoned(ctx context.Context, k8sClient kubernetes.Interface, node *corev1.Node) (time.Time, error) {
+	started, err := time.Parse(time.RFC3339, node.GetAnnotations()[annotationDrainStarted])
+	if err == nil && node.Spec.Unschedulable {
+		return started, nil
+	}
+	if err != nil {
+		started = time.Now()
+	}
+
+	d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("cordoning tenant cluster node %#q", node.GetName()))
+
+	annotations := node.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[annotationDrainStarted] = started.UTC().Format(time.RFC3339)
+	node.SetAnnotations(annotations)
+	node.Spec.Unschedulable = true
+
+	_, err = k8sClient.CoreV1().Nodes().Update(node)
+	if err != nil {
+		return time.Time{}, microerror.Mask(err)
+	}
+
+	d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("cordoned tenant cluster node %#q", node.GetName()))
+
+	return started, nil
+}
+
+func (d *nativeDrainer) evict(ctx context.Context, k8sClient kubernetes.Interface, pod corev1.Pod) error {
+	e := &policyv1beta1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.GetName(),
+			Namespace: pod.GetNamespace(),
+		},
+	}
+	if d.gracePeriod >= 0 {
+		s := int64(d.gracePeriod.Seconds())
+		e.DeleteOptions = &metav1.DeleteOptions{
+			GracePeriodSeconds: &s,
+		}
+	}
+
+	err := k8sClient.CoreV1().Pods(pod.GetNamespace()).Evict(e)
+	if errors.IsNotFound(err) {
+		// The pod is gone already.
+	} else if errors.IsTooManyRequests(err) {
+		d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("eviction of pod %s/%s is blocked by a pod disruption budget", pod.GetNamespace(), pod.GetName()))
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else {
+		d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("evicted pod %s/%s", pod.GetNamespace(), pod.GetName()))
+	}
+
+	return nil
+}
+
+// isEvictable returns false for pods which must not or need not be evicted
+// when draining their node.
+func isEvictable(pod corev1.Pod) bool {
+	if _, ok := pod.GetAnnotations()[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+
+	if c := metav1.GetControllerOf(&pod); c != nil && c.Kind == "DaemonSet" {
+		return false
+	}
+
+	return true
+}
+
+func removeDrainStarted(k8sClient kubernetes.Interface, nodeName string) error {
+	patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, annotationDrainStarted))
+
+	_, err := k8sClient.CoreV1().Nodes().Patch(nodeName, types.MergePatchType, patch)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func tenantK8sClient(ctx context.Context) (kubernetes.Interface, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	if cc.Client.TenantCluster.K8s == nil {
+		return nil, microerror.Mask(clientNotFoundError)
+	}
+
+	return cc.Client.TenantCluster.K8s, nil
+}
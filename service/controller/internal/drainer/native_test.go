@@ -0,0 +1,190 @@
+package drainer
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+)
+
+func Test_nativeDrainer_Drain(t *testing.T) {
+	testCases := []struct {
+		name              string
+		node              *corev1.Node
+		pods              []corev1.Pod
+		evictionError     error
+		expectedStatus    Status
+		expectedEvictions []string
+	}{
+		{
+			name:           "case 0: node does not exist",
+			expectedStatus: StatusDrained,
+		},
+		{
+			name: "case 1: node with pods to evict",
+			node: newNode(nil, false),
+			pods: []corev1.Pod{
+				newPod("app", nil),
+				newPod("daemon", func(p *corev1.Pod) {
+					p.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "daemon", Controller: to.BoolPtr(true)}}
+				}),
+				newPod("mirror", func(p *corev1.Pod) {
+					p.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "mirror"}
+				}),
+				newPod("job", func(p *corev1.Pod) {
+					p.Status.Phase = corev1.PodSucceeded
+				}),
+				newPod("terminating", func(p *corev1.Pod) {
+					now := metav1.Now()
+					p.DeletionTimestamp = &now
+				}),
+			},
+			expectedStatus:    StatusDraining,
+			expectedEvictions: []string{"default/app"},
+		},
+		{
+			name: "case 2: node without pods to evict",
+			node: newNode(nil, false),
+			pods: []corev1.Pod{
+				newPod("daemon", func(p *corev1.Pod) {
+					p.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "daemon", Controller: to.BoolPtr(true)}}
+				}),
+			},
+			expectedStatus: StatusDrained,
+		},
+		{
+			name:              "case 3: eviction blocked by a pod disruption budget",
+			node:              newNode(nil, false),
+			pods:              []corev1.Pod{newPod("app", nil)},
+			evictionError:     errors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10),
+			expectedStatus:    StatusDraining,
+			expectedEvictions: []string{"default/app"},
+		},
+		{
+			name: "case 4: draining timed out",
+			node: newNode(map[string]string{
+				annotationDrainStarted: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+			}, true),
+			pods:           []corev1.Pod{newPod("app", nil)},
+			expectedStatus: StatusTimedOut,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var objects []runtime.Object
+			if tc.node != nil {
+				objects = append(objects, tc.node)
+			}
+			for i := range tc.pods {
+				objects = append(objects, &tc.pods[i])
+			}
+
+			k8sClient := fake.NewSimpleClientset(objects...)
+
+			var evictions []string
+			k8sClient.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() != "eviction" {
+					return false, nil, nil
+				}
+
+				e := action.(k8stesting.CreateAction).GetObject().(*policyv1beta1.Eviction)
+				evictions = append(evictions, e.Namespace+"/"+e.Name)
+
+				return true, nil, tc.evictionError
+			})
+
+			ctx := controllercontext.NewContext(context.Background(), controllercontext.Context{
+				Client: controllercontext.ContextClient{
+					TenantCluster: controllercontext.ContextClientTenantCluster{
+						K8s: k8sClient,
+					},
+				},
+			})
+
+			d := &nativeDrainer{
+				logger: microloggertest.New(),
+
+				gracePeriod: -1,
+				timeout:     10 * time.Minute,
+			}
+
+			status, err := d.Drain(ctx, providerv1alpha1.AzureConfig{}, "worker-0")
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if status != tc.expectedStatus {
+				t.Fatalf("status == %q, want %q", status, tc.expectedStatus)
+			}
+
+			if !cmp.Equal(evictions, tc.expectedEvictions) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedEvictions, evictions))
+			}
+
+			if tc.node == nil {
+				return
+			}
+
+			node, err := k8sClient.CoreV1().Nodes().Get("worker-0", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+			if !node.Spec.Unschedulable {
+				t.Fatalf("node is not cordoned")
+			}
+			if _, ok := node.Annotations[annotationDrainStarted]; !ok {
+				t.Fatalf("node has no %#q annotation", annotationDrainStarted)
+			}
+		})
+	}
+}
+
+func newNode(annotations map[string]string, unschedulable bool) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: annotations,
+			Name:        "worker-0",
+		},
+		Spec: corev1.NodeSpec{
+			Unschedulable: unschedulable,
+		},
+	}
+}
+
+func newPod(name string, modify func(p *corev1.Pod)) corev1.Pod {
+	p := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: corev1.PodSpec{
+			NodeName: "worker-0",
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+		},
+	}
+
+	if modify != nil {
+		modify(&p)
+	}
+
+	return p
+}
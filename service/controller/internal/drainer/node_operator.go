@@ -0,0 +1,129 @@
+package drainer
+
+import (
+	"context"
+	"fmt"
+
+	corev1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/core/v1alpha1"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/apiextensions/pkg/clientset/versioned"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// nodeOperatorDrainer drains nodes by creating DrainerConfig custom resources
+// in the namespace of the cluster, which are reconciled by the node-operator.
+type nodeOperatorDrainer struct {
+	g8sClient versioned.Interface
+	logger    micrologger.Logger
+}
+
+func (d *nodeOperatorDrainer) Drain(ctx context.Context, cr providerv1alpha1.AzureConfig, nodeName string) (Status, error) {
+	dc, err := d.g8sClient.CoreV1alpha1().DrainerConfigs(key.ClusterID(cr)).Get(nodeName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		err = d.createDrainerConfig(ctx, cr, nodeName)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		return StatusDraining, nil
+	} else if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	switch {
+	case dc.Status.HasDrainedCondition():
+		return StatusDrained, nil
+	case dc.Status.HasTimeoutCondition():
+		return StatusTimedOut, nil
+	default:
+		return StatusDraining, nil
+	}
+}
+
+func (d *nodeOperatorDrainer) Reset(ctx context.Context, cr providerv1alpha1.AzureConfig, nodeName string) error {
+	d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deleting drainer config for tenant cluster node %#q", nodeName))
+
+	err := d.g8sClient.CoreV1alpha1().DrainerConfigs(key.ClusterID(cr)).Delete(nodeName, &metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		d.logger.LogCtx(ctx, "level", "debug", "message", "did not delete drainer config for tenant cluster node")
+		d.logger.LogCtx(ctx, "level", "debug", "message", "drainer config for tenant cluster node does not exist")
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else {
+		d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deleted drainer config for tenant cluster node %#q", nodeName))
+	}
+
+	return nil
+}
+
+func (d *nodeOperatorDrainer) Cleanup(ctx context.Context, cr providerv1alpha1.AzureConfig) error {
+	d.logger.LogCtx(ctx, "level", "debug", "message", "deleting all drainerconfigs")
+
+	o := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", key.ClusterIDLabel, key.ClusterID(cr)),
+	}
+
+	list, err := d.g8sClient.CoreV1alpha1().DrainerConfigs(metav1.NamespaceAll).List(o)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, dc := range list.Items {
+		err = d.g8sClient.CoreV1alpha1().DrainerConfigs(dc.Namespace).Delete(dc.Name, &metav1.DeleteOptions{})
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deleted %d drainerconfigs", len(list.Items)))
+
+	return nil
+}
+
+func (d *nodeOperatorDrainer) createDrainerConfig(ctx context.Context, cr providerv1alpha1.AzureConfig, nodeName string) error {
+	d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("creating drainer config for tenant cluster node %#q", nodeName))
+
+	c := &corev1alpha1.DrainerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				key.ClusterIDLabel: key.ClusterID(cr),
+			},
+			Name: nodeName,
+		},
+		Spec: corev1alpha1.DrainerConfigSpec{
+			Guest: corev1alpha1.DrainerConfigSpecGuest{
+				Cluster: corev1alpha1.DrainerConfigSpecGuestCluster{
+					API: corev1alpha1.DrainerConfigSpecGuestClusterAPI{
+						Endpoint: key.ClusterAPIEndpoint(cr),
+					},
+					ID: key.ClusterID(cr),
+				},
+				Node: corev1alpha1.DrainerConfigSpecGuestNode{
+					Name: nodeName,
+				},
+			},
+			VersionBundle: corev1alpha1.DrainerConfigSpecVersionBundle{
+				Version: "0.2.0",
+			},
+		},
+	}
+
+	_, err := d.g8sClient.CoreV1alpha1().DrainerConfigs(key.ClusterID(cr)).Create(c)
+	if errors.IsAlreadyExists(err) {
+		d.logger.LogCtx(ctx, "level", "debug", "message", "did not create drainer config for tenant cluster node")
+		d.logger.LogCtx(ctx, "level", "debug", "message", "drainer config for tenant cluster node does already exist")
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else {
+		d.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("created drainer config for tenant cluster node %#q", nodeName))
+	}
+
+	return nil
+}
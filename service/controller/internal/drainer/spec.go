@@ -0,0 +1,34 @@
+package drainer
+
+import (
+	"context"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+)
+
+// Status is the status of draining a node.
+type Status string
+
+const (
+	// StatusDraining means that pods are still being evicted from the node.
+	StatusDraining Status = "Draining"
+	// StatusDrained means that all pods which have to be evicted are gone.
+	StatusDrained Status = "Drained"
+	// StatusTimedOut means that the node did not get drained in time.
+	StatusTimedOut Status = "TimedOut"
+)
+
+// Interface drains tenant cluster nodes. Implementations do not block until a
+// node is drained. Drain is meant to be called once per reconciliation loop
+// until the returned status is either StatusDrained or StatusTimedOut.
+type Interface interface {
+	// Drain ensures that the node with the given name of the given cluster is
+	// cordoned and its pods get evicted, and returns the status of draining
+	// it.
+	Drain(ctx context.Context, cr providerv1alpha1.AzureConfig, nodeName string) (Status, error)
+	// Reset removes the drain state of the node with the given name, so that
+	// the next call to Drain starts over. Cordoned nodes are not uncordoned.
+	Reset(ctx context.Context, cr providerv1alpha1.AzureConfig, nodeName string) error
+	// Cleanup removes the drain state of all nodes of the given cluster.
+	Cleanup(ctx context.Context, cr providerv1alpha1.AzureConfig) error
+}
@@ -0,0 +1,201 @@
+// Package etcd implements a client for the etcd clusters of tenant clusters.
+// It uses the JSON gateway of the etcd v3 API, which the control plane
+// reaches through the etcd load balancer of tenant clusters.
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/certs"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+
+	// healthKey is the key read to check the health of etcd clusters, like
+	// etcdctl endpoint health does. It does not need to exist.
+	healthKey = "health"
+)
+
+// apiPrefixes are the paths of the JSON gateway of the etcd v3 API, newest
+// first. Older etcd releases only serve the beta path, newer ones only the
+// stable one.
+var apiPrefixes = []string{
+	"/v3",
+	"/v3beta",
+}
+
+type Config struct {
+	CertsSearcher certs.Interface
+	Logger        micrologger.Logger
+}
+
+type Client struct {
+	certsSearcher certs.Interface
+	logger        micrologger.Logger
+}
+
+func New(config Config) (*Client, error) {
+	if config.CertsSearcher == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CertsSearcher must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	c := &Client{
+		certsSearcher: config.CertsSearcher,
+		logger:        config.Logger,
+	}
+
+	return c, nil
+}
+
+func (c *Client) Healthy(ctx context.Context, cr providerv1alpha1.AzureConfig) (bool, error) {
+	httpClient, err := c.httpClient(cr)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	req := map[string]string{
+		"key": base64.StdEncoding.EncodeToString([]byte(healthKey)),
+	}
+
+	err = post(ctx, httpClient, endpoint(cr), "/kv/range", req, nil)
+	if IsExecutionFailed(err) {
+		c.logger.LogCtx(ctx, "level", "debug", "message", "etcd cluster is not healthy", "stack", fmt.Sprintf("%#v", err))
+		return false, nil
+	} else if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	return true, nil
+}
+
+func (c *Client) Members(ctx context.Context, cr providerv1alpha1.AzureConfig) ([]Member, error) {
+	httpClient, err := c.httpClient(cr)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var res struct {
+		Members []Member `json:"members"`
+	}
+
+	err = post(ctx, httpClient, endpoint(cr), "/cluster/member/list", struct{}{}, &res)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return res.Members, nil
+}
+
+// httpClient returns a client authenticating with the etcd certificates of
+// the given cluster.
+func (c *Client) httpClient(cr providerv1alpha1.AzureConfig) (*http.Client, error) {
+	tlsCerts, err := c.certsSearcher.SearchTLS(key.ClusterID(cr), certs.EtcdCert)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	certificate, err := tls.X509KeyPair(tlsCerts.Crt, tlsCerts.Key)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(tlsCerts.CA) {
+		return nil, microerror.Maskf(executionFailedError, "etcd CA of cluster %#q is not valid PEM", key.ClusterID(cr))
+	}
+
+	httpClient := &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				Certificates: []tls.Certificate{certificate},
+				RootCAs:      rootCAs,
+			},
+		},
+	}
+
+	return httpClient, nil
+}
+
+func endpoint(cr providerv1alpha1.AzureConfig) string {
+	return fmt.Sprintf("https://%s", key.ClusterEtcdDomain(cr))
+}
+
+// post sends the given request to the given path of the JSON gateway and
+// decodes the response into res, unless it is nil. Every API prefix is tried
+// until one is served.
+func post(ctx context.Context, httpClient *http.Client, endpoint, path string, req, res interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, prefix := range apiPrefixes {
+		err = postOnce(ctx, httpClient, endpoint+prefix+path, body, res)
+		if IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	}
+
+	return microerror.Maskf(notFoundError, "no etcd API found at %#q", endpoint)
+}
+
+func postOnce(ctx context.Context, httpClient *http.Client, url string, body []byte, res interface{}) error {
+	httpReq, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpRes, err := httpClient.Do(httpReq.WithContext(ctx))
+	if err != nil {
+		return microerror.Maskf(executionFailedError, "%s", err)
+	}
+	defer httpRes.Body.Close()
+
+	b, err := ioutil.ReadAll(httpRes.Body)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if httpRes.StatusCode == http.StatusNotFound {
+		return microerror.Maskf(notFoundError, "%s", url)
+	}
+	if httpRes.StatusCode != http.StatusOK {
+		return microerror.Maskf(executionFailedError, "%s returned %d: %s", url, httpRes.StatusCode, strings.TrimSpace(string(b)))
+	}
+
+	if res == nil {
+		return nil
+	}
+
+	err = json.Unmarshal(b, res)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package etcd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_post_MemberList(t *testing.T) {
+	testCases := []struct {
+		name            string
+		prefix          string
+		status          int
+		body            string
+		expectedMembers []Member
+		errorMatcher    func(err error) bool
+	}{
+		{
+			name:   "case 0: stable API with IDs encoded as strings",
+			prefix: "/v3",
+			status: http.StatusOK,
+			body:   `{"members":[{"ID":"10276657743932975437","name":"etcd1","peerURLs":["https://etcd1.example.com:2380"],"clientURLs":["https://etcd.example.com:2379"]},{"ID":"2","peerURLs":["https://etcd2.example.com:2380"]}]}`,
+			expectedMembers: []Member{
+				{ID: "10276657743932975437", Name: "etcd1", PeerURLs: []string{"https://etcd1.example.com:2380"}, ClientURLs: []string{"https://etcd.example.com:2379"}},
+				{ID: "2", PeerURLs: []string{"https://etcd2.example.com:2380"}},
+			},
+		},
+		{
+			name:   "case 1: beta API with IDs encoded as numbers",
+			prefix: "/v3beta",
+			status: http.StatusOK,
+			body:   `{"members":[{"ID":1,"name":"etcd0"}]}`,
+			expectedMembers: []Member{
+				{ID: "1", Name: "etcd0"},
+			},
+		},
+		{
+			name:         "case 2: unknown API",
+			prefix:       "/v2",
+			status:       http.StatusOK,
+			body:         `{}`,
+			errorMatcher: IsNotFound,
+		},
+		{
+			name:         "case 3: etcd failure",
+			prefix:       "/v3",
+			status:       http.StatusServiceUnavailable,
+			body:         `{"error":"etcdserver: request timed out"}`,
+			errorMatcher: IsExecutionFailed,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != tc.prefix+"/cluster/member/list" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			var res struct {
+				Members []Member `json:"members"`
+			}
+
+			err := post(context.Background(), server.Client(), server.URL, "/cluster/member/list", struct{}{}, &res)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if tc.errorMatcher != nil {
+				return
+			}
+
+			if !cmp.Equal(res.Members, tc.expectedMembers) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedMembers, res.Members))
+			}
+		})
+	}
+}
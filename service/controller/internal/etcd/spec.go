@@ -0,0 +1,41 @@
+package etcd
+
+import (
+	"context"
+	"strings"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+)
+
+// Interface talks to the etcd clusters of tenant clusters through their etcd
+// load balancer.
+type Interface interface {
+	// Healthy returns true when the etcd cluster of the given cluster serves
+	// linearizable reads, which requires a quorum of its members.
+	Healthy(ctx context.Context, cr providerv1alpha1.AzureConfig) (bool, error)
+	// Members returns the members of the etcd cluster of the given cluster.
+	Members(ctx context.Context, cr providerv1alpha1.AzureConfig) ([]Member, error)
+}
+
+// Member is a member of an etcd cluster.
+type Member struct {
+	ID         MemberID `json:"ID"`
+	Name       string   `json:"name"`
+	PeerURLs   []string `json:"peerURLs"`
+	ClientURLs []string `json:"clientURLs"`
+}
+
+// Started returns true when the member joined the cluster. Members which got
+// added but did not start yet have no name.
+func (m Member) Started() bool {
+	return m.Name != ""
+}
+
+// MemberID is the ID of an etcd member. The JSON gateway encodes 64 bit
+// integers as strings, but older releases did not.
+type MemberID string
+
+func (id *MemberID) UnmarshalJSON(b []byte) error {
+	*id = MemberID(strings.Trim(string(b), `"`))
+	return nil
+}
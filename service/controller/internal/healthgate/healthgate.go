@@ -0,0 +1,46 @@
+package healthgate
+
+import (
+	"context"
+	"fmt"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Default returns the gates checked for canary nodes by default: the node has
+// to be ready, the kube-system pods on it have to run and the probe declared
+// for the cluster has to succeed.
+func Default() []Gate {
+	return []Gate{
+		NodeReady{},
+		SystemPods{},
+		ProbeJob{},
+	}
+}
+
+// Run checks the given gates in order and returns the first result which did
+// not pass. Later gates are only checked once the earlier ones passed, so that
+// e.g. probes only run on ready nodes.
+func Run(ctx context.Context, gates []Gate, cr providerv1alpha1.AzureConfig, k8sClient kubernetes.Interface, node corev1.Node) (Result, error) {
+	for _, g := range gates {
+		r, err := g.Check(ctx, cr, k8sClient, node)
+		if err != nil {
+			return Result{}, microerror.Mask(err)
+		}
+
+		if r.Status != StatusPassed {
+			r.Message = fmt.Sprintf("gate %s: %s", g.Name(), r.Message)
+			return r, nil
+		}
+	}
+
+	r := Result{
+		Status:  StatusPassed,
+		Message: fmt.Sprintf("%d gates passed", len(gates)),
+	}
+
+	return r, nil
+}
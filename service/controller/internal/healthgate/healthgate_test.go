@@ -0,0 +1,224 @@
+package healthgate
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+const (
+	testProbe = `{"template":{"spec":{"containers":[{"name":"probe","image":"busybox","command":["true"]}]}}}`
+)
+
+func Test_Run(t *testing.T) {
+	testCases := []struct {
+		name           string
+		ready          bool
+		probe          string
+		objects        []runtime.Object
+		expectedStatus Status
+		expectedJob    bool
+		// expectedJobNode is the node of the probe job, which is only set for
+		// jobs created by the gate.
+		expectedJobNode string
+	}{
+		{
+			name:           "case 0: node not ready",
+			expectedStatus: StatusPending,
+		},
+		{
+			name:           "case 1: no system pods on the node yet",
+			ready:          true,
+			expectedStatus: StatusPending,
+		},
+		{
+			name:           "case 2: system pod crash looping",
+			ready:          true,
+			objects:        []runtime.Object{newWaitingSystemPod("calico-node", "CrashLoopBackOff", 5, time.Minute)},
+			expectedStatus: StatusFailed,
+		},
+		{
+			name:           "case 3: system pod not ready",
+			ready:          true,
+			objects:        []runtime.Object{newSystemPod("calico-node", false)},
+			expectedStatus: StatusPending,
+		},
+		{
+			name:           "case 4: healthy node without probe",
+			ready:          true,
+			objects:        []runtime.Object{newSystemPod("calico-node", true)},
+			expectedStatus: StatusPassed,
+		},
+		{
+			name:            "case 5: probe job gets created",
+			ready:           true,
+			probe:           testProbe,
+			objects:         []runtime.Object{newSystemPod("calico-node", true)},
+			expectedStatus:  StatusPending,
+			expectedJob:     true,
+			expectedJobNode: "eggs2-worker-000002",
+		},
+		{
+			name:  "case 6: probe job succeeded",
+			ready: true,
+			probe: testProbe,
+			objects: []runtime.Object{
+				newSystemPod("calico-node", true),
+				newProbeJobWithStatus(batchv1.JobStatus{Succeeded: 1}),
+			},
+			expectedStatus: StatusPassed,
+			expectedJob:    true,
+		},
+		{
+			name:  "case 7: probe job failed",
+			ready: true,
+			probe: testProbe,
+			objects: []runtime.Object{
+				newSystemPod("calico-node", true),
+				newProbeJobWithStatus(batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}}}),
+			},
+			expectedStatus: StatusFailed,
+			expectedJob:    true,
+		},
+		{
+			name:           "case 8: invalid probe",
+			ready:          true,
+			probe:          `[]`,
+			objects:        []runtime.Object{newSystemPod("calico-node", true)},
+			expectedStatus: StatusFailed,
+		},
+		{
+			name:           "case 9: system pod crash looping within the grace period",
+			ready:          true,
+			objects:        []runtime.Object{newWaitingSystemPod("calico-node", "CrashLoopBackOff", 2, time.Minute)},
+			expectedStatus: StatusPending,
+		},
+		{
+			name:           "case 10: image of system pod failed to be pulled once",
+			ready:          true,
+			objects:        []runtime.Object{newWaitingSystemPod("calico-node", "ErrImagePull", 0, time.Minute)},
+			expectedStatus: StatusPending,
+		},
+		{
+			name:           "case 11: image of system pod backing off within the grace period",
+			ready:          true,
+			objects:        []runtime.Object{newWaitingSystemPod("calico-node", "ImagePullBackOff", 0, time.Minute)},
+			expectedStatus: StatusPending,
+		},
+		{
+			name:           "case 12: image of system pod backing off after the grace period",
+			ready:          true,
+			objects:        []runtime.Object{newWaitingSystemPod("calico-node", "ImagePullBackOff", 0, 10*time.Minute)},
+			expectedStatus: StatusFailed,
+		},
+		{
+			name:           "case 13: invalid image name of system pod",
+			ready:          true,
+			objects:        []runtime.Object{newWaitingSystemPod("calico-node", "InvalidImageName", 0, time.Minute)},
+			expectedStatus: StatusFailed,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			cr := providerv1alpha1.AzureConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{},
+				},
+			}
+			if tc.probe != "" {
+				cr.Annotations[key.AnnotationCanaryProbe] = tc.probe
+			}
+
+			node := corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "eggs2-worker-000002",
+				},
+			}
+			if tc.ready {
+				node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+			}
+
+			k8sClient := fake.NewSimpleClientset(tc.objects...)
+
+			r, err := Run(context.Background(), Default(), cr, k8sClient, node)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if r.Status != tc.expectedStatus {
+				t.Fatalf("status == %q, want %q (%s)", r.Status, tc.expectedStatus, r.Message)
+			}
+
+			job, err := k8sClient.BatchV1().Jobs(systemNamespace).Get(probeJobName(node), metav1.GetOptions{})
+			if !tc.expectedJob {
+				if err == nil {
+					t.Fatalf("job %s exists, want none", job.Name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+			if job.Spec.Template.Spec.NodeName != tc.expectedJobNode {
+				t.Fatalf("job runs on node %#q, want %#q", job.Spec.Template.Spec.NodeName, tc.expectedJobNode)
+			}
+		})
+	}
+}
+
+func newSystemPod(name string, ready bool) *corev1.Pod {
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         systemNamespace,
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Minute)),
+		},
+		Spec: corev1.PodSpec{
+			NodeName: "eggs2-worker-000002",
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+		},
+	}
+
+	if ready {
+		p.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	}
+
+	return p
+}
+
+// newWaitingSystemPod returns a system pod created the given time ago, whose
+// container waits with the given reason after the given number of restarts.
+func newWaitingSystemPod(name string, waitingReason string, restartCount int32, age time.Duration) *corev1.Pod {
+	p := newSystemPod(name, false)
+	p.CreationTimestamp = metav1.NewTime(time.Now().Add(-age))
+	p.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{Name: name, RestartCount: restartCount, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: waitingReason}}},
+	}
+
+	return p
+}
+
+func newProbeJobWithStatus(status batchv1.JobStatus) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "canary-probe-eggs2-worker-000002",
+			Namespace: systemNamespace,
+		},
+		Status: status,
+	}
+}
@@ -0,0 +1,27 @@
+package healthgate
+
+import (
+	"context"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// NodeReady passes once the node is ready. It never fails, nodes which do not
+// become ready are caught by the deadline of the canary.
+type NodeReady struct{}
+
+func (NodeReady) Name() string {
+	return "NodeReady"
+}
+
+func (NodeReady) Check(ctx context.Context, cr providerv1alpha1.AzureConfig, k8sClient kubernetes.Interface, node corev1.Node) (Result, error) {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady && c.Status == corev1.ConditionTrue {
+			return Result{Status: StatusPassed, Message: "node is ready"}, nil
+		}
+	}
+
+	return Result{Status: StatusPending, Message: "node is not ready"}, nil
+}
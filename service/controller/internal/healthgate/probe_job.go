@@ -0,0 +1,93 @@
+package healthgate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/giantswarm/azure-operator/v4/pkg/project"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// ProbeJob runs the probe declared with the key.AnnotationCanaryProbe
+// annotation as a Job pinned to the node. It passes once the Job succeeds and
+// fails when the Job fails. Clusters without probe pass right away.
+//
+// The Job is named after the node, so that every canary node gets probed once.
+// Finished Jobs are kept for inspection.
+type ProbeJob struct{}
+
+func (ProbeJob) Name() string {
+	return "ProbeJob"
+}
+
+func (ProbeJob) Check(ctx context.Context, cr providerv1alpha1.AzureConfig, k8sClient kubernetes.Interface, node corev1.Node) (Result, error) {
+	v, ok := cr.GetAnnotations()[key.AnnotationCanaryProbe]
+	if !ok {
+		return Result{Status: StatusPassed, Message: "no probe declared"}, nil
+	}
+
+	name := probeJobName(node)
+
+	job, err := k8sClient.BatchV1().Jobs(systemNamespace).Get(name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		var spec batchv1.JobSpec
+		err = json.Unmarshal([]byte(v), &spec)
+		if err != nil {
+			return Result{Status: StatusFailed, Message: fmt.Sprintf("annotation %#q must be a JSON Job spec: %s", key.AnnotationCanaryProbe, err)}, nil
+		}
+
+		_, err = k8sClient.BatchV1().Jobs(systemNamespace).Create(newProbeJob(name, node, spec))
+		if err != nil {
+			return Result{}, microerror.Mask(err)
+		}
+
+		return Result{Status: StatusPending, Message: fmt.Sprintf("created job %s/%s", systemNamespace, name)}, nil
+	} else if err != nil {
+		return Result{}, microerror.Mask(err)
+	}
+
+	if job.Status.Succeeded > 0 {
+		return Result{Status: StatusPassed, Message: fmt.Sprintf("job %s/%s succeeded", systemNamespace, name)}, nil
+	}
+
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			return Result{Status: StatusFailed, Message: fmt.Sprintf("job %s/%s failed: %s", systemNamespace, name, c.Message)}, nil
+		}
+	}
+
+	return Result{Status: StatusPending, Message: fmt.Sprintf("job %s/%s did not finish yet", systemNamespace, name)}, nil
+}
+
+func newProbeJob(name string, node corev1.Node, spec batchv1.JobSpec) *batchv1.Job {
+	spec.Template.Spec.NodeName = node.GetName()
+	if spec.Template.Spec.RestartPolicy == "" {
+		spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: systemNamespace,
+			Labels: map[string]string{
+				key.LabelManagedBy: project.Name(),
+			},
+		},
+		Spec: spec,
+	}
+
+	return job
+}
+
+func probeJobName(node corev1.Node) string {
+	return fmt.Sprintf("canary-probe-%s", node.GetName())
+}
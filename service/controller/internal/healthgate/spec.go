@@ -0,0 +1,41 @@
+package healthgate
+
+import (
+	"context"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Status is the outcome of a health gate.
+type Status string
+
+const (
+	// StatusPassed means that the tenant cluster is healthy as far as the gate
+	// is concerned.
+	StatusPassed Status = "Passed"
+	// StatusPending means that the gate can not tell yet, e.g. because the
+	// node is still bootstrapping.
+	StatusPending Status = "Pending"
+	// StatusFailed means that the gate found the tenant cluster unhealthy.
+	StatusFailed Status = "Failed"
+)
+
+// Result is the outcome of a health gate along with a human readable message
+// explaining it.
+type Result struct {
+	Status  Status
+	Message string
+}
+
+// Gate checks the health of a tenant cluster after a new node came up in it.
+// Gates do not block. Check is meant to be called once per reconciliation
+// loop until the returned status is either StatusPassed or StatusFailed.
+type Gate interface {
+	// Name returns the name of the gate used in messages.
+	Name() string
+	// Check checks the health of the given cluster after the given node came
+	// up, using the given client of the tenant cluster.
+	Check(ctx context.Context, cr providerv1alpha1.AzureConfig, k8sClient kubernetes.Interface, node corev1.Node) (Result, error)
+}
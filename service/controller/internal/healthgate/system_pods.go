@@ -0,0 +1,116 @@
+package healthgate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	systemNamespace = "kube-system"
+
+	// backOffGracePeriod is the time pods are given to recover from backing
+	// off, e.g. while the registry or the network of a fresh node are not
+	// reachable yet.
+	backOffGracePeriod = 5 * time.Minute
+	// backOffRestartThreshold is the number of restarts after which crash
+	// looping containers are considered failed before backOffGracePeriod
+	// passed.
+	backOffRestartThreshold = 5
+)
+
+// failedWaitingReasons are the reasons of waiting containers which do not
+// recover without intervention.
+var failedWaitingReasons = map[string]bool{
+	"CreateContainerConfigError": true,
+	"InvalidImageName":           true,
+}
+
+// backOffWaitingReasons are the reasons of waiting containers which may still
+// recover. They are only considered failed once the container restarted
+// backOffRestartThreshold times or the pod exists for longer than
+// backOffGracePeriod.
+var backOffWaitingReasons = map[string]bool{
+	"CrashLoopBackOff": true,
+	"ImagePullBackOff": true,
+}
+
+// SystemPods passes once all kube-system pods on the node run and are ready.
+// It fails when the configuration or image name of one of their containers is
+// invalid, or when one of their containers keeps crash looping or its image
+// keeps failing to be pulled. Pods which are still backing off remain pending,
+// so that the timeout of the canary decides about them.
+type SystemPods struct{}
+
+func (SystemPods) Name() string {
+	return "SystemPods"
+}
+
+func (SystemPods) Check(ctx context.Context, cr providerv1alpha1.AzureConfig, k8sClient kubernetes.Interface, node corev1.Node) (Result, error) {
+	podList, err := k8sClient.CoreV1().Pods(systemNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return Result{}, microerror.Mask(err)
+	}
+
+	var pods []corev1.Pod
+	for _, p := range podList.Items {
+		if p.Spec.NodeName == node.GetName() {
+			pods = append(pods, p)
+		}
+	}
+
+	// The node runs at least the DaemonSets of kube-system once it is set up.
+	if len(pods) == 0 {
+		return Result{Status: StatusPending, Message: fmt.Sprintf("no %s pods scheduled on the node yet", systemNamespace)}, nil
+	}
+
+	for _, p := range pods {
+		r := checkPod(p)
+		if r.Status != StatusPassed {
+			return r, nil
+		}
+	}
+
+	return Result{Status: StatusPassed, Message: fmt.Sprintf("%d %s pods running", len(pods), systemNamespace)}, nil
+}
+
+func checkPod(p corev1.Pod) Result {
+	for _, s := range p.Status.ContainerStatuses {
+		if s.State.Waiting == nil {
+			continue
+		}
+
+		reason := s.State.Waiting.Reason
+		if failedWaitingReasons[reason] {
+			return Result{Status: StatusFailed, Message: fmt.Sprintf("container %s of pod %s/%s is in %s", s.Name, p.Namespace, p.Name, reason)}
+		}
+		if backOffWaitingReasons[reason] {
+			if s.RestartCount >= backOffRestartThreshold || time.Since(p.CreationTimestamp.Time) > backOffGracePeriod {
+				return Result{Status: StatusFailed, Message: fmt.Sprintf("container %s of pod %s/%s is in %s after %d restarts", s.Name, p.Namespace, p.Name, reason, s.RestartCount)}
+			}
+
+			return Result{Status: StatusPending, Message: fmt.Sprintf("container %s of pod %s/%s is in %s", s.Name, p.Namespace, p.Name, reason)}
+		}
+	}
+
+	switch p.Status.Phase {
+	case corev1.PodSucceeded:
+		return Result{Status: StatusPassed}
+	case corev1.PodFailed:
+		return Result{Status: StatusFailed, Message: fmt.Sprintf("pod %s/%s failed", p.Namespace, p.Name)}
+	case corev1.PodRunning:
+		for _, c := range p.Status.Conditions {
+			if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+				return Result{Status: StatusPassed}
+			}
+		}
+	}
+
+	return Result{Status: StatusPending, Message: fmt.Sprintf("pod %s/%s is not ready", p.Namespace, p.Name)}
+}
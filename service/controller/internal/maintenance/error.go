@@ -0,0 +1,32 @@
+package maintenance
+
+import (
+	"github.com/giantswarm/microerror"
+)
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
+var invalidScheduleError = &microerror.Error{
+	Kind: "invalidScheduleError",
+}
+
+// IsInvalidSchedule asserts invalidScheduleError.
+func IsInvalidSchedule(err error) bool {
+	return microerror.Cause(err) == invalidScheduleError
+}
+
+var invalidWindowError = &microerror.Error{
+	Kind: "invalidWindowError",
+}
+
+// IsInvalidWindow asserts invalidWindowError.
+func IsInvalidWindow(err error) bool {
+	return microerror.Cause(err) == invalidWindowError
+}
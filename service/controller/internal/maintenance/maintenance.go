@@ -0,0 +1,85 @@
+// Package maintenance implements maintenance windows, which restrict when
+// disruptive upgrades of clusters may start.
+package maintenance
+
+import (
+	"encoding/json"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
+)
+
+type Config struct {
+	Maintenance setting.Maintenance
+}
+
+type Checker struct {
+	organizationWindows map[string][]window
+}
+
+func New(config Config) (*Checker, error) {
+	organizationWindows := map[string][]window{}
+	for organization, windows := range config.Maintenance.OrganizationWindows {
+		parsed, err := parseWindows(windows)
+		if err != nil {
+			return nil, microerror.Maskf(invalidConfigError, "%T.Maintenance.OrganizationWindows[%s]: %s", config, organization, err)
+		}
+
+		organizationWindows[organization] = parsed
+	}
+
+	c := &Checker{
+		organizationWindows: organizationWindows,
+	}
+
+	return c, nil
+}
+
+func (c *Checker) Open(cr providerv1alpha1.AzureConfig, now time.Time) (bool, error) {
+	windows, err := c.windows(cr)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	if len(windows) == 0 {
+		return true, nil
+	}
+
+	for _, w := range windows {
+		if w.isOpen(now) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// windows returns the maintenance windows of the given cluster, which are the
+// ones of its annotation, its organization or all organizations, in this
+// order.
+func (c *Checker) windows(cr providerv1alpha1.AzureConfig) ([]window, error) {
+	if v, ok := cr.GetAnnotations()[key.AnnotationMaintenanceWindows]; ok {
+		var windows []setting.MaintenanceWindow
+		err := json.Unmarshal([]byte(v), &windows)
+		if err != nil {
+			return nil, microerror.Maskf(invalidWindowError, "annotation %#q must be a JSON list of maintenance windows: %s", key.AnnotationMaintenanceWindows, err)
+		}
+
+		parsed, err := parseWindows(windows)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		return parsed, nil
+	}
+
+	if windows, ok := c.organizationWindows[key.ClusterOrganization(cr)]; ok {
+		return windows, nil
+	}
+
+	return c.organizationWindows[setting.OrganizationWildcard], nil
+}
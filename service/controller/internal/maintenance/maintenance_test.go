@@ -0,0 +1,155 @@
+package maintenance
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
+)
+
+func Test_Checker_Open(t *testing.T) {
+	// Saturday nights in Berlin, which is UTC+2 in May.
+	saturdayNights := []setting.MaintenanceWindow{
+		{Schedule: "0 22 * * 6", Duration: "4h", Timezone: "Europe/Berlin"},
+	}
+
+	testCases := []struct {
+		name                string
+		organizationWindows map[string][]setting.MaintenanceWindow
+		annotation          *string
+		now                 time.Time
+		expectedOpen        bool
+		errorMatcher        func(err error) bool
+	}{
+		{
+			name:         "case 0: no maintenance windows",
+			now:          time.Date(2020, 5, 13, 12, 0, 0, 0, time.UTC),
+			expectedOpen: true,
+		},
+		{
+			name:                "case 1: organization window open",
+			organizationWindows: map[string][]setting.MaintenanceWindow{"acme": saturdayNights},
+			now:                 time.Date(2020, 5, 16, 21, 0, 0, 0, time.UTC),
+			expectedOpen:        true,
+		},
+		{
+			name:                "case 2: organization window open past midnight",
+			organizationWindows: map[string][]setting.MaintenanceWindow{"acme": saturdayNights},
+			now:                 time.Date(2020, 5, 16, 23, 59, 0, 0, time.UTC),
+			expectedOpen:        true,
+		},
+		{
+			name:                "case 3: organization window closed",
+			organizationWindows: map[string][]setting.MaintenanceWindow{"acme": saturdayNights},
+			now:                 time.Date(2020, 5, 17, 0, 0, 0, 0, time.UTC),
+			expectedOpen:        false,
+		},
+		{
+			name:                "case 4: wildcard window closed",
+			organizationWindows: map[string][]setting.MaintenanceWindow{"*": saturdayNights},
+			now:                 time.Date(2020, 5, 13, 12, 0, 0, 0, time.UTC),
+			expectedOpen:        false,
+		},
+		{
+			name:                "case 5: windows of other organizations do not apply",
+			organizationWindows: map[string][]setting.MaintenanceWindow{"other": saturdayNights},
+			now:                 time.Date(2020, 5, 13, 12, 0, 0, 0, time.UTC),
+			expectedOpen:        true,
+		},
+		{
+			name:                "case 6: annotation overrides organization windows",
+			organizationWindows: map[string][]setting.MaintenanceWindow{"acme": saturdayNights},
+			annotation:          to.StringPtr(`[{"schedule":"0 12 * * 3","duration":"1h"}]`),
+			now:                 time.Date(2020, 5, 13, 12, 30, 0, 0, time.UTC),
+			expectedOpen:        true,
+		},
+		{
+			name:                "case 7: empty annotation lifts organization windows",
+			organizationWindows: map[string][]setting.MaintenanceWindow{"acme": saturdayNights},
+			annotation:          to.StringPtr(`[]`),
+			now:                 time.Date(2020, 5, 13, 12, 0, 0, 0, time.UTC),
+			expectedOpen:        true,
+		},
+		{
+			name:         "case 8: malformed annotation",
+			annotation:   to.StringPtr(`{"schedule":"0 12 * * 3"}`),
+			now:          time.Date(2020, 5, 13, 12, 0, 0, 0, time.UTC),
+			errorMatcher: IsInvalidWindow,
+		},
+		{
+			name:         "case 9: invalid schedule in annotation",
+			annotation:   to.StringPtr(`[{"schedule":"0 12 * *","duration":"1h"}]`),
+			now:          time.Date(2020, 5, 13, 12, 0, 0, 0, time.UTC),
+			errorMatcher: IsInvalidSchedule,
+		},
+		{
+			name:         "case 10: invalid duration in annotation",
+			annotation:   to.StringPtr(`[{"schedule":"0 12 * * 3","duration":"30d"}]`),
+			now:          time.Date(2020, 5, 13, 12, 0, 0, 0, time.UTC),
+			errorMatcher: IsInvalidWindow,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			c, err := New(Config{Maintenance: setting.Maintenance{OrganizationWindows: tc.organizationWindows}})
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			cr := providerv1alpha1.AzureConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{},
+				},
+				Spec: providerv1alpha1.AzureConfigSpec{
+					Cluster: providerv1alpha1.Cluster{
+						Customer: providerv1alpha1.ClusterCustomer{
+							ID: "acme",
+						},
+					},
+				},
+			}
+			if tc.annotation != nil {
+				cr.Annotations[key.AnnotationMaintenanceWindows] = *tc.annotation
+			}
+
+			open, err := c.Open(cr, tc.now)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if open != tc.expectedOpen {
+				t.Fatalf("open == %t, want %t", open, tc.expectedOpen)
+			}
+		})
+	}
+}
+
+func Test_New(t *testing.T) {
+	_, err := New(Config{
+		Maintenance: setting.Maintenance{
+			OrganizationWindows: map[string][]setting.MaintenanceWindow{
+				"acme": {{Schedule: "0 22 * * 6", Duration: "4h", Timezone: "Nowhere/Nothing"}},
+			},
+		},
+	})
+	if !IsInvalidConfig(err) {
+		t.Fatalf("error == %#v, want matching", err)
+	}
+}
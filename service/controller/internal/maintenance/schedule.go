@@ -0,0 +1,152 @@
+package maintenance
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/microerror"
+)
+
+// schedule is a parsed cron expression made of the five fields minute, hour,
+// day of month, month and day of week. Fields support "*", numbers, ranges
+// like "1-5", lists like "1,3,5" and steps like "*/15" or "0-30/10".
+type schedule struct {
+	minutes     []bool
+	hours       []bool
+	daysOfMonth []bool
+	months      []bool
+	daysOfWeek  []bool
+
+	// Like cron, a day matches when either the day of month or the day of
+	// week matches, unless one of them is "*".
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+type field struct {
+	name string
+	min  int
+	max  int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// Like cron, both 0 and 7 are Sunday.
+	{name: "day of week", min: 0, max: 7},
+}
+
+func parseSchedule(expression string) (schedule, error) {
+	parts := strings.Fields(expression)
+	if len(parts) != len(fields) {
+		return schedule{}, microerror.Maskf(invalidScheduleError, "schedule %#q must have %d fields", expression, len(fields))
+	}
+
+	var values [][]bool
+	for i, f := range fields {
+		v, err := parseField(parts[i], f)
+		if err != nil {
+			return schedule{}, microerror.Maskf(invalidScheduleError, "schedule %#q: %s", expression, err)
+		}
+		values = append(values, v)
+	}
+
+	if values[4][7] {
+		values[4][0] = true
+	}
+
+	s := schedule{
+		minutes:     values[0],
+		hours:       values[1],
+		daysOfMonth: values[2],
+		months:      values[3],
+		daysOfWeek:  values[4],
+
+		anyDayOfMonth: parts[2] == "*",
+		anyDayOfWeek:  parts[4] == "*",
+	}
+
+	return s, nil
+}
+
+func parseField(s string, f field) ([]bool, error) {
+	values := make([]bool, f.max+1)
+
+	for _, item := range strings.Split(s, ",") {
+		step := 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n < 1 {
+				return nil, microerror.Maskf(invalidScheduleError, "%s step %#q must be a positive number", f.name, item[i+1:])
+			}
+			step = n
+			item = item[:i]
+		}
+
+		from, to := f.min, f.max
+		if item != "*" {
+			bounds := strings.SplitN(item, "-", 2)
+
+			n, err := parseValue(bounds[0], f)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+			from, to = n, n
+
+			if len(bounds) == 2 {
+				to, err = parseValue(bounds[1], f)
+				if err != nil {
+					return nil, microerror.Mask(err)
+				}
+				if to < from {
+					return nil, microerror.Maskf(invalidScheduleError, "%s range %#q must not be reversed", f.name, item)
+				}
+			} else if step > 1 {
+				// Like cron, "5/15" means every 15 starting at 5.
+				to = f.max
+			}
+		}
+
+		for v := from; v <= to; v += step {
+			values[v] = true
+		}
+	}
+
+	return values, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, microerror.Maskf(invalidScheduleError, "%s %#q must be a number", f.name, s)
+	}
+	if n < f.min || n > f.max {
+		return 0, microerror.Maskf(invalidScheduleError, "%s %d must be between %d and %d", f.name, n, f.min, f.max)
+	}
+
+	return n, nil
+}
+
+// matches returns whether the schedule fires at the minute of the given time.
+func (s schedule) matches(t time.Time) bool {
+	if !s.minutes[t.Minute()] || !s.hours[t.Hour()] || !s.months[int(t.Month())] {
+		return false
+	}
+
+	dayOfMonth := s.daysOfMonth[t.Day()]
+	dayOfWeek := s.daysOfWeek[int(t.Weekday())]
+
+	switch {
+	case s.anyDayOfMonth && s.anyDayOfWeek:
+		return true
+	case s.anyDayOfMonth:
+		return dayOfWeek
+	case s.anyDayOfWeek:
+		return dayOfMonth
+	default:
+		return dayOfMonth || dayOfWeek
+	}
+}
@@ -0,0 +1,152 @@
+package maintenance
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func Test_parseSchedule(t *testing.T) {
+	testCases := []struct {
+		name         string
+		schedule     string
+		errorMatcher func(err error) bool
+	}{
+		{
+			name:     "case 0: every minute",
+			schedule: "* * * * *",
+		},
+		{
+			name:     "case 1: lists, ranges and steps",
+			schedule: "*/15 1-5 1,15 */2 1-5/2",
+		},
+		{
+			name:     "case 2: Sunday as 7",
+			schedule: "0 2 * * 7",
+		},
+		{
+			name:         "case 3: missing field",
+			schedule:     "0 2 * *",
+			errorMatcher: IsInvalidSchedule,
+		},
+		{
+			name:         "case 4: value out of range",
+			schedule:     "60 2 * * *",
+			errorMatcher: IsInvalidSchedule,
+		},
+		{
+			name:         "case 5: reversed range",
+			schedule:     "0 5-1 * * *",
+			errorMatcher: IsInvalidSchedule,
+		},
+		{
+			name:         "case 6: invalid step",
+			schedule:     "*/0 * * * *",
+			errorMatcher: IsInvalidSchedule,
+		},
+		{
+			name:         "case 7: names are not supported",
+			schedule:     "0 2 * * SAT",
+			errorMatcher: IsInvalidSchedule,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			_, err := parseSchedule(tc.schedule)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+		})
+	}
+}
+
+func Test_schedule_matches(t *testing.T) {
+	testCases := []struct {
+		name            string
+		schedule        string
+		time            time.Time
+		expectedMatches bool
+	}{
+		{
+			name:            "case 0: exact minute",
+			schedule:        "30 2 * * *",
+			time:            time.Date(2020, 5, 16, 2, 30, 0, 0, time.UTC),
+			expectedMatches: true,
+		},
+		{
+			name:            "case 1: other minute",
+			schedule:        "30 2 * * *",
+			time:            time.Date(2020, 5, 16, 2, 31, 0, 0, time.UTC),
+			expectedMatches: false,
+		},
+		{
+			name:            "case 2: matching day of week",
+			schedule:        "0 2 * * 6",
+			time:            time.Date(2020, 5, 16, 2, 0, 0, 0, time.UTC),
+			expectedMatches: true,
+		},
+		{
+			name:            "case 3: other day of week",
+			schedule:        "0 2 * * 6",
+			time:            time.Date(2020, 5, 17, 2, 0, 0, 0, time.UTC),
+			expectedMatches: false,
+		},
+		{
+			name:            "case 4: Sunday as 7",
+			schedule:        "0 2 * * 7",
+			time:            time.Date(2020, 5, 17, 2, 0, 0, 0, time.UTC),
+			expectedMatches: true,
+		},
+		{
+			name:            "case 5: either day of month or day of week",
+			schedule:        "0 2 1 * 1",
+			time:            time.Date(2020, 5, 1, 2, 0, 0, 0, time.UTC),
+			expectedMatches: true,
+		},
+		{
+			name:            "case 6: step",
+			schedule:        "*/20 * * * *",
+			time:            time.Date(2020, 5, 1, 2, 40, 0, 0, time.UTC),
+			expectedMatches: true,
+		},
+		{
+			name:            "case 7: step starting at a value",
+			schedule:        "5/20 * * * *",
+			time:            time.Date(2020, 5, 1, 2, 25, 0, 0, time.UTC),
+			expectedMatches: true,
+		},
+		{
+			name:            "case 8: other month",
+			schedule:        "0 2 * 1-4 *",
+			time:            time.Date(2020, 5, 1, 2, 0, 0, 0, time.UTC),
+			expectedMatches: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			s, err := parseSchedule(tc.schedule)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			matches := s.matches(tc.time)
+			if matches != tc.expectedMatches {
+				t.Fatalf("matches == %t, want %t", matches, tc.expectedMatches)
+			}
+		})
+	}
+}
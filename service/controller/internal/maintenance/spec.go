@@ -0,0 +1,15 @@
+package maintenance
+
+import (
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+)
+
+// Interface tells whether disruptive upgrades of a cluster may happen.
+type Interface interface {
+	// Open returns true when one of the maintenance windows of the given
+	// cluster is open at the given time. Clusters without maintenance windows
+	// are always open.
+	Open(cr providerv1alpha1.AzureConfig, now time.Time) (bool, error)
+}
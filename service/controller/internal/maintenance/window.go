@@ -0,0 +1,75 @@
+package maintenance
+
+import (
+	"time"
+
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
+)
+
+// maxWindowDuration caps the duration of maintenance windows, so that checking
+// whether a window is open stays cheap.
+const maxWindowDuration = 7 * 24 * time.Hour
+
+type window struct {
+	schedule schedule
+	duration time.Duration
+	location *time.Location
+}
+
+func parseWindow(w setting.MaintenanceWindow) (window, error) {
+	s, err := parseSchedule(w.Schedule)
+	if err != nil {
+		return window{}, microerror.Mask(err)
+	}
+
+	d, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return window{}, microerror.Maskf(invalidWindowError, "duration %#q: %s", w.Duration, err)
+	}
+	if d < time.Minute || d > maxWindowDuration {
+		return window{}, microerror.Maskf(invalidWindowError, "duration %#q must be between %s and %s", w.Duration, time.Minute, maxWindowDuration)
+	}
+
+	l, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return window{}, microerror.Maskf(invalidWindowError, "timezone %#q: %s", w.Timezone, err)
+	}
+
+	p := window{
+		schedule: s,
+		duration: d,
+		location: l,
+	}
+
+	return p, nil
+}
+
+func parseWindows(windows []setting.MaintenanceWindow) ([]window, error) {
+	var parsed []window
+	for _, w := range windows {
+		p, err := parseWindow(w)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		parsed = append(parsed, p)
+	}
+
+	return parsed, nil
+}
+
+// isOpen returns whether the window opened less than its duration before the
+// given time.
+func (w window) isOpen(now time.Time) bool {
+	now = now.In(w.location)
+
+	for start := now.Truncate(time.Minute); now.Sub(start) < w.duration; start = start.Add(-time.Minute) {
+		if w.schedule.matches(start) {
+			return true
+		}
+	}
+
+	return false
+}
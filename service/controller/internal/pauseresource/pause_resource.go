@@ -0,0 +1,100 @@
+// Package pauseresource provides a resource wrapper which skips the wrapped
+// resource while the reconciliation of a cluster is paused with the
+// key.AnnotationPaused annotation.
+package pauseresource
+
+import (
+	"context"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/giantswarm/operatorkit/controller/context/finalizerskeptcontext"
+	"github.com/giantswarm/operatorkit/resource"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+type Config struct {
+	Logger   micrologger.Logger
+	Resource resource.Interface
+}
+
+type Resource struct {
+	logger   micrologger.Logger
+	resource resource.Interface
+}
+
+func New(config Config) (*Resource, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.Resource == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Resource must not be empty", config)
+	}
+
+	r := &Resource{
+		logger:   config.Logger,
+		resource: config.Resource,
+	}
+
+	return r, nil
+}
+
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	paused, err := r.isPaused(ctx, obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	if paused {
+		return nil
+	}
+
+	err = r.resource.EnsureCreated(ctx, obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// EnsureDeleted skips the wrapped resource while the cluster is paused. The
+// finalizers of the cluster are kept, so that the deletion carries on once the
+// cluster is resumed.
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	paused, err := r.isPaused(ctx, obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	if paused {
+		finalizerskeptcontext.SetKept(ctx)
+		return nil
+	}
+
+	err = r.resource.EnsureDeleted(ctx, obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *Resource) Name() string {
+	return r.resource.Name()
+}
+
+func (r *Resource) isPaused(ctx context.Context, obj interface{}) (bool, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	if !key.IsPaused(cr, time.Now()) {
+		return false, nil
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", "reconciliation is paused")
+	r.logger.LogCtx(ctx, "level", "debug", "message", "skipping resource")
+
+	return true, nil
+}
@@ -0,0 +1,133 @@
+package pauseresource
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/giantswarm/operatorkit/controller/context/finalizerskeptcontext"
+	"github.com/giantswarm/operatorkit/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+func Test_Resource(t *testing.T) {
+	testCases := []struct {
+		name              string
+		annotations       map[string]string
+		expectedCalls     int
+		expectedKeptOnDel bool
+	}{
+		{
+			name:          "case 0: cluster not paused",
+			expectedCalls: 2,
+		},
+		{
+			name:              "case 1: cluster paused",
+			annotations:       map[string]string{key.AnnotationPaused: "true"},
+			expectedCalls:     0,
+			expectedKeptOnDel: true,
+		},
+		{
+			name: "case 2: pause expired",
+			annotations: map[string]string{
+				key.AnnotationPaused:      "true",
+				key.AnnotationPausedUntil: time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+			},
+			expectedCalls: 2,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			wrapped := &countingResource{}
+
+			c := Config{
+				Logger:   microloggertest.New(),
+				Resource: wrapped,
+			}
+
+			r, err := New(c)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			cr := &providerv1alpha1.AzureConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+
+			err = r.EnsureCreated(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			ctx := finalizerskeptcontext.NewContext(context.Background(), make(chan struct{}))
+			err = r.EnsureDeleted(ctx, cr)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if wrapped.calls != tc.expectedCalls {
+				t.Fatalf("calls == %d, want %d", wrapped.calls, tc.expectedCalls)
+			}
+			if finalizerskeptcontext.IsKept(ctx) != tc.expectedKeptOnDel {
+				t.Fatalf("finalizers kept == %t, want %t", finalizerskeptcontext.IsKept(ctx), tc.expectedKeptOnDel)
+			}
+		})
+	}
+}
+
+type countingResource struct {
+	calls int
+	name  string
+}
+
+func (r *countingResource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	r.calls++
+	return nil
+}
+
+func (r *countingResource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	r.calls++
+	return nil
+}
+
+func (r *countingResource) Name() string {
+	return r.name
+}
+
+func Test_Wrap(t *testing.T) {
+	resources := []resource.Interface{
+		&countingResource{name: "status"},
+		&countingResource{name: "instance"},
+	}
+
+	c := WrapConfig{
+		Logger: microloggertest.New(),
+
+		Exclude: []string{"status"},
+	}
+
+	wrapped, err := Wrap(resources, c)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	if len(wrapped) != 2 {
+		t.Fatalf("len(wrapped) == %d, want 2", len(wrapped))
+	}
+	if wrapped[0] != resources[0] {
+		t.Fatalf("excluded resource %#q got wrapped", wrapped[0].Name())
+	}
+	if _, ok := wrapped[1].(*Resource); !ok || wrapped[1].Name() != "instance" {
+		t.Fatalf("resource %#q did not get wrapped", wrapped[1].Name())
+	}
+}
@@ -0,0 +1,52 @@
+package pauseresource
+
+import (
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/giantswarm/operatorkit/resource"
+)
+
+// WrapConfig is the configuration used to wrap resources with pause resources.
+type WrapConfig struct {
+	Logger micrologger.Logger
+
+	// Exclude holds the names of the resources which are not wrapped, because
+	// they do not mutate anything but the status of the cluster.
+	Exclude []string
+}
+
+// Wrap wraps each given resource with a pause resource, except the excluded
+// ones, and returns the list of resources in the given order.
+func Wrap(resources []resource.Interface, config WrapConfig) ([]resource.Interface, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	exclude := map[string]bool{}
+	for _, n := range config.Exclude {
+		exclude[n] = true
+	}
+
+	var wrapped []resource.Interface
+
+	for _, r := range resources {
+		if exclude[r.Name()] {
+			wrapped = append(wrapped, r)
+			continue
+		}
+
+		c := Config{
+			Logger:   config.Logger,
+			Resource: r,
+		}
+
+		pauseResource, err := New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		wrapped = append(wrapped, pauseResource)
+	}
+
+	return wrapped, nil
+}
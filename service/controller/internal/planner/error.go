@@ -0,0 +1,32 @@
+package planner
+
+import (
+	"github.com/giantswarm/microerror"
+)
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
+var whatIfFailedError = &microerror.Error{
+	Kind: "whatIfFailedError",
+}
+
+// IsWhatIfFailed asserts whatIfFailedError.
+func IsWhatIfFailed(err error) bool {
+	return microerror.Cause(err) == whatIfFailedError
+}
+
+var invalidChecksumError = &microerror.Error{
+	Kind: "invalidChecksumError",
+}
+
+// IsInvalidChecksum asserts invalidChecksumError.
+func IsInvalidChecksum(err error) bool {
+	return microerror.Cause(err) == invalidChecksumError
+}
@@ -0,0 +1,179 @@
+package planner
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v4/pkg/checksum"
+)
+
+const (
+	// PlanResourceName is the name of the resource status the plans of the
+	// deployments of a cluster are persisted in. The condition types are the
+	// names of the deployments.
+	PlanResourceName = "plan"
+)
+
+// plan holds the changes a deployment is predicted to make.
+type plan struct {
+	// ID identifies the template and parameters of the deployment.
+	ID   string    `json:"id"`
+	Time time.Time `json:"time"`
+
+	Create int `json:"create,omitempty"`
+	Delete int `json:"delete,omitempty"`
+	Deploy int `json:"deploy,omitempty"`
+	Modify int `json:"modify,omitempty"`
+
+	// Changes lists the resources which get changed.
+	Changes []change `json:"changes,omitempty"`
+}
+
+type change struct {
+	ResourceID string `json:"resourceId"`
+	ChangeType string `json:"changeType"`
+}
+
+func newPlan(id string, t time.Time, changes []features.WhatIfChange) plan {
+	p := plan{
+		ID:   id,
+		Time: t,
+	}
+
+	for _, c := range changes {
+		switch c.ChangeType {
+		case features.Create:
+			p.Create++
+		case features.Delete:
+			p.Delete++
+		case features.Deploy:
+			p.Deploy++
+		case features.Modify:
+			p.Modify++
+		default:
+			// Resources which are ignored or not changed are not part of
+			// the plan.
+			continue
+		}
+
+		var resourceID string
+		if c.ResourceID != nil {
+			resourceID = *c.ResourceID
+		}
+
+		p.Changes = append(p.Changes, change{ResourceID: resourceID, ChangeType: string(c.ChangeType)})
+	}
+
+	sort.Slice(p.Changes, func(i, j int) bool {
+		return p.Changes[i].ResourceID < p.Changes[j].ResourceID
+	})
+
+	return p
+}
+
+// isEmpty returns true when the plan does not change anything.
+func (p plan) isEmpty() bool {
+	return len(p.Changes) == 0
+}
+
+func (p plan) String() string {
+	return fmt.Sprintf("%d to create, %d to modify, %d to redeploy, %d to delete", p.Create, p.Modify, p.Deploy, p.Delete)
+}
+
+// planID returns the ID of the plan of the given deployment. It changes
+// whenever the template or the parameters of the deployment change.
+func planID(deployment azureresource.Deployment) (string, error) {
+	templateChk, err := checksum.GetDeploymentTemplateChecksum(deployment)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	parametersChk, err := checksum.GetDeploymentParametersChecksum(deployment)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return PlanID(templateChk, parametersChk), nil
+}
+
+// PlanID returns the ID of the plan of a deployment with the given checksums of
+// its template and parameters, as calculated by the checksum package.
+func PlanID(templateChecksum string, parametersChecksum string) string {
+	return fmt.Sprintf("%.8s%.8s", templateChecksum, parametersChecksum)
+}
+
+// getPlan returns the plan of the deployment with the given name persisted in
+// the status of the given cluster. False is returned when no plan is
+// persisted.
+func getPlan(cr providerv1alpha1.AzureConfig, deploymentName string) (plan, bool, error) {
+	for _, r := range cr.Status.Cluster.Resources {
+		if r.Name != PlanResourceName {
+			continue
+		}
+
+		for _, c := range r.Conditions {
+			if c.Type != deploymentName {
+				continue
+			}
+
+			var p plan
+			err := json.Unmarshal([]byte(c.Status), &p)
+			if err != nil {
+				return plan{}, false, microerror.Mask(err)
+			}
+
+			return p, true, nil
+		}
+	}
+
+	return plan{}, false, nil
+}
+
+// setPlan persists the given plan of the deployment with the given name in the
+// status of the given cluster.
+func setPlan(cr *providerv1alpha1.AzureConfig, deploymentName string, p plan) error {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	condition := providerv1alpha1.StatusClusterResourceCondition{
+		Status: string(b),
+		Type:   deploymentName,
+	}
+
+	for i, r := range cr.Status.Cluster.Resources {
+		if r.Name != PlanResourceName {
+			continue
+		}
+
+		var conditions []providerv1alpha1.StatusClusterResourceCondition
+		for _, c := range r.Conditions {
+			if c.Type == deploymentName {
+				continue
+			}
+			conditions = append(conditions, c)
+		}
+
+		cr.Status.Cluster.Resources[i].Conditions = append(conditions, condition)
+
+		return nil
+	}
+
+	resourceStatus := providerv1alpha1.StatusClusterResource{
+		Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+			condition,
+		},
+		Name: PlanResourceName,
+	}
+	cr.Status.Cluster.Resources = append(cr.Status.Cluster.Resources, resourceStatus)
+
+	return nil
+}
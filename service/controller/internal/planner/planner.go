@@ -0,0 +1,240 @@
+// Package planner implements a dry-run mode for deployments of ARM templates.
+// The changes a deployment is about to make are predicted with the ARM what-if
+// API and only applied once they got approved.
+package planner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/apiextensions/pkg/clientset/versioned"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+const (
+	// planTTL is the time after which the changes of a deployment awaiting
+	// approval are predicted again, because the resources of the cluster
+	// might have changed in the meantime.
+	planTTL = time.Hour
+	// whatIfTimeout is the time waited for the changes of a deployment to be
+	// predicted.
+	whatIfTimeout = 2 * time.Minute
+)
+
+type Config struct {
+	EventRecorder record.EventRecorder
+	G8sClient     versioned.Interface
+	Logger        micrologger.Logger
+
+	// DryRun is the default dry-run mode of clusters, which can be overridden
+	// per cluster with the key.AnnotationDryRun annotation.
+	DryRun bool
+}
+
+type Planner struct {
+	eventRecorder record.EventRecorder
+	g8sClient     versioned.Interface
+	logger        micrologger.Logger
+
+	dryRun bool
+}
+
+func New(config Config) (*Planner, error) {
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
+	if config.G8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.G8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	p := &Planner{
+		eventRecorder: config.EventRecorder,
+		g8sClient:     config.G8sClient,
+		logger:        config.Logger,
+
+		dryRun: config.DryRun,
+	}
+
+	return p, nil
+}
+
+func (p *Planner) Approved(ctx context.Context, cr providerv1alpha1.AzureConfig, deploymentName string, deployment azureresource.Deployment) (bool, error) {
+	if !key.IsDryRun(cr, p.dryRun) {
+		return true, nil
+	}
+
+	id, err := planID(deployment)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	approved, err := p.approved(ctx, cr, deploymentName, deployment, id)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	return approved, nil
+}
+
+func (p *Planner) ApprovedWithChecksums(ctx context.Context, cr providerv1alpha1.AzureConfig, deploymentName string, deployment azureresource.Deployment, templateChecksum string, parametersChecksum string) (bool, error) {
+	if !key.IsDryRun(cr, p.dryRun) {
+		return true, nil
+	}
+
+	if templateChecksum == "" || parametersChecksum == "" {
+		return false, microerror.Maskf(invalidChecksumError, "checksums of deployment %s must not be empty", deploymentName)
+	}
+
+	approved, err := p.approved(ctx, cr, deploymentName, deployment, PlanID(templateChecksum, parametersChecksum))
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	return approved, nil
+}
+
+func (p *Planner) approved(ctx context.Context, cr providerv1alpha1.AzureConfig, deploymentName string, deployment azureresource.Deployment, id string) (bool, error) {
+	if key.IsPlanApproved(cr, id) {
+		p.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("plan %s of deployment %s is approved", id, deploymentName))
+		return true, nil
+	}
+
+	previous, found, err := getPlan(cr, deploymentName)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	if found && previous.ID == id && time.Since(previous.Time) < planTTL {
+		if previous.isEmpty() {
+			return true, nil
+		}
+
+		p.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("plan %s of deployment %s awaits approval", id, deploymentName))
+		return false, nil
+	}
+
+	p.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("predicting changes of deployment %s", deploymentName))
+
+	changes, err := p.whatIf(ctx, cr, deploymentName, deployment)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	current := newPlan(id, time.Now(), changes)
+
+	p.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("predicted changes of deployment %s: %s", deploymentName, current))
+
+	err = p.recordPlan(cr, deploymentName, current)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	if current.isEmpty() {
+		return true, nil
+	}
+
+	// Plans which only got predicted again are not announced again.
+	if !found || previous.ID != id {
+		p.eventRecorder.Eventf(&cr, corev1.EventTypeWarning, recorder.ReasonDeploymentPlanned, "deployment %s plan %s predicts %s; approve it by adding %s to annotation %s", deploymentName, id, current, id, key.AnnotationApprovedPlans)
+	}
+
+	p.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("plan %s of deployment %s awaits approval", id, deploymentName))
+
+	return false, nil
+}
+
+func (p *Planner) recordPlan(cr providerv1alpha1.AzureConfig, deploymentName string, pl plan) error {
+	// Get the newest CR version. Otherwise status update may fail because of:
+	//
+	//	 the object has been modified; please apply your changes to the
+	//	 latest version and try again
+	//
+	{
+		o, err := p.g8sClient.ProviderV1alpha1().AzureConfigs(cr.Namespace).Get(cr.Name, metav1.GetOptions{})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		cr = *o
+	}
+
+	err := setPlan(&cr, deploymentName, pl)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	_, err = p.g8sClient.ProviderV1alpha1().AzureConfigs(cr.Namespace).UpdateStatus(&cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (p *Planner) whatIf(ctx context.Context, cr providerv1alpha1.AzureConfig, deploymentName string, deployment azureresource.Deployment) ([]features.WhatIfChange, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	c := cc.AzureClientSet.WhatIfClient
+
+	parameters := features.DeploymentWhatIf{
+		Properties: &features.DeploymentWhatIfProperties{
+			Mode:       features.DeploymentMode(deployment.Properties.Mode),
+			Parameters: deployment.Properties.Parameters,
+			Template:   deployment.Properties.Template,
+			WhatIfSettings: &features.DeploymentWhatIfSettings{
+				ResultFormat: features.ResourceIDOnly,
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, whatIfTimeout)
+	defer cancel()
+
+	future, err := c.WhatIf(ctx, key.ClusterID(cr), deploymentName, parameters)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, c.Client)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	result, err := future.Result(*c)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	if result.Error != nil {
+		var message string
+		if result.Error.Message != nil {
+			message = *result.Error.Message
+		}
+
+		return nil, microerror.Maskf(whatIfFailedError, "deployment %s: %s", deploymentName, message)
+	}
+
+	if result.WhatIfOperationProperties == nil || result.Changes == nil {
+		return nil, nil
+	}
+
+	return *result.Changes, nil
+}
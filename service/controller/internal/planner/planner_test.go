@@ -0,0 +1,193 @@
+package planner
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	g8sfake "github.com/giantswarm/apiextensions/pkg/clientset/versioned/fake"
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+func Test_newPlan(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	changes := []features.WhatIfChange{
+		{ResourceID: to.StringPtr("/vnet"), ChangeType: features.NoChange},
+		{ResourceID: to.StringPtr("/vmss"), ChangeType: features.Modify},
+		{ResourceID: to.StringPtr("/lb"), ChangeType: features.Create},
+		{ResourceID: to.StringPtr("/nsg"), ChangeType: features.Ignore},
+		{ResourceID: to.StringPtr("/disk"), ChangeType: features.Delete},
+	}
+
+	expected := plan{
+		ID:     "abc",
+		Time:   now,
+		Create: 1,
+		Delete: 1,
+		Modify: 1,
+		Changes: []change{
+			{ResourceID: "/disk", ChangeType: "Delete"},
+			{ResourceID: "/lb", ChangeType: "Create"},
+			{ResourceID: "/vmss", ChangeType: "Modify"},
+		},
+	}
+
+	p := newPlan("abc", now, changes)
+	if !cmp.Equal(p, expected) {
+		t.Fatalf("\n\n%s\n", cmp.Diff(expected, p))
+	}
+	if p.isEmpty() {
+		t.Fatalf("plan is empty")
+	}
+
+	if !newPlan("abc", now, changes[:1]).isEmpty() {
+		t.Fatalf("plan without changes is not empty")
+	}
+}
+
+func Test_setPlan(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cr := providerv1alpha1.AzureConfig{}
+
+	_, found, err := getPlan(cr, "cluster-main-template")
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+	if found {
+		t.Fatalf("found plan, want none")
+	}
+
+	first := plan{ID: "abc", Time: now, Create: 1, Changes: []change{{ResourceID: "/lb", ChangeType: "Create"}}}
+	second := plan{ID: "def", Time: now}
+
+	for _, p := range []plan{first, second} {
+		err = setPlan(&cr, "cluster-main-template", p)
+		if err != nil {
+			t.Fatalf("error == %#v, want nil", err)
+		}
+	}
+	err = setPlan(&cr, "cluster-vpn-template", first)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	if len(cr.Status.Cluster.Resources) != 1 || len(cr.Status.Cluster.Resources[0].Conditions) != 2 {
+		t.Fatalf("resources == %#v, want one resource with two conditions", cr.Status.Cluster.Resources)
+	}
+
+	p, found, err := getPlan(cr, "cluster-main-template")
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+	if !found {
+		t.Fatalf("found no plan")
+	}
+	if !cmp.Equal(p, second) {
+		t.Fatalf("\n\n%s\n", cmp.Diff(second, p))
+	}
+}
+
+func Test_Planner_Approved(t *testing.T) {
+	deployment := azureresource.Deployment{
+		Properties: &azureresource.DeploymentProperties{
+			Mode:       azureresource.Incremental,
+			Parameters: map[string]interface{}{"vmSize": struct{ Value interface{} }{Value: "Standard_D4s_v3"}},
+			Template:   map[string]interface{}{"resources": []interface{}{}},
+		},
+	}
+
+	id, err := planID(deployment)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	testCases := []struct {
+		name             string
+		dryRun           bool
+		annotations      map[string]string
+		recordedPlan     *plan
+		expectedApproved bool
+	}{
+		{
+			name:             "case 0: dry-run disabled",
+			dryRun:           false,
+			expectedApproved: true,
+		},
+		{
+			name:             "case 1: dry-run disabled for the cluster",
+			dryRun:           true,
+			annotations:      map[string]string{key.AnnotationDryRun: "false"},
+			expectedApproved: true,
+		},
+		{
+			name:             "case 2: plan approved",
+			dryRun:           true,
+			annotations:      map[string]string{key.AnnotationApprovedPlans: "0123456789abcdef, " + id},
+			expectedApproved: true,
+		},
+		{
+			name:             "case 3: recorded plan awaits approval",
+			dryRun:           true,
+			recordedPlan:     &plan{ID: id, Time: time.Now(), Modify: 1, Changes: []change{{ResourceID: "/vmss", ChangeType: "Modify"}}},
+			expectedApproved: false,
+		},
+		{
+			name:             "case 4: recorded plan without changes",
+			annotations:      map[string]string{key.AnnotationDryRun: "true"},
+			recordedPlan:     &plan{ID: id, Time: time.Now()},
+			expectedApproved: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			cr := providerv1alpha1.AzureConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+			if tc.recordedPlan != nil {
+				err := setPlan(&cr, "cluster-main-template", *tc.recordedPlan)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+			}
+
+			c := Config{
+				EventRecorder: record.NewFakeRecorder(10),
+				G8sClient:     g8sfake.NewSimpleClientset(),
+				Logger:        microloggertest.New(),
+
+				DryRun: tc.dryRun,
+			}
+
+			p, err := New(c)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			approved, err := p.Approved(context.Background(), cr, "cluster-main-template", deployment)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if approved != tc.expectedApproved {
+				t.Fatalf("approved == %t, want %t", approved, tc.expectedApproved)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package planner
+
+import (
+	"context"
+
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+)
+
+// Interface decides whether deployments of ARM templates may be applied.
+type Interface interface {
+	// Approved returns true when the given deployment with the given name of
+	// the given cluster may be applied. Deployments of clusters in dry-run
+	// mode are only approved when they do not change anything or their plan
+	// got approved with the key.AnnotationApprovedPlans annotation. The
+	// predicted changes of deployments awaiting approval are recorded in the
+	// status of the cluster.
+	Approved(ctx context.Context, cr providerv1alpha1.AzureConfig, deploymentName string, deployment azureresource.Deployment) (bool, error)
+	// ApprovedWithChecksums is like Approved but takes the checksums of the
+	// template and parameters of the given deployment, as calculated by the
+	// checksum package, instead of calculating them. It is used for
+	// deployments read back from storage, whose parameters cannot be
+	// checksummed anymore.
+	ApprovedWithChecksums(ctx context.Context, cr providerv1alpha1.AzureConfig, deploymentName string, deployment azureresource.Deployment, templateChecksum string, parametersChecksum string) (bool, error)
+}
@@ -0,0 +1,244 @@
+// Package quota implements pre-flight checks of the compute quota of the
+// subscriptions of tenant clusters, so that operations creating instances are
+// blocked with a clear reason instead of failing deep inside a deployment.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/apiextensions/pkg/clientset/versioned"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+const (
+	// skuTTL is the time the VM sizes of a location are cached for. They
+	// hardly ever change and listing them is expensive.
+	skuTTL = time.Hour
+
+	capabilityVCPUs      = "vCPUs"
+	resourceTypeVMs      = "virtualMachines"
+	skuLocationFilterFmt = "location eq '%s'"
+)
+
+type Config struct {
+	EventRecorder record.EventRecorder
+	G8sClient     versioned.Interface
+	Logger        micrologger.Logger
+}
+
+type Checker struct {
+	eventRecorder record.EventRecorder
+	g8sClient     versioned.Interface
+	logger        micrologger.Logger
+
+	mutex sync.Mutex
+	skus  map[string]cachedSkus
+}
+
+type cachedSkus struct {
+	skus map[string]sku
+	time time.Time
+}
+
+func New(config Config) (*Checker, error) {
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
+	if config.G8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.G8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	c := &Checker{
+		eventRecorder: config.EventRecorder,
+		g8sClient:     config.G8sClient,
+		logger:        config.Logger,
+
+		skus: map[string]cachedSkus{},
+	}
+
+	return c, nil
+}
+
+// Sufficient fails open. When the quota cannot be looked up, or a VM size is
+// unknown, the operation is allowed and Azure gets the final say.
+func (c *Checker) Sufficient(ctx context.Context, cr providerv1alpha1.AzureConfig, location string, operation string, demands []Demand) (bool, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	c.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("checking quota in %s for %s", location, operation))
+
+	skus, err := c.getSkus(ctx, cc.AzureClientSet.ResourceSkusClient, location)
+	if err != nil {
+		c.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("failed to list VM sizes in %s, skipping quota check", location), "stack", fmt.Sprintf("%#v", err))
+		return true, nil
+	}
+
+	usages, err := listUsages(ctx, cc.AzureClientSet.UsageClient, location)
+	if err != nil {
+		c.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("failed to list compute usages in %s, skipping quota check", location), "stack", fmt.Sprintf("%#v", err))
+		return true, nil
+	}
+
+	missing, err := shortfalls(demands, skus, usages)
+	if IsUnknownVMSize(err) {
+		c.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("unknown VM size in %s, skipping quota check", location), "stack", fmt.Sprintf("%#v", err))
+		return true, nil
+	} else if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	exceeded := len(missing) > 0
+
+	changed, err := c.updateCondition(cr, exceeded)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	if exceeded {
+		c.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("insufficient quota in %s for %s: %s", location, operation, joinShortfalls(missing)))
+		c.eventRecorder.Eventf(&cr, corev1.EventTypeWarning, recorder.ReasonQuotaExceeded, "%s blocked by insufficient quota in %s: %s", operation, location, joinShortfalls(missing))
+		return false, nil
+	}
+
+	if changed {
+		c.eventRecorder.Eventf(&cr, corev1.EventTypeNormal, recorder.ReasonQuotaSufficient, "quota in %s is sufficient for %s", location, operation)
+	}
+
+	c.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("checked quota in %s for %s", location, operation))
+
+	return true, nil
+}
+
+// getSkus returns the VM sizes available in the given location keyed by their
+// lower case names.
+func (c *Checker) getSkus(ctx context.Context, client *compute.ResourceSkusClient, location string) (map[string]sku, error) {
+	c.mutex.Lock()
+	cached, ok := c.skus[location]
+	c.mutex.Unlock()
+
+	if ok && time.Since(cached.time) < skuTTL {
+		return cached.skus, nil
+	}
+
+	skus := map[string]sku{}
+
+	iterator, err := client.ListComplete(ctx, fmt.Sprintf(skuLocationFilterFmt, location))
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	for iterator.NotDone() {
+		s := iterator.Value()
+
+		if s.ResourceType != nil && *s.ResourceType == resourceTypeVMs && s.Name != nil && s.Family != nil && s.Capabilities != nil {
+			for _, capability := range *s.Capabilities {
+				if capability.Name == nil || *capability.Name != capabilityVCPUs || capability.Value == nil {
+					continue
+				}
+
+				vCPUs, err := strconv.ParseInt(*capability.Value, 10, 64)
+				if err != nil {
+					return nil, microerror.Mask(err)
+				}
+
+				skus[strings.ToLower(*s.Name)] = sku{family: *s.Family, vCPUs: vCPUs}
+			}
+		}
+
+		err = iterator.NextWithContext(ctx)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	c.mutex.Lock()
+	c.skus[location] = cachedSkus{skus: skus, time: time.Now()}
+	c.mutex.Unlock()
+
+	return skus, nil
+}
+
+func (c *Checker) updateCondition(cr providerv1alpha1.AzureConfig, exceeded bool) (bool, error) {
+	now := time.Now()
+
+	status := string(corev1.ConditionFalse)
+	if exceeded {
+		status = string(corev1.ConditionTrue)
+	}
+
+	// The status is only updated when the condition changes.
+	if _, changed := key.SetResourceCondition(cr.DeepCopy(), QuotaResourceName, QuotaExceededConditionType, status, now); !changed {
+		return false, nil
+	}
+
+	// Get the newest CR version. Otherwise status update may fail because of:
+	//
+	//	 the object has been modified; please apply your changes to the
+	//	 latest version and try again
+	//
+	{
+		o, err := c.g8sClient.ProviderV1alpha1().AzureConfigs(cr.Namespace).Get(cr.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		cr = *o
+	}
+
+	if _, changed := key.SetResourceCondition(&cr, QuotaResourceName, QuotaExceededConditionType, status, now); !changed {
+		return false, nil
+	}
+
+	_, err := c.g8sClient.ProviderV1alpha1().AzureConfigs(cr.Namespace).UpdateStatus(&cr)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	return true, nil
+}
+
+// listUsages returns the compute usages of the given location keyed by their
+// names.
+func listUsages(ctx context.Context, client *compute.UsageClient, location string) (map[string]usage, error) {
+	usages := map[string]usage{}
+
+	iterator, err := client.ListComplete(ctx, location)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	for iterator.NotDone() {
+		u := iterator.Value()
+
+		if u.Name != nil && u.Name.Value != nil && u.CurrentValue != nil && u.Limit != nil {
+			usages[*u.Name.Value] = usage{current: int64(*u.CurrentValue), limit: *u.Limit}
+		}
+
+		err = iterator.NextWithContext(ctx)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return usages, nil
+}
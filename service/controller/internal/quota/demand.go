@@ -0,0 +1,29 @@
+package quota
+
+import (
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// ClusterDemands returns the instances the masters and all worker node pools
+// of the given cluster consist of.
+func ClusterDemands(cr providerv1alpha1.AzureConfig) ([]Demand, error) {
+	var demands []Demand
+
+	for _, m := range cr.Spec.Azure.Masters {
+		demands = append(demands, Demand{VMSize: m.VMSize, Count: 1})
+	}
+
+	pools, err := key.NodePools(cr)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	for _, p := range pools {
+		demands = append(demands, Demand{VMSize: p.VMSize, Count: int64(p.Count), Spot: p.IsSpot()})
+	}
+
+	return demands, nil
+}
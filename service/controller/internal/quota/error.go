@@ -0,0 +1,23 @@
+package quota
+
+import (
+	"github.com/giantswarm/microerror"
+)
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
+var unknownVMSizeError = &microerror.Error{
+	Kind: "unknownVMSizeError",
+}
+
+// IsUnknownVMSize asserts unknownVMSizeError.
+func IsUnknownVMSize(err error) bool {
+	return microerror.Cause(err) == unknownVMSizeError
+}
@@ -0,0 +1,109 @@
+package quota
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	// QuotaResourceName is the name of the resource status holding the
+	// QuotaExceeded condition.
+	QuotaResourceName = "quota"
+	// QuotaExceededConditionType is the type of the condition telling whether
+	// the last operation of a cluster is blocked by insufficient quota.
+	QuotaExceededConditionType = "QuotaExceeded"
+
+	// usageCores is the name of the quota of all regular cores of a region.
+	usageCores = "cores"
+	// usageLowPriorityCores is the name of the quota of all Spot cores of a
+	// region.
+	usageLowPriorityCores = "lowPriorityCores"
+)
+
+// sku is a VM size as far as quotas are concerned.
+type sku struct {
+	family string
+	vCPUs  int64
+}
+
+type usage struct {
+	current int64
+	limit   int64
+}
+
+// shortfall is a quota too small for the demands of an operation.
+type shortfall struct {
+	name      string
+	required  int64
+	available int64
+}
+
+func (s shortfall) String() string {
+	return fmt.Sprintf("%s requires %d cores but %d are available", s.name, s.required, s.available)
+}
+
+// shortfalls returns the quotas the given demands exceed, given the VM sizes
+// keyed by their lower case names and the usages keyed by their names.
+// Regular instances count against the quota of their VM family and the
+// regional core quota, Spot instances only against the regional low priority
+// core quota.
+func shortfalls(demands []Demand, skus map[string]sku, usages map[string]usage) ([]shortfall, error) {
+	required := map[string]int64{}
+	for _, d := range demands {
+		if d.Count <= 0 {
+			continue
+		}
+
+		s, ok := skus[strings.ToLower(d.VMSize)]
+		if !ok {
+			return nil, microerror.Maskf(unknownVMSizeError, "VM size %#q", d.VMSize)
+		}
+
+		cores := s.vCPUs * d.Count
+		if d.Spot {
+			required[usageLowPriorityCores] += cores
+			continue
+		}
+
+		required[s.family] += cores
+		required[usageCores] += cores
+	}
+
+	var names []string
+	for name := range required {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result []shortfall
+	for _, name := range names {
+		// Usages which are not reported are not limited.
+		u, ok := usages[name]
+		if !ok {
+			continue
+		}
+
+		available := u.limit - u.current
+		if available < 0 {
+			available = 0
+		}
+
+		if required[name] > available {
+			result = append(result, shortfall{name: name, required: required[name], available: available})
+		}
+	}
+
+	return result, nil
+}
+
+func joinShortfalls(shortfalls []shortfall) string {
+	var s []string
+	for _, f := range shortfalls {
+		s = append(s, f.String())
+	}
+
+	return strings.Join(s, ", ")
+}
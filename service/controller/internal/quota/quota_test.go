@@ -0,0 +1,155 @@
+package quota
+
+import (
+	"strconv"
+	"testing"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_shortfalls(t *testing.T) {
+	skus := map[string]sku{
+		"standard_d4s_v3": {family: "standardDSv3Family", vCPUs: 4},
+		"standard_e8s_v3": {family: "standardESv3Family", vCPUs: 8},
+	}
+
+	testCases := []struct {
+		name               string
+		demands            []Demand
+		usages             map[string]usage
+		expectedShortfalls []shortfall
+		errorMatcher       func(err error) bool
+	}{
+		{
+			name:    "case 0: sufficient quota",
+			demands: []Demand{{VMSize: "Standard_D4s_v3", Count: 3}},
+			usages: map[string]usage{
+				"cores":              {current: 10, limit: 100},
+				"standardDSv3Family": {current: 8, limit: 20},
+			},
+		},
+		{
+			name:    "case 1: insufficient family quota",
+			demands: []Demand{{VMSize: "Standard_D4s_v3", Count: 4}},
+			usages: map[string]usage{
+				"cores":              {current: 10, limit: 100},
+				"standardDSv3Family": {current: 8, limit: 20},
+			},
+			expectedShortfalls: []shortfall{
+				{name: "standardDSv3Family", required: 16, available: 12},
+			},
+		},
+		{
+			name: "case 2: demands of different families add up in the regional quota",
+			demands: []Demand{
+				{VMSize: "Standard_D4s_v3", Count: 2},
+				{VMSize: "Standard_E8s_v3", Count: 2},
+			},
+			usages: map[string]usage{
+				"cores":              {current: 80, limit: 100},
+				"standardDSv3Family": {current: 0, limit: 100},
+				"standardESv3Family": {current: 0, limit: 100},
+			},
+			expectedShortfalls: []shortfall{
+				{name: "cores", required: 24, available: 20},
+			},
+		},
+		{
+			name:    "case 3: Spot instances count against the low priority quota only",
+			demands: []Demand{{VMSize: "Standard_E8s_v3", Count: 2, Spot: true}},
+			usages: map[string]usage{
+				"cores":              {current: 100, limit: 100},
+				"lowPriorityCores":   {current: 0, limit: 10},
+				"standardESv3Family": {current: 100, limit: 100},
+			},
+			expectedShortfalls: []shortfall{
+				{name: "lowPriorityCores", required: 16, available: 10},
+			},
+		},
+		{
+			name:    "case 4: usages above their limits leave nothing available",
+			demands: []Demand{{VMSize: "Standard_D4s_v3", Count: 1}},
+			usages: map[string]usage{
+				"cores": {current: 110, limit: 100},
+			},
+			expectedShortfalls: []shortfall{
+				{name: "cores", required: 4, available: 0},
+			},
+		},
+		{
+			name:    "case 5: unreported usages are not limited",
+			demands: []Demand{{VMSize: "Standard_D4s_v3", Count: 100}},
+			usages:  map[string]usage{},
+		},
+		{
+			name: "case 6: empty demands are ignored",
+			demands: []Demand{
+				{VMSize: "Standard_D4s_v3", Count: 0},
+				{VMSize: "Standard_Unknown", Count: -1},
+			},
+			usages: map[string]usage{
+				"cores": {current: 100, limit: 100},
+			},
+		},
+		{
+			name:         "case 7: unknown VM size",
+			demands:      []Demand{{VMSize: "Standard_Unknown", Count: 1}},
+			errorMatcher: IsUnknownVMSize,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			result, err := shortfalls(tc.demands, skus, tc.usages)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if !cmp.Equal(result, tc.expectedShortfalls, cmp.AllowUnexported(shortfall{})) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedShortfalls, result, cmp.AllowUnexported(shortfall{})))
+			}
+		})
+	}
+}
+
+func Test_ClusterDemands(t *testing.T) {
+	cr := providerv1alpha1.AzureConfig{}
+	cr.Annotations = map[string]string{
+		"azure-operator.giantswarm.io/node-pools": `[{"name":"batch","vmSize":"Standard_E8s_v3","count":2,"spot":{}}]`,
+	}
+	cr.Spec.Cluster.ID = "eggs2"
+	cr.Spec.Azure.Masters = []providerv1alpha1.AzureConfigSpecAzureNode{
+		{VMSize: "Standard_D2s_v3"},
+	}
+	cr.Spec.Azure.Workers = []providerv1alpha1.AzureConfigSpecAzureNode{
+		{VMSize: "Standard_D4s_v3"},
+		{VMSize: "Standard_D4s_v3"},
+		{VMSize: "Standard_D4s_v3"},
+	}
+
+	demands, err := ClusterDemands(cr)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	expectedDemands := []Demand{
+		{VMSize: "Standard_D2s_v3", Count: 1},
+		{VMSize: "Standard_D4s_v3", Count: 3},
+		{VMSize: "Standard_E8s_v3", Count: 2, Spot: true},
+	}
+
+	if !cmp.Equal(demands, expectedDemands) {
+		t.Fatalf("\n\n%s\n", cmp.Diff(expectedDemands, demands))
+	}
+}
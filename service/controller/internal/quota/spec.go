@@ -0,0 +1,27 @@
+package quota
+
+import (
+	"context"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+)
+
+// Demand is a number of instances of the same VM size an operation is about
+// to create.
+type Demand struct {
+	VMSize string
+	Count  int64
+	// Spot is true for Spot instances, which count against the low priority
+	// core quota of the region instead of the quota of their VM family.
+	Spot bool
+}
+
+// Interface checks the compute quota of the subscription of a cluster before
+// operations creating instances.
+type Interface interface {
+	// Sufficient returns true when the compute quota left in the given
+	// location covers the given demands of the given cluster. Insufficient
+	// quota is reported with the QuotaExceeded condition in the status of the
+	// cluster and a QuotaExceeded event naming the given operation.
+	Sufficient(ctx context.Context, cr providerv1alpha1.AzureConfig, location string, operation string, demands []Demand) (bool, error)
+}
@@ -0,0 +1,84 @@
+// Package recorder provides the event recorder used to publish Kubernetes
+// events on the custom resources reconciled by the operator, so that
+// `kubectl describe` tells what the operator did to a tenant cluster.
+package recorder
+
+import (
+	"fmt"
+
+	"github.com/giantswarm/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// Reasons of the events emitted by the operator.
+const (
+	ReasonCanaryFailed             = "CanaryFailed"
+	ReasonCanaryPassed             = "CanaryPassed"
+	ReasonCredentialCheckFailed    = "CredentialCheckFailed"
+	ReasonCredentialCheckSucceeded = "CredentialCheckSucceeded"
+	ReasonDeploymentFailed         = "DeploymentFailed"
+	ReasonDeploymentPlanned        = "DeploymentPlanned"
+	ReasonDeploymentUpdated        = "DeploymentUpdated"
+	ReasonEtcdRestoreFailed        = "EtcdRestoreFailed"
+	ReasonEtcdRestored             = "EtcdRestored"
+	ReasonEtcdSnapshotTaken        = "EtcdSnapshotTaken"
+	ReasonInstanceDeleted          = "InstanceDeleted"
+	ReasonInstanceReimaged         = "InstanceReimaged"
+	ReasonInstanceRemediated       = "InstanceRemediated"
+	ReasonNodeUnhealthy            = "NodeUnhealthy"
+	ReasonQuotaExceeded            = "QuotaExceeded"
+	ReasonQuotaSufficient          = "QuotaSufficient"
+	ReasonReconciliationPaused     = "ReconciliationPaused"
+	ReasonReconciliationResumed    = "ReconciliationResumed"
+	ReasonRemoteCommandFailed      = "RemoteCommandFailed"
+	ReasonRemoteCommandSucceeded   = "RemoteCommandSucceeded"
+	ReasonResourceGroupCreated     = "ResourceGroupCreated"
+	ReasonResourceGroupDeleting    = "ResourceGroupDeleting"
+	ReasonStateChanged             = "StateChanged"
+	ReasonStateEscalated           = "StateEscalated"
+	ReasonStateTransitionFailed    = "StateTransitionFailed"
+	ReasonUpgradeFailed            = "UpgradeFailed"
+	ReasonUpgradeRolledBack        = "UpgradeRolledBack"
+	ReasonVMSSScaled               = "VMSSScaled"
+)
+
+type Config struct {
+	K8sClient k8sclient.Interface
+	Logger    micrologger.Logger
+
+	// Component is the source component of the events, usually the project
+	// name.
+	Component string
+}
+
+// New creates an event recorder which publishes events to the control plane
+// Kubernetes API. Objects events are emitted for must be registered in the
+// scheme of the given K8sClient.
+func New(config Config) (record.EventRecorder, error) {
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	if config.Component == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Component must not be empty", config)
+	}
+
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartLogging(func(format string, args ...interface{}) {
+		config.Logger.Log("level", "debug", "message", "emitted event", "event", fmt.Sprintf(format, args...)) // nolint: errcheck
+	})
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: config.K8sClient.K8sClient().CoreV1().Events(""),
+	})
+
+	r := broadcaster.NewRecorder(config.K8sClient.Scheme(), corev1.EventSource{Component: config.Component})
+
+	return r, nil
+}
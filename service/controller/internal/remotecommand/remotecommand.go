@@ -0,0 +1,532 @@
+// Package remotecommand runs named and versioned scripts on the instances of
+// the VMSSs of tenant clusters with the Azure run command API. The results of
+// the scripts are stored in a config map per cluster, so that they can be
+// inspected after the fact.
+package remotecommand
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/giantswarm/azure-operator/v4/pkg/project"
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
+)
+
+const (
+	// maxOutputLength is the number of bytes of the stdout, stderr and error
+	// of results kept in the config map. Longer output is cut at the front.
+	maxOutputLength = 4 * 1024
+	// maxResults is the number of results kept in the config map. Along with
+	// maxOutputLength it keeps the config map well below the size limit of
+	// 1 MiB of Kubernetes objects.
+	maxResults = 100
+	// resultsRetention is the time results are kept in the config map.
+	resultsRetention = 7 * 24 * time.Hour
+
+	stderrMarker = "[stderr]\n"
+	stdoutMarker = "[stdout]\n"
+)
+
+var (
+	exitStatusRegexp = regexp.MustCompile(`exit status=(\d+)`)
+	requestIDRegexp  = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+)
+
+type Config struct {
+	K8sClient kubernetes.Interface
+	Logger    micrologger.Logger
+
+	RemoteCommand setting.RemoteCommand
+}
+
+type Runner struct {
+	k8sClient kubernetes.Interface
+	logger    micrologger.Logger
+
+	maxConcurrent int
+	timeout       time.Duration
+
+	// running holds the requests started with Start which did not finish
+	// yet, keyed by cluster ID and request ID.
+	mutex   sync.Mutex
+	running map[string]bool
+}
+
+func New(config Config) (*Runner, error) {
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	if err := config.RemoteCommand.Validate(); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.RemoteCommand.%s", config, err)
+	}
+
+	r := &Runner{
+		k8sClient: config.K8sClient,
+		logger:    config.Logger,
+
+		maxConcurrent: config.RemoteCommand.MaxConcurrent,
+		timeout:       config.RemoteCommand.Timeout,
+
+		running: map[string]bool{},
+	}
+
+	return r, nil
+}
+
+func (r *Runner) Run(ctx context.Context, cr providerv1alpha1.AzureConfig, request Request) ([]Result, error) {
+	c, script, err := r.prepare(ctx, request)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	results, err := r.run(ctx, c, cr, request, script)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return results, nil
+}
+
+func (r *Runner) Start(ctx context.Context, cr providerv1alpha1.AzureConfig, request Request) error {
+	c, script, err := r.prepare(ctx, request)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	k := runningKey(cr, request.ID)
+	{
+		r.mutex.Lock()
+		if r.running[k] {
+			r.mutex.Unlock()
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("request %#q is running already", request.ID))
+			return nil
+		}
+		r.running[k] = true
+		r.mutex.Unlock()
+	}
+
+	// The script outlives the reconciliation loop which started it, so it
+	// must not be canceled along with the context of the loop.
+	go func() {
+		defer func() {
+			r.mutex.Lock()
+			delete(r.running, k)
+			r.mutex.Unlock()
+		}()
+
+		_, err := r.run(context.Background(), c, cr, request, script)
+		if err != nil {
+			r.logger.Log("level", "warning", "message", fmt.Sprintf("failed to run request %#q of cluster %#q", request.ID, key.ClusterID(cr)), "stack", fmt.Sprintf("%#v", err)) // nolint: errcheck
+		}
+	}()
+
+	return nil
+}
+
+func (r *Runner) Running(cr providerv1alpha1.AzureConfig, requestID string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.running[runningKey(cr, requestID)]
+}
+
+func (r *Runner) Results(ctx context.Context, cr providerv1alpha1.AzureConfig) ([]Result, error) {
+	stored, err := r.getResults(cr)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var results []Result
+	for _, res := range stored {
+		results = append(results, res)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].StartedAt.Equal(results[j].StartedAt) {
+			return resultKey(results[i]) < resultKey(results[j])
+		}
+		return results[i].StartedAt.Before(results[j].StartedAt)
+	})
+
+	return results, nil
+}
+
+func (r *Runner) DeleteResults(ctx context.Context, cr providerv1alpha1.AzureConfig) error {
+	err := r.k8sClient.CoreV1().ConfigMaps(key.CertificateEncryptionNamespace).Delete(key.RemoteCommandResultsConfigMapName(cr), &metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// prepare validates the given request and returns the client and the rendered
+// script to run it with.
+func (r *Runner) prepare(ctx context.Context, request Request) (*compute.VirtualMachineScaleSetVMsClient, string, error) {
+	if !requestIDRegexp.MatchString(request.ID) {
+		return nil, "", microerror.Maskf(invalidRequestError, "request ID %#q must only contain alphanumeric characters, '-' and '_'", request.ID)
+	}
+	if request.VMSSName == "" {
+		return nil, "", microerror.Maskf(invalidRequestError, "VMSS name of request %#q must not be empty", request.ID)
+	}
+
+	script, err := request.Script.Render(request.Parameters)
+	if err != nil {
+		return nil, "", microerror.Mask(err)
+	}
+
+	c, err := r.getVMsClient(ctx)
+	if err != nil {
+		return nil, "", microerror.Mask(err)
+	}
+
+	return c, script, nil
+}
+
+// run runs the given rendered script of the given request on the selected
+// instances and stores the results.
+func (r *Runner) run(ctx context.Context, c *compute.VirtualMachineScaleSetVMsClient, cr providerv1alpha1.AzureConfig, request Request, script string) ([]Result, error) {
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("running script %#q of request %#q on %d instances of VMSS %#q", request.Script.ID(), request.ID, len(request.InstanceIDs), request.VMSSName))
+
+	results := make([]Result, len(request.InstanceIDs))
+	{
+		sem := make(chan struct{}, r.maxConcurrent)
+		var wg sync.WaitGroup
+
+		for i, id := range request.InstanceIDs {
+			wg.Add(1)
+			go func(i int, id string) {
+				defer wg.Done()
+
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				results[i] = r.runOnInstance(ctx, c, cr, request, script, id)
+			}(i, id)
+		}
+
+		wg.Wait()
+	}
+
+	err := r.storeResults(cr, results)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("ran script %#q of request %#q on %d instances of VMSS %#q", request.Script.ID(), request.ID, len(request.InstanceIDs), request.VMSSName))
+
+	return results, nil
+}
+
+func (r *Runner) runOnInstance(ctx context.Context, c *compute.VirtualMachineScaleSetVMsClient, cr providerv1alpha1.AzureConfig, request Request, script string, instanceID string) Result {
+	result := Result{
+		RequestID:  request.ID,
+		Script:     request.Script.Name,
+		Version:    request.Script.Version,
+		VMSSName:   request.VMSSName,
+		InstanceID: instanceID,
+		StartedAt:  time.Now().UTC(),
+		ExitCode:   -1,
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	message, err := runShellScript(runCtx, c, key.ResourceGroupName(cr), request.VMSSName, instanceID, script)
+	result.FinishedAt = time.Now().UTC()
+	if err != nil {
+		r.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("failed to run script %#q on instance %#q of VMSS %#q", request.Script.ID(), instanceID, request.VMSSName), "stack", fmt.Sprintf("%#v", err))
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Stdout, result.Stderr, result.ExitCode = parseMessage(message)
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("script %#q exited with status %d on instance %#q of VMSS %#q", request.Script.ID(), result.ExitCode, instanceID, request.VMSSName))
+
+	return result
+}
+
+func (r *Runner) getResults(cr providerv1alpha1.AzureConfig) (map[string]Result, error) {
+	cm, err := r.k8sClient.CoreV1().ConfigMaps(key.CertificateEncryptionNamespace).Get(key.RemoteCommandResultsConfigMapName(cr), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return map[string]Result{}, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	results, err := decodeResults(cm.Data)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return results, nil
+}
+
+// storeResults adds the given results to the stored ones and drops the ones
+// older than resultsRetention, or beyond the newest maxResults. The config map
+// is updated with the resource version it was read with and updated again on
+// conflicts, so that results of concurrent requests are not lost.
+func (r *Runner) storeResults(cr providerv1alpha1.AzureConfig, results []Result) error {
+	isRetriable := func(err error) bool {
+		return apierrors.IsConflict(microerror.Cause(err)) || apierrors.IsAlreadyExists(microerror.Cause(err))
+	}
+
+	err := retry.OnError(retry.DefaultRetry, isRetriable, func() error {
+		cm, err := r.k8sClient.CoreV1().ConfigMaps(key.CertificateEncryptionNamespace).Get(key.RemoteCommandResultsConfigMapName(cr), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			cm = nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		stored := map[string]Result{}
+		if cm != nil {
+			stored, err = decodeResults(cm.Data)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		for _, res := range results {
+			stored[resultKey(res)] = truncateResult(res)
+		}
+		pruneResults(stored, time.Now().Add(-resultsRetention))
+		limitResults(stored, maxResults)
+
+		data, err := encodeResults(stored)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if cm == nil {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.RemoteCommandResultsConfigMapName(cr),
+					Namespace: key.CertificateEncryptionNamespace,
+					Labels: map[string]string{
+						key.LabelCluster:      key.ClusterID(cr),
+						key.LabelManagedBy:    project.Name(),
+						key.LabelOrganization: key.ClusterCustomer(cr),
+					},
+				},
+				Data: data,
+			}
+
+			_, err = r.k8sClient.CoreV1().ConfigMaps(key.CertificateEncryptionNamespace).Create(cm)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return nil
+		}
+
+		cm.Data = data
+
+		_, err = r.k8sClient.CoreV1().ConfigMaps(key.CertificateEncryptionNamespace).Update(cm)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *Runner) getVMsClient(ctx context.Context) (*compute.VirtualMachineScaleSetVMsClient, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return cc.AzureClientSet.VirtualMachineScaleSetVMsClient, nil
+}
+
+// runShellScript runs the given script on the given instance and returns the
+// messages of the run command API once it finished.
+func runShellScript(ctx context.Context, c *compute.VirtualMachineScaleSetVMsClient, resourceGroup string, vmssName string, instanceID string, script string) (string, error) {
+	input := compute.RunCommandInput{
+		CommandID: to.StringPtr("RunShellScript"),
+		Script:    to.StringSlicePtr(strings.Split(script, "\n")),
+	}
+	future, err := c.RunCommand(ctx, resourceGroup, vmssName, instanceID, input)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+	err = future.WaitForCompletionRef(ctx, c.Client)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+	result, err := future.Result(*c)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	var messages []string
+	if result.Value != nil {
+		for _, s := range *result.Value {
+			if s.Message != nil {
+				messages = append(messages, *s.Message)
+			}
+		}
+	}
+
+	return strings.Join(messages, "\n"), nil
+}
+
+// parseMessage splits the message of the run command API into the output of
+// the script and its exit status. Messages look like this.
+//
+//	Enable failed: failed to execute command: command terminated with exit status=1
+//	[stdout]
+//	...
+//	[stderr]
+//	...
+func parseMessage(message string) (string, string, int) {
+	head := message
+	var stdout, stderr string
+	if i := strings.Index(message, stdoutMarker); i >= 0 {
+		head = message[:i]
+		stdout = message[i+len(stdoutMarker):]
+
+		if j := strings.Index(stdout, stderrMarker); j >= 0 {
+			stderr = stdout[j+len(stderrMarker):]
+			stdout = stdout[:j]
+		}
+	}
+
+	exitCode := -1
+	if strings.HasPrefix(head, "Enable succeeded") {
+		exitCode = 0
+	} else if m := exitStatusRegexp.FindStringSubmatch(head); m != nil {
+		c, err := strconv.Atoi(m[1])
+		if err == nil {
+			exitCode = c
+		}
+	}
+
+	return strings.TrimSuffix(stdout, "\n"), strings.TrimSuffix(stderr, "\n"), exitCode
+}
+
+// runningKey returns the key of the given request of the given cluster in the
+// requests started with Start.
+func runningKey(cr providerv1alpha1.AzureConfig, requestID string) string {
+	return fmt.Sprintf("%s/%s", key.ClusterID(cr), requestID)
+}
+
+// resultKey returns the key of the given result in the config map. Results of
+// the same request on the same instance replace each other.
+func resultKey(result Result) string {
+	return fmt.Sprintf("%s.%s_%s", result.RequestID, result.VMSSName, result.InstanceID)
+}
+
+// pruneResults drops the results which finished before the given time.
+func pruneResults(results map[string]Result, before time.Time) {
+	for k, res := range results {
+		if res.FinishedAt.Before(before) {
+			delete(results, k)
+		}
+	}
+}
+
+// limitResults drops the oldest results until at most max are left.
+func limitResults(results map[string]Result, max int) {
+	if len(results) <= max {
+		return
+	}
+
+	var keys []string
+	for k := range results {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if results[keys[i]].StartedAt.Equal(results[keys[j]].StartedAt) {
+			return keys[i] < keys[j]
+		}
+		return results[keys[i]].StartedAt.Before(results[keys[j]].StartedAt)
+	})
+
+	for _, k := range keys[:len(keys)-max] {
+		delete(results, k)
+	}
+}
+
+// truncateResult cuts the stdout, stderr and error of the given result to
+// their last maxOutputLength bytes.
+func truncateResult(result Result) Result {
+	result.Stdout = truncateOutput(result.Stdout, maxOutputLength)
+	result.Stderr = truncateOutput(result.Stderr, maxOutputLength)
+	result.Error = truncateOutput(result.Error, maxOutputLength)
+
+	return result
+}
+
+// truncateOutput returns the last max bytes of the given output, without
+// splitting UTF-8 encoded characters.
+func truncateOutput(output string, max int) string {
+	if len(output) <= max {
+		return output
+	}
+
+	i := len(output) - max
+	for i < len(output) && !utf8.RuneStart(output[i]) {
+		i++
+	}
+
+	return output[i:]
+}
+
+func decodeResults(data map[string]string) (map[string]Result, error) {
+	results := map[string]Result{}
+	for k, v := range data {
+		var res Result
+		err := json.Unmarshal([]byte(v), &res)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		results[k] = res
+	}
+
+	return results, nil
+}
+
+func encodeResults(results map[string]Result) (map[string]string, error) {
+	data := map[string]string{}
+	for k, res := range results {
+		b, err := json.Marshal(res)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		data[k] = string(b)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,301 @@
+package remotecommand
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+func Test_parseMessage(t *testing.T) {
+	testCases := []struct {
+		name             string
+		message          string
+		expectedStdout   string
+		expectedStderr   string
+		expectedExitCode int
+	}{
+		{
+			name:             "case 0: script succeeded",
+			message:          "Enable succeeded: \n[stdout]\nactive\n\n[stderr]\n",
+			expectedStdout:   "active\n",
+			expectedExitCode: 0,
+		},
+		{
+			name:             "case 1: script failed",
+			message:          "Enable failed: failed to execute command: command terminated with exit status=3\n[stdout]\n\n[stderr]\nFailed to restart k8s-kubelet.service: Unit not found.\n",
+			expectedStderr:   "Failed to restart k8s-kubelet.service: Unit not found.",
+			expectedExitCode: 3,
+		},
+		{
+			name:             "case 2: script without output",
+			message:          "Enable succeeded: \n[stdout]\n\n[stderr]\n",
+			expectedExitCode: 0,
+		},
+		{
+			name:             "case 3: unknown message",
+			message:          "Enable failed: extension is busy",
+			expectedExitCode: -1,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			stdout, stderr, exitCode := parseMessage(tc.message)
+
+			if stdout != tc.expectedStdout {
+				t.Fatalf("stdout == %q, want %q", stdout, tc.expectedStdout)
+			}
+			if stderr != tc.expectedStderr {
+				t.Fatalf("stderr == %q, want %q", stderr, tc.expectedStderr)
+			}
+			if exitCode != tc.expectedExitCode {
+				t.Fatalf("exit code == %d, want %d", exitCode, tc.expectedExitCode)
+			}
+		})
+	}
+}
+
+func Test_pruneResults(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	old := Result{RequestID: "old", VMSSName: "a1b2c-worker", InstanceID: "0", FinishedAt: now.Add(-8 * 24 * time.Hour)}
+	recent := Result{RequestID: "recent", VMSSName: "a1b2c-worker", InstanceID: "0", FinishedAt: now.Add(-time.Hour)}
+
+	results := map[string]Result{
+		resultKey(old):    old,
+		resultKey(recent): recent,
+	}
+
+	pruneResults(results, now.Add(-resultsRetention))
+
+	expected := map[string]Result{
+		"recent.a1b2c-worker_0": recent,
+	}
+	if !cmp.Equal(results, expected) {
+		t.Fatalf("\n\n%s\n", cmp.Diff(expected, results))
+	}
+}
+
+func Test_limitResults(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	results := map[string]Result{}
+	for i := 0; i < 5; i++ {
+		res := Result{RequestID: "r" + strconv.Itoa(i), VMSSName: "a1b2c-worker", InstanceID: "0", StartedAt: now.Add(time.Duration(i) * time.Minute)}
+		results[resultKey(res)] = res
+	}
+
+	limitResults(results, 3)
+
+	var keys []string
+	for k := range results {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	expected := []string{"r2.a1b2c-worker_0", "r3.a1b2c-worker_0", "r4.a1b2c-worker_0"}
+	if !cmp.Equal(keys, expected) {
+		t.Fatalf("\n\n%s\n", cmp.Diff(expected, keys))
+	}
+}
+
+func Test_truncateOutput(t *testing.T) {
+	testCases := []struct {
+		name           string
+		output         string
+		max            int
+		expectedOutput string
+	}{
+		{
+			name:           "case 0: short output",
+			output:         "active",
+			max:            8,
+			expectedOutput: "active",
+		},
+		{
+			name:           "case 1: long output keeps its tail",
+			output:         "line 1\nline 2\n",
+			max:            7,
+			expectedOutput: "line 2\n",
+		},
+		{
+			name:           "case 2: multi-byte characters are not split",
+			output:         "ab\u00e9cd",
+			max:            3,
+			expectedOutput: "cd",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			output := truncateOutput(tc.output, tc.max)
+
+			if output != tc.expectedOutput {
+				t.Fatalf("output == %q, want %q", output, tc.expectedOutput)
+			}
+		})
+	}
+}
+
+func Test_Runner_storeResults(t *testing.T) {
+	cr := providerv1alpha1.AzureConfig{}
+	cr.Spec.Cluster.ID = "a1b2c"
+
+	stored := Result{RequestID: "stored", VMSSName: "a1b2c-worker", InstanceID: "0", StartedAt: time.Now(), FinishedAt: time.Now()}
+	concurrent := Result{RequestID: "concurrent", VMSSName: "a1b2c-worker", InstanceID: "0", StartedAt: time.Now(), FinishedAt: time.Now()}
+	added := Result{RequestID: "added", VMSSName: "a1b2c-worker", InstanceID: "0", StartedAt: time.Now(), FinishedAt: time.Now(), Stdout: strings.Repeat("x", 2*maxOutputLength)}
+
+	testCases := []struct {
+		name         string
+		stored       []Result
+		conflict     bool
+		expectedKeys []string
+	}{
+		{
+			name:         "case 0: no results stored yet",
+			expectedKeys: []string{"added.a1b2c-worker_0"},
+		},
+		{
+			name:         "case 1: results stored already",
+			stored:       []Result{stored},
+			expectedKeys: []string{"added.a1b2c-worker_0", "stored.a1b2c-worker_0"},
+		},
+		{
+			name:         "case 2: results stored concurrently",
+			stored:       []Result{stored},
+			conflict:     true,
+			expectedKeys: []string{"added.a1b2c-worker_0", "concurrent.a1b2c-worker_0", "stored.a1b2c-worker_0"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var objects []runtime.Object
+			if tc.stored != nil {
+				results := map[string]Result{}
+				for _, res := range tc.stored {
+					results[resultKey(res)] = res
+				}
+				data, err := encodeResults(results)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+
+				objects = append(objects, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      key.RemoteCommandResultsConfigMapName(cr),
+						Namespace: key.CertificateEncryptionNamespace,
+					},
+					Data: data,
+				})
+			}
+
+			k8sClient := fake.NewSimpleClientset(objects...)
+
+			if tc.conflict {
+				conflicted := false
+				k8sClient.PrependReactor("update", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					if conflicted {
+						return false, nil, nil
+					}
+					conflicted = true
+
+					// Another request stores its results in the meantime.
+					cm, err := k8sClient.Tracker().Get(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, key.CertificateEncryptionNamespace, key.RemoteCommandResultsConfigMapName(cr))
+					if err != nil {
+						return true, nil, err
+					}
+					b, err := encodeResults(map[string]Result{resultKey(concurrent): concurrent})
+					if err != nil {
+						return true, nil, err
+					}
+					for k, v := range b {
+						cm.(*corev1.ConfigMap).Data[k] = v
+					}
+					err = k8sClient.Tracker().Update(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, cm, key.CertificateEncryptionNamespace)
+					if err != nil {
+						return true, nil, err
+					}
+
+					return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, key.RemoteCommandResultsConfigMapName(cr), nil)
+				})
+			}
+
+			r := &Runner{
+				k8sClient: k8sClient,
+				logger:    microloggertest.New(),
+			}
+
+			err := r.storeResults(cr, []Result{added})
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			results, err := r.getResults(cr)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			var keys []string
+			for k := range results {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			if !cmp.Equal(keys, tc.expectedKeys) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedKeys, keys))
+			}
+			if n := len(results[resultKey(added)].Stdout); n != maxOutputLength {
+				t.Fatalf("stdout length == %d, want %d", n, maxOutputLength)
+			}
+		})
+	}
+}
+
+func Test_Runner_Start_invalidRequest(t *testing.T) {
+	cr := providerv1alpha1.AzureConfig{}
+	cr.Spec.Cluster.ID = "a1b2c"
+
+	r := &Runner{
+		k8sClient: fake.NewSimpleClientset(),
+		logger:    microloggertest.New(),
+
+		running: map[string]bool{},
+	}
+
+	request := Request{
+		ID:       "flatcar migration",
+		Script:   RestartKubelet,
+		VMSSName: "a1b2c-worker",
+	}
+
+	err := r.Start(context.Background(), cr, request)
+	if !IsInvalidRequest(err) {
+		t.Fatalf("error == %#v, want invalidRequestError", err)
+	}
+	if r.Running(cr, request.ID) {
+		t.Fatalf("request %#q is running, want not running", request.ID)
+	}
+}
@@ -0,0 +1,86 @@
+package remotecommand
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/giantswarm/microerror"
+)
+
+// Script is a named and versioned bash script run on instances. The version
+// has to be bumped whenever the template changes, so that stored results tell
+// which script produced them.
+type Script struct {
+	Name    string
+	Version string
+	// Template is a text/template rendered with the parameters of requests.
+	Template string
+}
+
+// ID returns the name and the version of the script.
+func (s Script) ID() string {
+	return fmt.Sprintf("%s-%s", s.Name, s.Version)
+}
+
+// Render executes the template of the script with the given parameters.
+func (s Script) Render(parameters map[string]interface{}) (string, error) {
+	t, err := template.New(s.ID()).Option("missingkey=error").Parse(s.Template)
+	if err != nil {
+		return "", microerror.Maskf(invalidScriptError, "script %#q: %s", s.ID(), err)
+	}
+
+	var b bytes.Buffer
+	err = t.Execute(&b, parameters)
+	if err != nil {
+		return "", microerror.Maskf(invalidScriptError, "script %#q: %s", s.ID(), err)
+	}
+
+	return b.String(), nil
+}
+
+var (
+	// NodeDiagnostics prints the state of the services of a node and the
+	// recent logs of the kubelet.
+	NodeDiagnostics = Script{
+		Name:    "node-diagnostics",
+		Version: "1",
+		Template: `#!/bin/bash
+uptime
+df --human-readable
+systemctl --no-pager --failed
+systemctl --no-pager status k8s-kubelet docker
+journalctl --no-pager --unit k8s-kubelet --since "-10min" | tail -n 50
+`,
+	}
+
+	// RestartKubelet restarts the kubelet of a node.
+	RestartKubelet = Script{
+		Name:    "restart-kubelet",
+		Version: "1",
+		Template: `#!/bin/bash
+set -o errexit -o nounset -o pipefail
+
+systemctl restart k8s-kubelet
+`,
+	}
+)
+
+// onDemandScripts are the scripts which may be run on demand with the
+// key.AnnotationRemoteCommand annotation. They take no parameters.
+var onDemandScripts = []Script{
+	NodeDiagnostics,
+	RestartKubelet,
+}
+
+// Lookup returns the script with the given name and version which may be run
+// on demand.
+func Lookup(name string, version string) (Script, error) {
+	for _, s := range onDemandScripts {
+		if s.Name == name && s.Version == version {
+			return s, nil
+		}
+	}
+
+	return Script{}, microerror.Maskf(notFoundError, "script %#q version %#q", name, version)
+}
@@ -0,0 +1,61 @@
+package remotecommand
+
+import (
+	"strconv"
+	"testing"
+)
+
+func Test_Script_Render(t *testing.T) {
+	testCases := []struct {
+		name           string
+		script         Script
+		parameters     map[string]interface{}
+		expectedResult string
+		errorMatcher   func(error) bool
+	}{
+		{
+			name:           "case 0: script without parameters",
+			script:         Script{Name: "hello", Version: "1", Template: "echo hello\n"},
+			expectedResult: "echo hello\n",
+		},
+		{
+			name:           "case 1: script with parameters",
+			script:         Script{Name: "hello", Version: "1", Template: "echo {{ .Name }}\n"},
+			parameters:     map[string]interface{}{"Name": "world"},
+			expectedResult: "echo world\n",
+		},
+		{
+			name:         "case 2: missing parameter",
+			script:       Script{Name: "hello", Version: "1", Template: "echo {{ .Name }}\n"},
+			errorMatcher: IsInvalidScript,
+		},
+		{
+			name:         "case 3: invalid template",
+			script:       Script{Name: "hello", Version: "1", Template: "echo {{ .Name \n"},
+			errorMatcher: IsInvalidScript,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			result, err := tc.script.Render(tc.parameters)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if result != tc.expectedResult {
+				t.Fatalf("result == %q, want %q", result, tc.expectedResult)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package remotecommand
+
+import (
+	"context"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+)
+
+// Interface runs scripts on the instances of the VMSSs of tenant clusters.
+type Interface interface {
+	// Run runs the script of the given request on the instances of the given
+	// cluster it selects and returns the results once the script finished on
+	// all of them. The results are stored along with the ones of earlier
+	// requests, of which only the newest are kept with the tail of their
+	// output. Failures of the script on single instances are reported in
+	// their results rather than as error.
+	Run(ctx context.Context, cr providerv1alpha1.AzureConfig, request Request) ([]Result, error)
+	// Start runs the script of the given request like Run, but in the
+	// background. It returns once the request got validated. Requests of the
+	// given cluster with the same ID which are still running are not started
+	// again. The results are stored once the script finished on all
+	// instances.
+	Start(ctx context.Context, cr providerv1alpha1.AzureConfig, request Request) error
+	// Running returns true while the request with the given ID of the given
+	// cluster started with Start is running.
+	Running(cr providerv1alpha1.AzureConfig, requestID string) bool
+	// Results returns the stored results of the given cluster, oldest first.
+	Results(ctx context.Context, cr providerv1alpha1.AzureConfig) ([]Result, error)
+	// DeleteResults deletes the stored results of the given cluster.
+	DeleteResults(ctx context.Context, cr providerv1alpha1.AzureConfig) error
+}
+
+// Request selects the instances a script is run on.
+type Request struct {
+	// ID identifies the request in the stored results. It may only contain
+	// alphanumeric characters, '-' and '_'.
+	ID string
+	// Script is the script run on the instances.
+	Script Script
+	// Parameters are passed to the template of the script.
+	Parameters map[string]interface{}
+	// VMSSName is the name of the VMSS the instances belong to.
+	VMSSName string
+	// InstanceIDs are the IDs of the instances of the VMSS the script is run
+	// on.
+	InstanceIDs []string
+}
+
+// Result is the outcome of a script run on a single instance.
+type Result struct {
+	RequestID  string    `json:"requestID"`
+	Script     string    `json:"script"`
+	Version    string    `json:"version"`
+	VMSSName   string    `json:"vmssName"`
+	InstanceID string    `json:"instanceID"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	// ExitCode is the exit status of the script, or -1 when it is unknown,
+	// e.g. because the script could not be run.
+	ExitCode int `json:"exitCode"`
+	// Stdout and Stderr hold the tail of the output of the script. Azure
+	// only keeps the last few kilobytes of each, and stored results even
+	// less.
+	Stdout string `json:"stdout"`
+	Stderr string `json:"stderr"`
+	// Error tells why the script could not be run on the instance.
+	Error string `json:"error,omitempty"`
+}
+
+// Succeeded returns true when the script ran and exited with status 0.
+func (r Result) Succeeded() bool {
+	return r.Error == "" && r.ExitCode == 0
+}
+
+// FailedInstances returns the IDs of the instances the script of the given
+// results did not succeed on.
+func FailedInstances(results []Result) []string {
+	var failed []string
+	for _, r := range results {
+		if !r.Succeeded() {
+			failed = append(failed, r.InstanceID)
+		}
+	}
+
+	return failed
+}
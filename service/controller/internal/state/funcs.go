@@ -2,6 +2,7 @@ package state
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/giantswarm/microerror"
 )
@@ -12,11 +13,18 @@ func (m Machine) Execute(ctx context.Context, obj interface{}, currentState Stat
 		return "", microerror.Maskf(executionFailedError, "State: %q is not configured in this state machine", currentState)
 	}
 
-	newState, err := transitionFunc(ctx, obj, currentState)
+	newState, escalated, err := m.escalate(ctx, obj, currentState)
 	if err != nil {
 		return newState, microerror.Mask(err)
 	}
 
+	if !escalated {
+		newState, err = transitionFunc(ctx, obj, currentState)
+		if err != nil {
+			return newState, microerror.Mask(err)
+		}
+	}
+
 	_, exists = m.Transitions[newState]
 	if !exists {
 		return newState, microerror.Maskf(executionFailedError, "State transition returned new unknown state: %q. Input state: %q", newState, currentState)
@@ -25,3 +33,45 @@ func (m Machine) Execute(ctx context.Context, obj interface{}, currentState Stat
 	m.Logger.LogCtx(ctx, "resource", m.ResourceName, "message", "state changed", "oldState", currentState, "newState", newState)
 	return newState, nil
 }
+
+// escalate checks if the current state has been held longer than its
+// configured timeout. In that case the escalation target is returned together
+// with true.
+func (m Machine) escalate(ctx context.Context, obj interface{}, currentState State) (State, bool, error) {
+	timeout, exists := m.Timeouts[currentState]
+	if !exists || m.EnteredAtFunc == nil {
+		return currentState, false, nil
+	}
+
+	enteredAt, err := m.EnteredAtFunc(ctx, obj, currentState)
+	if err != nil {
+		return currentState, false, microerror.Mask(err)
+	}
+	if enteredAt.IsZero() {
+		return currentState, false, nil
+	}
+
+	heldFor := m.clock().Now().Sub(enteredAt)
+	if heldFor <= timeout.Deadline {
+		return currentState, false, nil
+	}
+
+	m.Logger.LogCtx(ctx, "level", "warning", "resource", m.ResourceName, "message", fmt.Sprintf("state %q held for %s exceeding its deadline of %s, escalating to %q", currentState, heldFor, timeout.Deadline, timeout.Escalate))
+
+	if m.EscalationFunc != nil {
+		err = m.EscalationFunc(ctx, obj, currentState, timeout.Escalate, heldFor)
+		if err != nil {
+			return currentState, false, microerror.Mask(err)
+		}
+	}
+
+	return timeout.Escalate, true, nil
+}
+
+func (m Machine) clock() Clock {
+	if m.Clock == nil {
+		return systemClock{}
+	}
+
+	return m.Clock
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
@@ -13,14 +14,29 @@ import (
 const (
 	OpenState   = "open"
 	ClosedState = "closed"
+	StuckState  = "stuck"
 )
 
+type fakeClock struct {
+	now time.Time
+}
+
+func (c fakeClock) Now() time.Time {
+	return c.now
+}
+
 func Test_StateMachine(t *testing.T) {
 	logger, err := micrologger.New(micrologger.Config{})
 	if err != nil {
 		t.Fatal(err)
 	}
 
+	t0 := time.Date(2020, 5, 20, 10, 0, 0, 0, time.UTC)
+	enteredAt := func(ctx context.Context, obj interface{}, currentState State) (time.Time, error) { return t0, nil }
+	stay := func(ctx context.Context, obj interface{}, currentState State) (State, error) {
+		return currentState, nil
+	}
+
 	testCases := []struct {
 		name             string
 		machine          Machine
@@ -77,6 +93,87 @@ func Test_StateMachine(t *testing.T) {
 			expectedNewState: "",
 			errorMatcher:     IsExecutionFailedError,
 		},
+		{
+			name: "case 4: state held within its deadline is not escalated",
+			machine: Machine{
+				Logger:       logger,
+				ResourceName: "",
+				Transitions: TransitionMap{
+					OpenState:  stay,
+					StuckState: stay,
+				},
+				Timeouts: TimeoutMap{
+					OpenState: {Deadline: time.Hour, Escalate: StuckState},
+				},
+				EnteredAtFunc: enteredAt,
+				Clock:         fakeClock{now: t0.Add(30 * time.Minute)},
+			},
+			currentState:     OpenState,
+			expectedNewState: OpenState,
+			errorMatcher:     nil,
+		},
+		{
+			name: "case 5: state held longer than its deadline is escalated",
+			machine: Machine{
+				Logger:       logger,
+				ResourceName: "",
+				Transitions: TransitionMap{
+					OpenState:  stay,
+					StuckState: stay,
+				},
+				Timeouts: TimeoutMap{
+					OpenState: {Deadline: time.Hour, Escalate: StuckState},
+				},
+				EnteredAtFunc: enteredAt,
+				Clock:         fakeClock{now: t0.Add(2 * time.Hour)},
+			},
+			currentState:     OpenState,
+			expectedNewState: StuckState,
+			errorMatcher:     nil,
+		},
+		{
+			name: "case 6: unknown entry time disables the deadline",
+			machine: Machine{
+				Logger:       logger,
+				ResourceName: "",
+				Transitions: TransitionMap{
+					OpenState:  stay,
+					StuckState: stay,
+				},
+				Timeouts: TimeoutMap{
+					OpenState: {Deadline: time.Hour, Escalate: StuckState},
+				},
+				EnteredAtFunc: func(ctx context.Context, obj interface{}, currentState State) (time.Time, error) {
+					return time.Time{}, nil
+				},
+				Clock: fakeClock{now: t0.Add(2 * time.Hour)},
+			},
+			currentState:     OpenState,
+			expectedNewState: OpenState,
+			errorMatcher:     nil,
+		},
+		{
+			name: "case 7: failing escalation keeps the current state",
+			machine: Machine{
+				Logger:       logger,
+				ResourceName: "",
+				Transitions: TransitionMap{
+					OpenState:  stay,
+					StuckState: stay,
+				},
+				Timeouts: TimeoutMap{
+					OpenState: {Deadline: time.Hour, Escalate: StuckState},
+				},
+				EnteredAtFunc: enteredAt,
+				EscalationFunc: func(ctx context.Context, obj interface{}, currentState State, newState State, heldFor time.Duration) error {
+					return microerror.Mask(executionFailedError)
+				},
+				Clock: fakeClock{now: t0.Add(2 * time.Hour)},
+			},
+			currentState:     OpenState,
+			expectedNewState: OpenState,
+			errorMatcher:     IsExecutionFailedError,
+		},
 	}
 
 	for i, tc := range testCases {
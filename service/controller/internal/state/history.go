@@ -0,0 +1,165 @@
+package state
+
+import (
+	"encoding/json"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// HistoryConditionType is the condition type used to persist state
+	// transitions in the resource status of the custom resource.
+	HistoryConditionType = "StateTransition"
+	// HistoryLimit is the maximum number of transitions kept in the resource
+	// status. Older transitions are dropped first.
+	HistoryLimit = 20
+
+	// maxErrorLength limits the size of error messages persisted in the
+	// history so that a verbose error does not bloat the CR status.
+	maxErrorLength = 256
+)
+
+// Transition describes a single step of a state machine. Transitions which
+// failed have From and To set to the same state and carry the error message.
+type Transition struct {
+	From     State
+	To       State
+	Time     time.Time
+	Duration time.Duration
+	Error    string
+}
+
+// transitionStatus is the representation of a Transition persisted in the
+// status of a condition. The time of the transition is kept in the
+// condition's LastTransitionTime.
+type transitionStatus struct {
+	From     State  `json:"from"`
+	To       State  `json:"to"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+// AppendHistory appends the given transition to the history persisted in the
+// given conditions and returns the resulting conditions. The duration spent in
+// the state the transition leaves is computed from the history. Consecutive
+// failures with the same error are only recorded once so that a transition
+// failing on every reconciliation loop does not flush the history. At most
+// limit transitions are kept.
+func AppendHistory(conditions []providerv1alpha1.StatusClusterResourceCondition, t Transition, limit int) []providerv1alpha1.StatusClusterResourceCondition {
+	history := History(conditions)
+
+	if len(history) > 0 {
+		last := history[len(history)-1]
+		if t.Error != "" && last.From == t.From && last.To == t.To && last.Error == t.Error {
+			return conditions
+		}
+	}
+
+	if t.Duration == 0 {
+		t.Duration = durationInState(history, t.From, t.Time)
+	}
+	if len(t.Error) > maxErrorLength {
+		t.Error = t.Error[:maxErrorLength]
+	}
+
+	history = append(history, t)
+	if len(history) > limit {
+		history = history[len(history)-limit:]
+	}
+
+	var newConditions []providerv1alpha1.StatusClusterResourceCondition
+	for _, c := range conditions {
+		if c.Type == HistoryConditionType {
+			continue
+		}
+		newConditions = append(newConditions, c)
+	}
+	for _, h := range history {
+		newConditions = append(newConditions, toCondition(h))
+	}
+
+	return newConditions
+}
+
+// History returns the transitions persisted in the given conditions, oldest
+// first. Conditions which cannot be decoded are ignored.
+func History(conditions []providerv1alpha1.StatusClusterResourceCondition) []Transition {
+	var history []Transition
+
+	for _, c := range conditions {
+		if c.Type != HistoryConditionType {
+			continue
+		}
+
+		var s transitionStatus
+		err := json.Unmarshal([]byte(c.Status), &s)
+		if err != nil {
+			continue
+		}
+		d, err := time.ParseDuration(s.Duration)
+		if err != nil {
+			continue
+		}
+
+		t := Transition{
+			From:     s.From,
+			To:       s.To,
+			Time:     c.LastTransitionTime.Time,
+			Duration: d,
+			Error:    s.Error,
+		}
+
+		history = append(history, t)
+	}
+
+	return history
+}
+
+// EnteredAt returns the time the given state was entered according to the
+// given history. The zero time is returned when the most recent successful
+// transition did not lead to the given state, e.g. because the state was set
+// manually.
+func EnteredAt(history []Transition, s State) time.Time {
+	for i := len(history) - 1; i >= 0; i-- {
+		h := history[i]
+		if h.Error != "" {
+			continue
+		}
+		if h.To == s {
+			return h.Time
+		}
+
+		break
+	}
+
+	return time.Time{}
+}
+
+func durationInState(history []Transition, s State, now time.Time) time.Duration {
+	enteredAt := EnteredAt(history, s)
+	if enteredAt.IsZero() || now.Before(enteredAt) {
+		return 0
+	}
+
+	return now.Sub(enteredAt)
+}
+
+func toCondition(t Transition) providerv1alpha1.StatusClusterResourceCondition {
+	s := transitionStatus{
+		From:     t.From,
+		To:       t.To,
+		Duration: t.Duration.String(),
+		Error:    t.Error,
+	}
+
+	// Marshaling a struct of strings can not fail.
+	b, _ := json.Marshal(s)
+
+	return providerv1alpha1.StatusClusterResourceCondition{
+		LastTransitionTime: metav1.NewTime(t.Time),
+		Status:             string(b),
+		Type:               HistoryConditionType,
+	}
+}
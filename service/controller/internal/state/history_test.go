@@ -0,0 +1,102 @@
+package state
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_AppendHistory(t *testing.T) {
+	t0 := time.Date(2020, 5, 20, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		conditions      []providerv1alpha1.StatusClusterResourceCondition
+		transitions     []Transition
+		limit           int
+		expectedHistory []Transition
+		expectedOther   int
+	}{
+		{
+			name: "case 0: first transition is appended without duration",
+			transitions: []Transition{
+				{From: "", To: OpenState, Time: t0},
+			},
+			limit: 5,
+			expectedHistory: []Transition{
+				{From: "", To: OpenState, Time: t0},
+			},
+		},
+		{
+			name: "case 1: duration in state is computed from the history",
+			transitions: []Transition{
+				{From: "", To: OpenState, Time: t0},
+				{From: OpenState, To: ClosedState, Time: t0.Add(6 * time.Hour)},
+			},
+			limit: 5,
+			expectedHistory: []Transition{
+				{From: "", To: OpenState, Time: t0},
+				{From: OpenState, To: ClosedState, Time: t0.Add(6 * time.Hour), Duration: 6 * time.Hour},
+			},
+		},
+		{
+			name: "case 2: consecutive identical failures are recorded once",
+			transitions: []Transition{
+				{From: "", To: OpenState, Time: t0},
+				{From: OpenState, To: OpenState, Time: t0.Add(time.Minute), Error: "boom"},
+				{From: OpenState, To: OpenState, Time: t0.Add(2 * time.Minute), Error: "boom"},
+			},
+			limit: 5,
+			expectedHistory: []Transition{
+				{From: "", To: OpenState, Time: t0},
+				{From: OpenState, To: OpenState, Time: t0.Add(time.Minute), Duration: time.Minute, Error: "boom"},
+			},
+		},
+		{
+			name: "case 3: history is bounded and other conditions are preserved",
+			conditions: []providerv1alpha1.StatusClusterResourceCondition{
+				{Type: "Stage", Status: ClosedState},
+			},
+			transitions: []Transition{
+				{From: "", To: OpenState, Time: t0},
+				{From: OpenState, To: ClosedState, Time: t0.Add(time.Minute)},
+				{From: ClosedState, To: OpenState, Time: t0.Add(3 * time.Minute)},
+			},
+			limit: 2,
+			expectedHistory: []Transition{
+				{From: OpenState, To: ClosedState, Time: t0.Add(time.Minute), Duration: time.Minute},
+				{From: ClosedState, To: OpenState, Time: t0.Add(3 * time.Minute), Duration: 2 * time.Minute},
+			},
+			expectedOther: 1,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			conditions := tc.conditions
+			for _, tr := range tc.transitions {
+				conditions = AppendHistory(conditions, tr, tc.limit)
+			}
+
+			history := History(conditions)
+			if !cmp.Equal(history, tc.expectedHistory) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedHistory, history))
+			}
+
+			var other int
+			for _, c := range conditions {
+				if c.Type != HistoryConditionType {
+					other++
+				}
+			}
+			if other != tc.expectedOther {
+				t.Fatalf("other conditions == %d, want %d", other, tc.expectedOther)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package state
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	PrometheusNamespace = "azure_operator"
+	PrometheusSubsystem = "state_machine"
+)
+
+var (
+	currentStateGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "current_state",
+			Help:      "Current state of the state machine of a resource per cluster. The value is always 1.",
+		},
+		[]string{"cluster_id", "resource", "state"},
+	)
+
+	stateDurationHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "state_duration_seconds",
+			Help:      "Time spent in a state before the state machine transitioned to another state.",
+			// Buckets range from one minute to roughly 34 hours.
+			Buckets: prometheus.ExponentialBuckets(60, 2, 12),
+		},
+		[]string{"resource", "state"},
+	)
+)
+
+// currentStates tracks the state reported per cluster and resource, so that
+// the series of the previous state can be removed when the state changes and
+// all series of a cluster can be removed when it gets deleted.
+var currentStates sync.Map
+
+type currentStateKey struct {
+	clusterID    string
+	resourceName string
+}
+
+func init() {
+	prometheus.MustRegister(currentStateGauge)
+	prometheus.MustRegister(stateDurationHistogram)
+}
+
+// ReportState updates the current state metric of the given resource and
+// cluster.
+func ReportState(resourceName string, clusterID string, s State) {
+	k := currentStateKey{clusterID: clusterID, resourceName: resourceName}
+
+	previous, loaded := currentStates.Load(k)
+	if loaded && previous.(State) != s {
+		currentStateGauge.DeleteLabelValues(clusterID, resourceName, string(previous.(State)))
+	}
+
+	currentStates.Store(k, s)
+	currentStateGauge.WithLabelValues(clusterID, resourceName, string(s)).Set(1)
+}
+
+// DeleteCluster removes the current state metrics of all resources of the
+// given cluster.
+func DeleteCluster(clusterID string) {
+	currentStates.Range(func(k, v interface{}) bool {
+		if k.(currentStateKey).clusterID == clusterID {
+			currentStateGauge.DeleteLabelValues(clusterID, k.(currentStateKey).resourceName, string(v.(State)))
+			currentStates.Delete(k)
+		}
+		return true
+	})
+}
+
+// ReportTransition records the time spent in the state the given transition
+// leaves. Failed transitions are not recorded since the state is not left.
+func ReportTransition(resourceName string, t Transition) {
+	if t.Error != "" || t.From == t.To || t.Duration == 0 {
+		return
+	}
+
+	stateDurationHistogram.WithLabelValues(resourceName, string(t.From)).Observe(t.Duration.Seconds())
+}
@@ -0,0 +1,31 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func Test_DeleteCluster(t *testing.T) {
+	series := testutil.CollectAndCount(currentStateGauge)
+
+	ReportState("instance", "a1b2c", State("DeploymentUninitialized"))
+	ReportState("instance", "a1b2c", State("ClusterUpgradeRequirementCheck"))
+	ReportState("masters", "a1b2c", State("DeploymentCompleted"))
+	ReportState("instance", "d3e4f", State("DeploymentCompleted"))
+
+	if n := testutil.CollectAndCount(currentStateGauge); n != series+3 {
+		t.Fatalf("series == %d, want %d", n, series+3)
+	}
+
+	DeleteCluster("a1b2c")
+
+	if n := testutil.CollectAndCount(currentStateGauge); n != series+1 {
+		t.Fatalf("series == %d, want %d", n, series+1)
+	}
+	if v := testutil.ToFloat64(currentStateGauge.WithLabelValues("d3e4f", "instance", "DeploymentCompleted")); v != 1 {
+		t.Fatalf("value == %f, want %f", v, 1.0)
+	}
+
+	DeleteCluster("d3e4f")
+}
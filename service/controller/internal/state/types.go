@@ -2,6 +2,7 @@ package state
 
 import (
 	"context"
+	"time"
 
 	"github.com/giantswarm/micrologger"
 )
@@ -11,10 +12,51 @@ type Machine struct {
 	Logger       micrologger.Logger
 	ResourceName string
 	Transitions  TransitionMap
+
+	// Timeouts optionally limits how long states may be held. States without
+	// timeout may be held forever.
+	Timeouts TimeoutMap
+	// EnteredAtFunc returns the time the given state was entered. The zero
+	// time disables the timeout of the state. Timeouts are only honoured when
+	// EnteredAtFunc is configured.
+	EnteredAtFunc EnteredAtFunc
+	// EscalationFunc is optionally executed when a state is escalated because
+	// it was held longer than its timeout, e.g. to emit events or set failure
+	// conditions.
+	EscalationFunc EscalationFunc
+	// Clock defaults to the system clock when not configured.
+	Clock Clock
 }
 
 type State string
 type TransitionMap map[State]TransitionFunc
+type TimeoutMap map[State]Timeout
+
+// Timeout defines how long a state may be held and where the state machine
+// escalates to when the deadline passed.
+type Timeout struct {
+	Deadline time.Duration
+	Escalate State
+}
 
 // TransitionFunc defines state transition function signature.
 type TransitionFunc func(ctx context.Context, obj interface{}, currentState State) (State, error)
+
+// EnteredAtFunc defines the signature of the function looking up when a state
+// was entered.
+type EnteredAtFunc func(ctx context.Context, obj interface{}, currentState State) (time.Time, error)
+
+// EscalationFunc defines the signature of the function executed when a state
+// is escalated.
+type EscalationFunc func(ctx context.Context, obj interface{}, currentState State, newState State, heldFor time.Duration) error
+
+// Clock abstracts the current time so that timeouts can be tested.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
@@ -7,11 +7,19 @@ import (
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/giantswarm/azure-operator/v4/client/senddecorator"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
 )
 
 type deleterJob struct {
-	context context.Context
-	logger  micrologger.Logger
+	context       context.Context
+	eventRecorder record.EventRecorder
+	logger        micrologger.Logger
+	obj           runtime.Object
 
 	id                    string
 	resourceGroup         string
@@ -34,7 +42,13 @@ func (dj *deleterJob) Run() error {
 
 	var err error
 	dj.allInstancesSucceeded, err = dj.deleteFailedInstances(dj.context, dj.resourceGroup, dj.vmss)
-	if err != nil {
+	if senddecorator.IsRateLimitBudgetExhausted(err) {
+		// The watchdog is low priority. Try again later once the rate limit
+		// budget of the subscription recovered.
+		dj.logger.LogCtx(dj.context, "level", "debug", "message", "deferring check due to low Azure API rate limit budget")
+		dj.nextExecutionTime = time.Now().Add(deferredCheckInterval)
+		return nil
+	} else if err != nil {
 		return microerror.Mask(err)
 	}
 
@@ -48,11 +62,12 @@ func (dj *deleterJob) Finished() bool {
 		return false
 	}
 
+	jobsFinishedCounter.WithLabelValues(actionDelete).Inc()
 	dj.onFinished()
 	return true
 }
 
-// If any of the instances is not Succeeded, returns false.
+// If any of the instances is not Succeeded or evicted, returns false.
 // It deletes instances that are in "Failed" state.
 func (dj *deleterJob) deleteFailedInstances(ctx context.Context, rg string, vmssName string) (bool, error) {
 	c, err := getVMsClient(ctx)
@@ -61,7 +76,7 @@ func (dj *deleterJob) deleteFailedInstances(ctx context.Context, rg string, vmss
 	}
 
 	// Get a list of instances in the VMSS.
-	iterator, err := c.ListComplete(ctx, rg, vmssName, "", "", "")
+	iterator, err := listInstances(ctx, rg, vmssName)
 	if err != nil {
 		return false, microerror.Mask(err)
 	}
@@ -73,19 +88,28 @@ func (dj *deleterJob) deleteFailedInstances(ctx context.Context, rg string, vmss
 
 		dj.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Instance %s has state %s", *instance.Name, *instance.ProvisioningState))
 
-		switch *instance.ProvisioningState {
-		case provisioningStateFailed:
+		switch {
+		case isEvicted(instance):
+			// Evicted Spot instances may be in Failed provisioning state when
+			// Azure could not allocate them again. Deleting them would only
+			// repeat the failed allocation, so they are left alone.
+			dj.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Skipping evicted Spot instance %s", *instance.Name))
+			instanceActionsCounter.WithLabelValues(actionDelete, resultSkipped).Inc()
+		case *instance.ProvisioningState == provisioningStateFailed:
 			// Reimage the instance.
 			dj.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Deleting instance %s", *instance.Name))
 			_, err := c.Delete(ctx, rg, vmssName, *instance.InstanceID)
 			if err != nil {
 				dj.logger.LogCtx(ctx, "level", "error", "message", fmt.Sprintf("Error deleting instance %s: %s", *instance.Name, err.Error()))
+				instanceActionsCounter.WithLabelValues(actionDelete, resultFailure).Inc()
 				return false, microerror.Mask(err)
 			}
 
 			dj.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Deleted instance %s", *instance.Name))
+			instanceActionsCounter.WithLabelValues(actionDelete, resultSuccess).Inc()
+			dj.eventRecorder.Eventf(dj.obj, corev1.EventTypeWarning, recorder.ReasonInstanceDeleted, "deleted instance %s of VMSS %s in provisioning state %s", *instance.Name, vmssName, provisioningStateFailed)
 			allSucceeded = false
-		case provisioningStateSucceeded:
+		case *instance.ProvisioningState == provisioningStateSucceeded:
 			// OK to continue.
 		default:
 			// Just wait.
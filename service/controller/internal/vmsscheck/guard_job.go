@@ -7,6 +7,12 @@ import (
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/giantswarm/azure-operator/v4/client/senddecorator"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
 )
 
 const (
@@ -15,8 +21,10 @@ const (
 )
 
 type guardJob struct {
-	context context.Context
-	logger  micrologger.Logger
+	context       context.Context
+	eventRecorder record.EventRecorder
+	logger        micrologger.Logger
+	obj           runtime.Object
 
 	id                    string
 	resourceGroup         string
@@ -39,7 +47,13 @@ func (gj *guardJob) Run() error {
 
 	var err error
 	gj.allInstancesSucceeded, err = gj.reimageFailedInstances(gj.context, gj.resourceGroup, gj.vmss)
-	if err != nil {
+	if senddecorator.IsRateLimitBudgetExhausted(err) {
+		// The watchdog is low priority. Try again later once the rate limit
+		// budget of the subscription recovered.
+		gj.logger.LogCtx(gj.context, "level", "debug", "message", "deferring check due to low Azure API rate limit budget")
+		gj.nextExecutionTime = time.Now().Add(deferredCheckInterval)
+		return nil
+	} else if err != nil {
 		return microerror.Mask(err)
 	}
 
@@ -53,11 +67,12 @@ func (gj *guardJob) Finished() bool {
 		return false
 	}
 
+	jobsFinishedCounter.WithLabelValues(actionReimage).Inc()
 	gj.onFinished()
 	return true
 }
 
-// If any of the instances is not Succeeded, returns false.
+// If any of the instances is not Succeeded or evicted, returns false.
 // It reimages instances that are in "Failed" state.
 func (gj *guardJob) reimageFailedInstances(ctx context.Context, rg string, vmssName string) (bool, error) {
 	c, err := getVMsClient(ctx)
@@ -66,7 +81,7 @@ func (gj *guardJob) reimageFailedInstances(ctx context.Context, rg string, vmssN
 	}
 
 	// Get a list of instances in the VMSS.
-	iterator, err := c.ListComplete(ctx, rg, vmssName, "", "", "")
+	iterator, err := listInstances(ctx, rg, vmssName)
 	if err != nil {
 		return false, microerror.Mask(err)
 	}
@@ -78,8 +93,14 @@ func (gj *guardJob) reimageFailedInstances(ctx context.Context, rg string, vmssN
 
 		gj.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Instance %s has state %s", *instance.Name, *instance.ProvisioningState))
 
-		switch *instance.ProvisioningState {
-		case provisioningStateFailed:
+		switch {
+		case isEvicted(instance):
+			// Evicted Spot instances may be in Failed provisioning state when
+			// Azure could not allocate them again. Reimaging them would only
+			// repeat the failed allocation, so they are left alone.
+			gj.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Skipping evicted Spot instance %s", *instance.Name))
+			instanceActionsCounter.WithLabelValues(actionReimage, resultSkipped).Inc()
+		case *instance.ProvisioningState == provisioningStateFailed:
 			// Reimage the instance.
 			gj.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Reimaging instance %s", *instance.Name))
 
@@ -88,6 +109,7 @@ func (gj *guardJob) reimageFailedInstances(ctx context.Context, rg string, vmssN
 				_, err := c.Reimage(ctx, rg, vmssName, *instance.InstanceID, nil)
 				if err != nil {
 					gj.logger.LogCtx(ctx, "level", "error", "message", fmt.Sprintf("Error reimaging instance %s: %s", *instance.Name, err.Error()))
+					instanceActionsCounter.WithLabelValues(actionReimage, resultFailure).Inc()
 					if retries == 0 {
 						return false, microerror.Mask(err)
 					}
@@ -101,8 +123,10 @@ func (gj *guardJob) reimageFailedInstances(ctx context.Context, rg string, vmssN
 			}
 
 			gj.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Reimaged instance %s", *instance.Name))
+			instanceActionsCounter.WithLabelValues(actionReimage, resultSuccess).Inc()
+			gj.eventRecorder.Eventf(gj.obj, corev1.EventTypeWarning, recorder.ReasonInstanceReimaged, "reimaged instance %s of VMSS %s in provisioning state %s", *instance.Name, vmssName, provisioningStateFailed)
 			allSucceeded = false
-		case provisioningStateSucceeded:
+		case *instance.ProvisioningState == provisioningStateSucceeded:
 			// OK to continue.
 		default:
 			// Just wait.
@@ -7,45 +7,62 @@ import (
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 
+	"github.com/giantswarm/azure-operator/v4/client/senddecorator"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/workerpool"
 )
 
+const (
+	// deferredCheckInterval is the time a check is postponed by when it was
+	// deferred due to a low Azure API rate limit budget.
+	deferredCheckInterval = 1 * time.Minute
+)
+
 type Config struct {
-	Logger micrologger.Logger
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
 
 	NumWorkers int
 }
 
 type concurrentInstanceWatchdog struct {
-	vmssGuards *sync.Map
-	pool       *workerpool.Pool
-	logger     micrologger.Logger
+	vmssGuards    *sync.Map
+	pool          *workerpool.Pool
+	eventRecorder record.EventRecorder
+	logger        micrologger.Logger
 }
 
 func NewInstanceWatchdog(config Config) (InstanceWatchdog, error) {
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
 
 	wd := &concurrentInstanceWatchdog{
-		vmssGuards: new(sync.Map),
-		pool:       workerpool.New(config.NumWorkers, config.Logger),
-		logger:     config.Logger,
+		vmssGuards:    new(sync.Map),
+		pool:          workerpool.New(config.NumWorkers, config.Logger),
+		eventRecorder: config.EventRecorder,
+		logger:        config.Logger,
 	}
 
 	return wd, nil
 }
 
-func (wd *concurrentInstanceWatchdog) GuardVMSS(ctx context.Context, resourceGroupName, vmssName string) {
+func (wd *concurrentInstanceWatchdog) GuardVMSS(ctx context.Context, obj runtime.Object, resourceGroupName, vmssName string) {
 	jobID := vmssGuardName(resourceGroupName, vmssName, "reimage")
 	job := &guardJob{
 		id:                jobID,
 		resourceGroup:     resourceGroupName,
 		vmss:              vmssName,
 		nextExecutionTime: time.Now().Add(60 * time.Second),
-		context:           ctx,
+		context:           senddecorator.WithLowPriority(ctx),
+		eventRecorder:     wd.eventRecorder,
 		logger:            wd.logger,
+		obj:               obj,
 
 		onFinished: func() { wd.vmssGuards.Delete(jobID) },
 	}
@@ -58,15 +75,17 @@ func (wd *concurrentInstanceWatchdog) GuardVMSS(ctx context.Context, resourceGro
 	wd.pool.EnqueueJob(job)
 }
 
-func (wd *concurrentInstanceWatchdog) DeleteFailedVMSS(ctx context.Context, resourceGroupName, vmssName string) {
+func (wd *concurrentInstanceWatchdog) DeleteFailedVMSS(ctx context.Context, obj runtime.Object, resourceGroupName, vmssName string) {
 	jobID := vmssGuardName(resourceGroupName, vmssName, "delete")
 	job := &deleterJob{
 		id:                jobID,
 		resourceGroup:     resourceGroupName,
 		vmss:              vmssName,
 		nextExecutionTime: time.Now().Add(60 * time.Second),
-		context:           ctx,
+		context:           senddecorator.WithLowPriority(ctx),
+		eventRecorder:     wd.eventRecorder,
 		logger:            wd.logger,
+		obj:               obj,
 
 		onFinished: func() { wd.vmssGuards.Delete(jobID) },
 	}
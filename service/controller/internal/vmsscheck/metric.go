@@ -0,0 +1,46 @@
+package vmsscheck
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	PrometheusNamespace = "azure_operator"
+	PrometheusSubsystem = "vmss_watchdog"
+)
+
+const (
+	actionDelete  = "delete"
+	actionReimage = "reimage"
+
+	resultFailure = "failure"
+	resultSkipped = "skipped"
+	resultSuccess = "success"
+)
+
+var (
+	instanceActionsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "instance_actions_total",
+			Help:      "Number of actions taken or skipped by the VMSS watchdog on instances in failed provisioning state or evicted Spot instances.",
+		},
+		[]string{"action", "result"},
+	)
+
+	jobsFinishedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "jobs_finished_total",
+			Help:      "Number of VMSS watchdog jobs which finished because all instances succeeded provisioning.",
+		},
+		[]string{"action"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(instanceActionsCounter)
+	prometheus.MustRegister(jobsFinishedCounter)
+}
@@ -1,8 +1,12 @@
 package vmsscheck
 
-import "context"
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
 
 type InstanceWatchdog interface {
-	GuardVMSS(ctx context.Context, resourceGroupName, vmssName string)
-	DeleteFailedVMSS(ctx context.Context, resourceGroupName, vmssName string)
+	GuardVMSS(ctx context.Context, obj runtime.Object, resourceGroupName, vmssName string)
+	DeleteFailedVMSS(ctx context.Context, obj runtime.Object, resourceGroupName, vmssName string)
 }
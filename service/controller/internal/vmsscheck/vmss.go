@@ -37,18 +37,19 @@ const (
 
 	// If the number of remaining calls for 3min drops below this threshold, we do not proceed
 	remainingCallsThreshold3m = remainingCallsMax3m * 0.5
+
+	// Instance view status codes of instances which are stopped and do not
+	// run on any host. Evicted Spot instances with the Deallocate eviction
+	// policy are in one of these states.
+	powerStateDeallocated  = "PowerState/deallocated"
+	powerStateDeallocating = "PowerState/deallocating"
 )
 
 // Find out provisioning state of all VMSS instances and return true if all are
 // Succeeded.
 func InstancesAreRunning(ctx context.Context, logger micrologger.Logger, rg string, vmssName string) (bool, error) {
-	c, err := getVMsClient(ctx)
-	if err != nil {
-		return false, microerror.Mask(err)
-	}
-
 	// Get a list of instances in the VMSS.
-	iterator, err := c.ListComplete(ctx, rg, vmssName, "", "", "")
+	iterator, err := listInstances(ctx, rg, vmssName)
 	if err != nil {
 		return false, microerror.Mask(err)
 	}
@@ -60,10 +61,13 @@ func InstancesAreRunning(ctx context.Context, logger micrologger.Logger, rg stri
 		instance := iterator.Value()
 		logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Instance %s has state %s", *instance.Name, *instance.ProvisioningState))
 
-		switch *instance.ProvisioningState {
-		case provisioningStateFailed:
+		switch {
+		case isEvicted(instance):
+			// Evicted Spot instances stay deallocated until they get started
+			// or deleted. Waiting for them would block forever.
+		case *instance.ProvisioningState == provisioningStateFailed:
 			allSucceeded = false
-		case provisioningStateSucceeded:
+		case *instance.ProvisioningState == provisioningStateSucceeded:
 			// OK to continue.
 		default:
 			allSucceeded = false
@@ -96,6 +100,65 @@ func getVMsClient(ctx context.Context) (*compute.VirtualMachineScaleSetVMsClient
 	return cc.AzureClientSet.VirtualMachineScaleSetVMsClient, nil
 }
 
+// listInstances lists the instances of the given VMSS. The instance views are
+// only included for Spot VMSS, because they are needed to tell evicted
+// instances apart and are expensive to fetch.
+func listInstances(ctx context.Context, rg string, vmssName string) (compute.VirtualMachineScaleSetVMListResultIterator, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return compute.VirtualMachineScaleSetVMListResultIterator{}, microerror.Mask(err)
+	}
+
+	vmss, err := cc.AzureClientSet.VirtualMachineScaleSetsClient.Get(ctx, rg, vmssName)
+	if err != nil {
+		return compute.VirtualMachineScaleSetVMListResultIterator{}, microerror.Mask(err)
+	}
+
+	var expand string
+	if isSpot(vmss) {
+		expand = string(compute.InstanceView)
+	}
+
+	iterator, err := cc.AzureClientSet.VirtualMachineScaleSetVMsClient.ListComplete(ctx, rg, vmssName, "", "", expand)
+	if err != nil {
+		return compute.VirtualMachineScaleSetVMListResultIterator{}, microerror.Mask(err)
+	}
+
+	return iterator, nil
+}
+
+// isSpot returns true when the given VMSS runs Spot or low priority instances.
+func isSpot(vmss compute.VirtualMachineScaleSet) bool {
+	if vmss.VirtualMachineScaleSetProperties == nil || vmss.VirtualMachineProfile == nil {
+		return false
+	}
+
+	p := vmss.VirtualMachineProfile.Priority
+
+	return p == compute.Spot || p == compute.Low
+}
+
+// isEvicted returns true when the given instance is a deallocated Spot
+// instance. Azure deallocates Spot instances on eviction when the eviction
+// policy is Deallocate. Instances listed without their instance view, i.e.
+// instances of VMSS other than Spot VMSS, are never considered evicted.
+func isEvicted(instance compute.VirtualMachineScaleSetVM) bool {
+	if instance.VirtualMachineScaleSetVMProperties == nil || instance.InstanceView == nil || instance.InstanceView.Statuses == nil {
+		return false
+	}
+
+	for _, s := range *instance.InstanceView.Statuses {
+		if s.Code == nil {
+			continue
+		}
+		if *s.Code == powerStateDeallocated || *s.Code == powerStateDeallocating {
+			return true
+		}
+	}
+
+	return false
+}
+
 func rateLimitThresholdsFromResponse(response autorest.Response) (int64, int64) {
 	headers := response.Header[remainingCallsHeaderName]
 
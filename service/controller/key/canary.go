@@ -0,0 +1,35 @@
+package key
+
+import (
+	"strconv"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+)
+
+const (
+	// AnnotationCanary overrides the operator wide canary setting of a cluster
+	// when set to "true" or "false". Worker upgrades of clusters with canaries
+	// bring up a single new node per node pool first and only continue once
+	// the health gates pass for it.
+	AnnotationCanary = "azure-operator.giantswarm.io/canary"
+	// AnnotationCanaryProbe declares a probe run on canary nodes as a JSON
+	// batch/v1 JobSpec. The canary passes the probe once the Job succeeds.
+	AnnotationCanaryProbe = "azure-operator.giantswarm.io/canary-probe"
+)
+
+// IsCanaryEnabled returns true when worker upgrades of the given cluster start
+// with a canary node. Clusters without a valid AnnotationCanary annotation use
+// the given default.
+func IsCanaryEnabled(customObject providerv1alpha1.AzureConfig, defaultValue bool) bool {
+	v, ok := customObject.GetAnnotations()[AnnotationCanary]
+	if !ok {
+		return defaultValue
+	}
+
+	enabled, err := strconv.ParseBool(v)
+	if err != nil {
+		return defaultValue
+	}
+
+	return enabled
+}
@@ -0,0 +1,61 @@
+package key
+
+import (
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SetResourceCondition sets the condition of the given type of the given
+// resource in the status of the given custom resource to the given status.
+// Other conditions of the resource are kept. Resources without the condition
+// do not get it with status False, so that e.g. clusters which were never
+// paused do not get a paused condition. It returns the previous status of the
+// condition and whether it changed.
+func SetResourceCondition(customObject *providerv1alpha1.AzureConfig, resourceName, conditionType, status string, now time.Time) (string, bool) {
+	condition := providerv1alpha1.StatusClusterResourceCondition{
+		LastTransitionTime: metav1.NewTime(now),
+		Status:             status,
+		Type:               conditionType,
+	}
+
+	for i, r := range customObject.Status.Cluster.Resources {
+		if r.Name != resourceName {
+			continue
+		}
+
+		var previous string
+		var conditions []providerv1alpha1.StatusClusterResourceCondition
+		for _, c := range r.Conditions {
+			if c.Type == conditionType {
+				previous = c.Status
+				continue
+			}
+			conditions = append(conditions, c)
+		}
+
+		if previous == status || previous == "" && status == string(corev1.ConditionFalse) {
+			return previous, false
+		}
+
+		customObject.Status.Cluster.Resources[i].Conditions = append(conditions, condition)
+
+		return previous, true
+	}
+
+	if status == string(corev1.ConditionFalse) {
+		return "", false
+	}
+
+	resourceStatus := providerv1alpha1.StatusClusterResource{
+		Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+			condition,
+		},
+		Name: resourceName,
+	}
+	customObject.Status.Cluster.Resources = append(customObject.Status.Cluster.Resources, resourceStatus)
+
+	return "", true
+}
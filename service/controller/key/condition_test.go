@@ -0,0 +1,157 @@
+package key
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_SetResourceCondition(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	before := metav1.NewTime(now.Add(-time.Hour))
+
+	testCases := []struct {
+		name              string
+		resources         []providerv1alpha1.StatusClusterResource
+		status            string
+		expectedPrevious  string
+		expectedChanged   bool
+		expectedResources []providerv1alpha1.StatusClusterResource
+	}{
+		{
+			name:            "case 0: resource without condition does not get it with status False",
+			status:          "False",
+			expectedChanged: false,
+		},
+		{
+			name:            "case 1: resource without condition gets it with status True",
+			status:          "True",
+			expectedChanged: true,
+			expectedResources: []providerv1alpha1.StatusClusterResource{
+				{
+					Name: "pause",
+					Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+						{LastTransitionTime: metav1.NewTime(now), Status: "True", Type: "Paused"},
+					},
+				},
+			},
+		},
+		{
+			name:            "case 2: resource without condition gets it with other status",
+			status:          "Valid",
+			expectedChanged: true,
+			expectedResources: []providerv1alpha1.StatusClusterResource{
+				{
+					Name: "pause",
+					Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+						{LastTransitionTime: metav1.NewTime(now), Status: "Valid", Type: "Paused"},
+					},
+				},
+			},
+		},
+		{
+			name: "case 3: unchanged status is not updated",
+			resources: []providerv1alpha1.StatusClusterResource{
+				{
+					Name: "pause",
+					Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+						{LastTransitionTime: before, Status: "True", Type: "Paused"},
+					},
+				},
+			},
+			status:           "True",
+			expectedPrevious: "True",
+			expectedChanged:  false,
+			expectedResources: []providerv1alpha1.StatusClusterResource{
+				{
+					Name: "pause",
+					Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+						{LastTransitionTime: before, Status: "True", Type: "Paused"},
+					},
+				},
+			},
+		},
+		{
+			name: "case 4: changed status is replaced and other conditions and resources are kept",
+			resources: []providerv1alpha1.StatusClusterResource{
+				{
+					Name: "quota",
+					Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+						{LastTransitionTime: before, Status: "True", Type: "Paused"},
+					},
+				},
+				{
+					Name: "pause",
+					Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+						{LastTransitionTime: before, Status: "True", Type: "Paused"},
+						{LastTransitionTime: before, Status: "Foo", Type: "Other"},
+					},
+				},
+			},
+			status:           "False",
+			expectedPrevious: "True",
+			expectedChanged:  true,
+			expectedResources: []providerv1alpha1.StatusClusterResource{
+				{
+					Name: "quota",
+					Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+						{LastTransitionTime: before, Status: "True", Type: "Paused"},
+					},
+				},
+				{
+					Name: "pause",
+					Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+						{LastTransitionTime: before, Status: "Foo", Type: "Other"},
+						{LastTransitionTime: metav1.NewTime(now), Status: "False", Type: "Paused"},
+					},
+				},
+			},
+		},
+		{
+			name: "case 5: resource with other conditions does not get condition with status False",
+			resources: []providerv1alpha1.StatusClusterResource{
+				{
+					Name: "pause",
+					Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+						{LastTransitionTime: before, Status: "Foo", Type: "Other"},
+					},
+				},
+			},
+			status:          "False",
+			expectedChanged: false,
+			expectedResources: []providerv1alpha1.StatusClusterResource{
+				{
+					Name: "pause",
+					Conditions: []providerv1alpha1.StatusClusterResourceCondition{
+						{LastTransitionTime: before, Status: "Foo", Type: "Other"},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			cr := providerv1alpha1.AzureConfig{}
+			cr.Status.Cluster.Resources = tc.resources
+
+			previous, changed := SetResourceCondition(&cr, "pause", "Paused", tc.status, now)
+
+			if previous != tc.expectedPrevious {
+				t.Fatalf("previous == %#q, want %#q", previous, tc.expectedPrevious)
+			}
+			if changed != tc.expectedChanged {
+				t.Fatalf("changed == %t, want %t", changed, tc.expectedChanged)
+			}
+			if !cmp.Equal(cr.Status.Cluster.Resources, tc.expectedResources) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedResources, cr.Status.Cluster.Resources))
+			}
+		})
+	}
+}
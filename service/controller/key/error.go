@@ -40,3 +40,21 @@ var wrongTypeError = &microerror.Error{
 func IsWrongTypeError(err error) bool {
 	return microerror.Cause(err) == wrongTypeError
 }
+
+var invalidNodePoolError = &microerror.Error{
+	Kind: "invalidNodePoolError",
+}
+
+// IsInvalidNodePool asserts invalidNodePoolError.
+func IsInvalidNodePool(err error) bool {
+	return microerror.Cause(err) == invalidNodePoolError
+}
+
+var invalidAnnotationError = &microerror.Error{
+	Kind: "invalidAnnotationError",
+}
+
+// IsInvalidAnnotation asserts invalidAnnotationError.
+func IsInvalidAnnotation(err error) bool {
+	return microerror.Cause(err) == invalidAnnotationError
+}
@@ -0,0 +1,72 @@
+package key
+
+import (
+	"fmt"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+)
+
+const (
+	etcdPeerPort              = 2380
+	etcdSnapshotContainerName = "etcd-snapshots"
+)
+
+// MasterCount returns the number of master instances of the given cluster.
+// Each master runs a member of the etcd cluster of the tenant cluster.
+func MasterCount(customObject providerv1alpha1.AzureConfig) int {
+	return len(customObject.Spec.Azure.Masters)
+}
+
+// IsHighlyAvailable returns true when the control plane of the given cluster
+// survives the loss of a master.
+func IsHighlyAvailable(customObject providerv1alpha1.AzureConfig) bool {
+	return MasterCount(customObject) >= 3
+}
+
+// IsValidMasterCount returns true when the given number of masters can form
+// an etcd cluster. Even numbers of members add no failure tolerance.
+func IsValidMasterCount(count int) bool {
+	return count == 1 || count == 3 || count == 5
+}
+
+// EtcdQuorum returns the number of members an etcd cluster of the given size
+// needs to be available.
+func EtcdQuorum(members int) int {
+	return members/2 + 1
+}
+
+// EtcdMemberName returns the name of the etcd member with the given zero
+// based index in highly available control planes.
+func EtcdMemberName(index int) string {
+	return fmt.Sprintf("etcd%d", index+1)
+}
+
+// EtcdMemberDomain returns the DNS name the etcd member with the given zero
+// based index is reachable at by its peers in highly available control
+// planes. The records live in the DNS zone of the cluster next to the record
+// of the etcd load balancer.
+func EtcdMemberDomain(customObject providerv1alpha1.AzureConfig, index int) string {
+	return fmt.Sprintf("%s.%s", EtcdMemberName(index), ClusterDNSDomain(customObject))
+}
+
+// EtcdMemberPeerURL returns the URL the etcd member with the given zero based
+// index serves its peers at in highly available control planes. Peers verify
+// it against the etcd certificate of the cluster, which therefore has to cover
+// the member domain.
+func EtcdMemberPeerURL(customObject providerv1alpha1.AzureConfig, index int) string {
+	return fmt.Sprintf("https://%s:%d", EtcdMemberDomain(customObject, index), etcdPeerPort)
+}
+
+// EtcdSnapshotContainerName returns the name of the container in the storage
+// account of the cluster which holds the etcd snapshots taken before the
+// masters get changed.
+func EtcdSnapshotContainerName() string {
+	return etcdSnapshotContainerName
+}
+
+// EtcdSnapshotBlobName returns the name of the blob holding the etcd snapshot
+// taken from the given instance at the given time.
+func EtcdSnapshotBlobName(instanceName string, takenAt time.Time) string {
+	return fmt.Sprintf("%s-%s.db", instanceName, takenAt.UTC().Format("20060102T150405Z"))
+}
@@ -39,6 +39,15 @@ const (
 	AnnotationEtcdDomain        = "giantswarm.io/etcd-domain"
 	AnnotationPrometheusCluster = "giantswarm.io/prometheus-cluster"
 
+	// AnnotationMaxSurge and AnnotationMaxUnavailable override the operator
+	// wide rolling update settings of the workers of a cluster.
+	AnnotationMaxSurge       = "azure-operator.giantswarm.io/max-surge"
+	AnnotationMaxUnavailable = "azure-operator.giantswarm.io/max-unavailable"
+
+	// AnnotationMaxConcurrentRemediations overrides the operator wide number
+	// of worker instances of a cluster remediated at once.
+	AnnotationMaxConcurrentRemediations = "azure-operator.giantswarm.io/max-concurrent-remediations"
+
 	LabelApp             = "app"
 	LabelCluster         = "giantswarm.io/cluster"
 	LabelCustomer        = "customer"
@@ -121,6 +130,19 @@ func CertificateEncryptionSecretName(customObject providerv1alpha1.AzureConfig)
 	return fmt.Sprintf("%s-certificate-encryption", customObject.Spec.Cluster.ID)
 }
 
+// WorkersDeploymentHistorySecretName returns the name of the secret holding the
+// current and the previous deployment of the worker instances of the given
+// cluster.
+func WorkersDeploymentHistorySecretName(customObject providerv1alpha1.AzureConfig) string {
+	return fmt.Sprintf("%s-workers-deployments", customObject.Spec.Cluster.ID)
+}
+
+// RemoteCommandResultsConfigMapName returns the name of the config map holding
+// the results of the scripts run on the instances of the given cluster.
+func RemoteCommandResultsConfigMapName(customObject providerv1alpha1.AzureConfig) string {
+	return fmt.Sprintf("%s-remote-commands", customObject.Spec.Cluster.ID)
+}
+
 func CloudConfigSmallTemplates() []string {
 	return []string{
 		ignition.Small,
@@ -304,6 +326,65 @@ func MastersSubnetCIDR(customObject providerv1alpha1.AzureConfig) string {
 	return customObject.Spec.Azure.VirtualNetwork.MasterSubnetCIDR
 }
 
+// WorkerMaxSurge returns the max surge annotation of the given cluster, or the
+// given default when the annotation is not set.
+func WorkerMaxSurge(customObject providerv1alpha1.AzureConfig, defaultValue string) string {
+	v, ok := customObject.GetAnnotations()[AnnotationMaxSurge]
+	if !ok {
+		return defaultValue
+	}
+
+	return v
+}
+
+// WorkerMaxUnavailable returns the max unavailable annotation of the given
+// cluster, or the given default when the annotation is not set.
+func WorkerMaxUnavailable(customObject providerv1alpha1.AzureConfig, defaultValue string) string {
+	v, ok := customObject.GetAnnotations()[AnnotationMaxUnavailable]
+	if !ok {
+		return defaultValue
+	}
+
+	return v
+}
+
+// WorkerMaxConcurrentRemediations returns the max concurrent remediations
+// annotation of the given cluster, or the given default when the annotation is
+// not set.
+func WorkerMaxConcurrentRemediations(customObject providerv1alpha1.AzureConfig, defaultValue int) (int, error) {
+	v, ok := customObject.GetAnnotations()[AnnotationMaxConcurrentRemediations]
+	if !ok {
+		return defaultValue, nil
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, microerror.Maskf(invalidAnnotationError, "annotation %#q must be a non-negative number", AnnotationMaxConcurrentRemediations)
+	}
+
+	return n, nil
+}
+
+// IsWorkerAutoscalingEnabled returns true when the given cluster declares a
+// range of worker counts. The number of workers is then managed by the cluster
+// autoscaler within this range.
+func IsWorkerAutoscalingEnabled(customObject providerv1alpha1.AzureConfig) bool {
+	s := customObject.Spec.Cluster.Scaling
+	return s.Max > 0 && s.Min < s.Max
+}
+
+// WorkerMaxCount returns the maximum number of workers of an autoscaled
+// cluster.
+func WorkerMaxCount(customObject providerv1alpha1.AzureConfig) int {
+	return customObject.Spec.Cluster.Scaling.Max
+}
+
+// WorkerMinCount returns the minimum number of workers of an autoscaled
+// cluster.
+func WorkerMinCount(customObject providerv1alpha1.AzureConfig) int {
+	return customObject.Spec.Cluster.Scaling.Min
+}
+
 // WorkerCount returns the desired number of workers.
 func WorkerCount(customObject providerv1alpha1.AzureConfig) int {
 	return len(customObject.Spec.Azure.Workers)
@@ -328,6 +409,15 @@ func MasterNICName(customObject providerv1alpha1.AzureConfig) string {
 	return fmt.Sprintf("%s-Master-1-NIC", ClusterID(customObject))
 }
 
+func LegacyMasterInstanceName(customObject providerv1alpha1.AzureConfig, instanceID string) string {
+	idB36, err := vmssInstanceIDBase36(instanceID)
+	if err != nil {
+		panic(err)
+	}
+
+	return fmt.Sprintf("%s-master-%06s", ClusterID(customObject), idB36)
+}
+
 func LegacyMasterVMSSName(customObject providerv1alpha1.AzureConfig) string {
 	return fmt.Sprintf("%s-master", ClusterID(customObject))
 }
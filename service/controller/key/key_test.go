@@ -3,10 +3,13 @@ package key
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest/to"
 	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Test_ClusterID(t *testing.T) {
@@ -245,6 +248,39 @@ func Test_Functions_for_DNSKeys(t *testing.T) {
 	}
 }
 
+func Test_EtcdMemberDomain(t *testing.T) {
+	expectedEtcdMemberDomain := "etcd2.3p5j2.k8s.domain.tld"
+
+	customObject := providerv1alpha1.AzureConfig{
+		Spec: providerv1alpha1.AzureConfigSpec{
+			Azure: providerv1alpha1.AzureConfigSpecAzure{
+				DNSZones: providerv1alpha1.AzureConfigSpecAzureDNSZones{
+					API: providerv1alpha1.AzureConfigSpecAzureDNSZonesDNSZone{
+						Name: "domain.tld",
+					},
+				},
+			},
+			Cluster: providerv1alpha1.Cluster{
+				ID: "3p5j2",
+			},
+		},
+	}
+
+	if EtcdMemberDomain(customObject, 1) != expectedEtcdMemberDomain {
+		t.Fatalf("Expected etcd member domain %s but was %s", expectedEtcdMemberDomain, EtcdMemberDomain(customObject, 1))
+	}
+}
+
+func Test_EtcdSnapshotBlobName(t *testing.T) {
+	expectedEtcdSnapshotBlobName := "3p5j2-master-3p5j2-000002-20200824T143005Z.db"
+
+	takenAt := time.Date(2020, 8, 24, 16, 30, 5, 0, time.FixedZone("CEST", 2*60*60))
+
+	if EtcdSnapshotBlobName("3p5j2-master-3p5j2-000002", takenAt) != expectedEtcdSnapshotBlobName {
+		t.Fatalf("Expected etcd snapshot blob name %s but was %s", expectedEtcdSnapshotBlobName, EtcdSnapshotBlobName("3p5j2-master-3p5j2-000002", takenAt))
+	}
+}
+
 func Test_MasterNICName(t *testing.T) {
 	expectedMasterNICName := "3p5j2-Master-1-NIC"
 
@@ -260,3 +296,109 @@ func Test_MasterNICName(t *testing.T) {
 		t.Fatalf("Expected master nic name %s but was %s", expectedMasterNICName, MasterNICName(customObject))
 	}
 }
+
+func Test_IsWorkerAutoscalingEnabled(t *testing.T) {
+	testCases := []struct {
+		name           string
+		scaling        providerv1alpha1.ClusterScaling
+		expectedResult bool
+	}{
+		{
+			name:           "case 0: scaling not set",
+			expectedResult: false,
+		},
+		{
+			name:           "case 1: fixed number of workers",
+			scaling:        providerv1alpha1.ClusterScaling{Min: 3, Max: 3},
+			expectedResult: false,
+		},
+		{
+			name:           "case 2: range of workers",
+			scaling:        providerv1alpha1.ClusterScaling{Min: 3, Max: 10},
+			expectedResult: true,
+		},
+		{
+			name:           "case 3: invalid range",
+			scaling:        providerv1alpha1.ClusterScaling{Min: 10, Max: 3},
+			expectedResult: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		customObject := providerv1alpha1.AzureConfig{
+			Spec: providerv1alpha1.AzureConfigSpec{
+				Cluster: providerv1alpha1.Cluster{
+					Scaling: tc.scaling,
+				},
+			},
+		}
+
+		actualRes := IsWorkerAutoscalingEnabled(customObject)
+		if actualRes != tc.expectedResult {
+			t.Fatalf("%s: expected %t but was %t", tc.name, tc.expectedResult, actualRes)
+		}
+	}
+}
+
+func Test_WorkerMaxConcurrentRemediations(t *testing.T) {
+	testCases := []struct {
+		name           string
+		annotations    map[string]string
+		expectedResult int
+		errorMatcher   func(err error) bool
+	}{
+		{
+			name:           "case 0: annotation not set",
+			expectedResult: 1,
+		},
+		{
+			name:           "case 1: annotation overrides the default",
+			annotations:    map[string]string{AnnotationMaxConcurrentRemediations: "3"},
+			expectedResult: 3,
+		},
+		{
+			name:           "case 2: remediation disabled",
+			annotations:    map[string]string{AnnotationMaxConcurrentRemediations: "0"},
+			expectedResult: 0,
+		},
+		{
+			name:         "case 3: invalid annotation",
+			annotations:  map[string]string{AnnotationMaxConcurrentRemediations: "all"},
+			errorMatcher: IsInvalidAnnotation,
+		},
+		{
+			name:         "case 4: negative annotation",
+			annotations:  map[string]string{AnnotationMaxConcurrentRemediations: "-1"},
+			errorMatcher: IsInvalidAnnotation,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			customObject := providerv1alpha1.AzureConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+
+			result, err := WorkerMaxConcurrentRemediations(customObject, 1)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if result != tc.expectedResult {
+				t.Fatalf("result == %d, want %d", result, tc.expectedResult)
+			}
+		})
+	}
+}
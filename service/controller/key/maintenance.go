@@ -0,0 +1,9 @@
+package key
+
+const (
+	// AnnotationMaintenanceWindows declares the maintenance windows of a
+	// cluster as a JSON list of setting.MaintenanceWindow objects. It takes
+	// precedence over the maintenance windows of the cluster's organization.
+	// An empty list lets upgrades start at any time.
+	AnnotationMaintenanceWindows = "azure-operator.giantswarm.io/maintenance-windows"
+)
@@ -0,0 +1,330 @@
+package key
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// AnnotationNodePools declares the additional worker node pools of a
+	// cluster as a JSON list of NodePool objects.
+	AnnotationNodePools = "azure-operator.giantswarm.io/node-pools"
+
+	// DefaultNodePoolName is the name of the node pool made of the workers
+	// declared in the AzureConfig spec.
+	DefaultNodePoolName = "default"
+
+	// LabelNodePool is the label set on the nodes of additional node pools.
+	LabelNodePool = "azure-operator.giantswarm.io/node-pool"
+
+	// LabelSpot is the label and taint key set on the nodes of Spot node
+	// pools. It is the one used by AKS, so that workloads written for AKS
+	// Spot node pools schedule the same way.
+	LabelSpot = "kubernetes.azure.com/scalesetpriority"
+	// LabelSpotValue is the value of LabelSpot on the nodes of Spot node
+	// pools.
+	LabelSpotValue = "spot"
+
+	// SpotEvictionPolicyDeallocate stops evicted Spot instances. Their disks
+	// are kept and still count against the core quota of the subscription.
+	SpotEvictionPolicyDeallocate = "Deallocate"
+	// SpotEvictionPolicyDelete deletes evicted Spot instances.
+	SpotEvictionPolicyDelete = "Delete"
+
+	// SpotMaxPriceOnDemand caps the price of Spot instances at the on-demand
+	// price, so that they are never evicted for price reasons.
+	SpotMaxPriceOnDemand = -1
+)
+
+var nodePoolNameRegexp = regexp.MustCompile("^[a-z0-9]{1,16}$")
+
+// NodePool is a group of workers backed by its own VMSS.
+type NodePool struct {
+	Name                string            `json:"name"`
+	VMSize              string            `json:"vmSize"`
+	DockerVolumeSizeGB  int               `json:"dockerVolumeSizeGB,omitempty"`
+	KubeletVolumeSizeGB int               `json:"kubeletVolumeSizeGB,omitempty"`
+	Labels              map[string]string `json:"labels,omitempty"`
+	Taints              []corev1.Taint    `json:"taints,omitempty"`
+	Zones               []int             `json:"zones,omitempty"`
+	Count               int               `json:"count"`
+	Spot                *NodePoolSpot     `json:"spot,omitempty"`
+}
+
+// NodePoolSpot holds the settings of node pools made of Spot instances. The
+// priority of an existing VMSS cannot be changed, so a node pool cannot be
+// turned into a Spot node pool or back once it got created.
+type NodePoolSpot struct {
+	// MaxPrice is the maximum hourly price in US dollars paid for an
+	// instance. Instances are evicted when the Spot price exceeds it. Zero
+	// defaults to SpotMaxPriceOnDemand.
+	MaxPrice float64 `json:"maxPrice,omitempty"`
+	// EvictionPolicy is either SpotEvictionPolicyDeallocate or
+	// SpotEvictionPolicyDelete. Empty defaults to SpotEvictionPolicyDelete.
+	EvictionPolicy string `json:"evictionPolicy,omitempty"`
+}
+
+// IsDefault returns true for the node pool made of the workers declared in the
+// AzureConfig spec.
+func (p NodePool) IsDefault() bool {
+	return p.Name == DefaultNodePoolName
+}
+
+// IsSpot returns true for node pools made of Spot instances.
+func (p NodePool) IsSpot() bool {
+	return p.Spot != nil
+}
+
+// SpotEvictionPolicy returns the eviction policy of the Spot instances of the
+// pool.
+func (p NodePool) SpotEvictionPolicy() string {
+	if p.Spot == nil || p.Spot.EvictionPolicy == "" {
+		return SpotEvictionPolicyDelete
+	}
+
+	return p.Spot.EvictionPolicy
+}
+
+// SpotMaxPrice returns the maximum hourly price paid for the Spot instances of
+// the pool.
+func (p NodePool) SpotMaxPrice() float64 {
+	if p.Spot == nil || p.Spot.MaxPrice == 0 {
+		return SpotMaxPriceOnDemand
+	}
+
+	return p.Spot.MaxPrice
+}
+
+// KubeletLabels returns the node labels of the pool in the format of the
+// kubelet's --node-labels flag.
+func (p NodePool) KubeletLabels() string {
+	if p.IsDefault() {
+		return ""
+	}
+
+	labels := []string{fmt.Sprintf("%s=%s", LabelNodePool, p.Name)}
+	if p.IsSpot() {
+		labels = append(labels, fmt.Sprintf("%s=%s", LabelSpot, LabelSpotValue))
+	}
+	for k, v := range p.Labels {
+		labels = append(labels, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(labels)
+
+	return strings.Join(labels, ",")
+}
+
+// KubeletTaints returns the taints of the pool in the format of the kubelet's
+// --register-with-taints flag. The nodes of Spot node pools are tainted with
+// LabelSpot unless the pool declares a taint with this key itself, so that
+// only workloads tolerating evictions get scheduled there.
+func (p NodePool) KubeletTaints() string {
+	var taints []string
+	var spotTainted bool
+	for _, t := range p.Taints {
+		taints = append(taints, t.ToString())
+		spotTainted = spotTainted || t.Key == LabelSpot
+	}
+
+	if p.IsSpot() && !spotTainted {
+		t := corev1.Taint{
+			Key:    LabelSpot,
+			Value:  LabelSpotValue,
+			Effect: corev1.TaintEffectNoSchedule,
+		}
+		taints = append(taints, t.ToString())
+	}
+
+	return strings.Join(taints, ",")
+}
+
+// NodePools returns the worker node pools of the given cluster. The default
+// node pool comes first, followed by the node pools declared with the
+// AnnotationNodePools annotation in the order of the declaration.
+func NodePools(customObject providerv1alpha1.AzureConfig) ([]NodePool, error) {
+	pools := []NodePool{
+		defaultNodePool(customObject),
+	}
+
+	v, ok := customObject.GetAnnotations()[AnnotationNodePools]
+	if !ok {
+		return pools, nil
+	}
+
+	var declared []NodePool
+	err := json.Unmarshal([]byte(v), &declared)
+	if err != nil {
+		return nil, microerror.Maskf(invalidNodePoolError, "annotation %#q must be a JSON list of node pools: %s", AnnotationNodePools, err)
+	}
+
+	// The VMSS of a node pool named after the cluster would be the VMSS of the
+	// default node pool.
+	names := map[string]bool{
+		DefaultNodePoolName:     true,
+		ClusterID(customObject): true,
+	}
+	for _, p := range declared {
+		err := validateNodePool(p)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		if names[p.Name] {
+			return nil, microerror.Maskf(invalidNodePoolError, "node pool name %#q is reserved or used more than once", p.Name)
+		}
+		names[p.Name] = true
+
+		pools = append(pools, p)
+	}
+
+	return pools, nil
+}
+
+// NodePoolByName returns the worker node pool of the given cluster with the
+// given name.
+func NodePoolByName(customObject providerv1alpha1.AzureConfig, name string) (NodePool, error) {
+	pools, err := NodePools(customObject)
+	if err != nil {
+		return NodePool{}, microerror.Mask(err)
+	}
+
+	for _, p := range pools {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+
+	return NodePool{}, microerror.Maskf(notFoundError, "node pool %#q", name)
+}
+
+// NodePoolAvailabilityZones returns the availability zones of the given node
+// pool. Node pools which do not declare zones use the zones of the cluster.
+func NodePoolAvailabilityZones(customObject providerv1alpha1.AzureConfig, pool NodePool, location string) []int {
+	for _, l := range LocationsThatDontSupportAZs {
+		if l == location {
+			return []int{}
+		}
+	}
+
+	if len(pool.Zones) > 0 {
+		return pool.Zones
+	}
+
+	return AvailabilityZones(customObject, location)
+}
+
+// NodePoolBlobName returns the name of the blob holding the worker cloudconfig
+// of the given node pool.
+func NodePoolBlobName(customObject providerv1alpha1.AzureConfig, pool string) string {
+	if pool == DefaultNodePoolName {
+		return BlobName(customObject, prefixWorker)
+	}
+
+	return BlobName(customObject, fmt.Sprintf("%s-%s", prefixWorker, pool))
+}
+
+// NodePoolInstanceName returns the name of the tenant cluster node of the
+// given instance of the VMSS of the given node pool.
+func NodePoolInstanceName(customObject providerv1alpha1.AzureConfig, pool string, instanceID string) string {
+	if pool == DefaultNodePoolName {
+		return WorkerInstanceName(customObject, instanceID)
+	}
+
+	idB36, err := vmssInstanceIDBase36(instanceID)
+	if err != nil {
+		panic(err)
+	}
+
+	return fmt.Sprintf("%s-%06s", NodePoolVMSSName(customObject, pool), idB36)
+}
+
+// NodePoolVMSSName returns the name of the VMSS of the given node pool.
+func NodePoolVMSSName(customObject providerv1alpha1.AzureConfig, pool string) string {
+	if pool == DefaultNodePoolName {
+		return WorkerVMSSName(customObject)
+	}
+
+	return fmt.Sprintf("%s-worker-%s", ClusterID(customObject), pool)
+}
+
+func defaultNodePool(customObject providerv1alpha1.AzureConfig) NodePool {
+	p := NodePool{
+		Name:  DefaultNodePoolName,
+		Count: WorkerCount(customObject),
+		Zones: customObject.Spec.Azure.AvailabilityZones,
+	}
+
+	if len(customObject.Spec.Azure.Workers) > 0 {
+		w := customObject.Spec.Azure.Workers[0]
+		p.VMSize = w.VMSize
+		p.DockerVolumeSizeGB = w.DockerVolumeSizeGB
+		p.KubeletVolumeSizeGB = w.KubeletVolumeSizeGB
+	}
+
+	return p
+}
+
+func validateNodePool(p NodePool) error {
+	if !nodePoolNameRegexp.MatchString(p.Name) {
+		return microerror.Maskf(invalidNodePoolError, "node pool name %#q must match %s", p.Name, nodePoolNameRegexp)
+	}
+	if p.VMSize == "" {
+		return microerror.Maskf(invalidNodePoolError, "node pool %#q must declare a VM size", p.Name)
+	}
+	if p.Count < 1 {
+		return microerror.Maskf(invalidNodePoolError, "node pool %#q must have at least one worker", p.Name)
+	}
+	if p.DockerVolumeSizeGB < 0 || p.KubeletVolumeSizeGB < 0 {
+		return microerror.Maskf(invalidNodePoolError, "node pool %#q must not declare negative volume sizes", p.Name)
+	}
+
+	for k, v := range p.Labels {
+		errs := append(validation.IsQualifiedName(k), validation.IsValidLabelValue(v)...)
+		if len(errs) > 0 {
+			return microerror.Maskf(invalidNodePoolError, "node pool %#q label %#q: %s", p.Name, k, strings.Join(errs, ", "))
+		}
+	}
+
+	for _, t := range p.Taints {
+		errs := validation.IsQualifiedName(t.Key)
+		if t.Value != "" {
+			errs = append(errs, validation.IsValidLabelValue(t.Value)...)
+		}
+		if len(errs) > 0 {
+			return microerror.Maskf(invalidNodePoolError, "node pool %#q taint %#q: %s", p.Name, t.Key, strings.Join(errs, ", "))
+		}
+
+		switch t.Effect {
+		case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		default:
+			return microerror.Maskf(invalidNodePoolError, "node pool %#q taint %#q has unknown effect %#q", p.Name, t.Key, t.Effect)
+		}
+	}
+
+	for _, z := range p.Zones {
+		if z < 1 || z > 3 {
+			return microerror.Maskf(invalidNodePoolError, "node pool %#q has invalid availability zone %d", p.Name, z)
+		}
+	}
+
+	if p.Spot != nil {
+		if p.Spot.MaxPrice < 0 && p.Spot.MaxPrice != SpotMaxPriceOnDemand {
+			return microerror.Maskf(invalidNodePoolError, "node pool %#q Spot max price must be positive or %d", p.Name, SpotMaxPriceOnDemand)
+		}
+
+		switch p.Spot.EvictionPolicy {
+		case "", SpotEvictionPolicyDeallocate, SpotEvictionPolicyDelete:
+		default:
+			return microerror.Maskf(invalidNodePoolError, "node pool %#q has unknown Spot eviction policy %#q", p.Name, p.Spot.EvictionPolicy)
+		}
+	}
+
+	return nil
+}
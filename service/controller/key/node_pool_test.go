@@ -0,0 +1,233 @@
+package key
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_NodePools(t *testing.T) {
+	testCases := []struct {
+		name          string
+		annotation    *string
+		expectedPools []NodePool
+		errorMatcher  func(err error) bool
+	}{
+		{
+			name: "case 0: default node pool only",
+			expectedPools: []NodePool{
+				{Name: DefaultNodePoolName, VMSize: "Standard_D4s_v3", DockerVolumeSizeGB: 50, Count: 2, Zones: []int{1}},
+			},
+		},
+		{
+			name:       "case 1: additional node pools",
+			annotation: to.StringPtr(`[{"name":"mem","vmSize":"Standard_E8s_v3","count":3,"labels":{"pool":"mem"},"taints":[{"key":"dedicated","value":"mem","effect":"NoSchedule"}],"zones":[2]},{"name":"gp","vmSize":"Standard_D8s_v3","count":1}]`),
+			expectedPools: []NodePool{
+				{Name: DefaultNodePoolName, VMSize: "Standard_D4s_v3", DockerVolumeSizeGB: 50, Count: 2, Zones: []int{1}},
+				{
+					Name:   "mem",
+					VMSize: "Standard_E8s_v3",
+					Count:  3,
+					Labels: map[string]string{"pool": "mem"},
+					Taints: []corev1.Taint{{Key: "dedicated", Value: "mem", Effect: corev1.TaintEffectNoSchedule}},
+					Zones:  []int{2},
+				},
+				{Name: "gp", VMSize: "Standard_D8s_v3", Count: 1},
+			},
+		},
+		{
+			name:         "case 2: malformed annotation",
+			annotation:   to.StringPtr(`{"name":"mem"}`),
+			errorMatcher: IsInvalidNodePool,
+		},
+		{
+			name:         "case 3: reserved node pool name",
+			annotation:   to.StringPtr(`[{"name":"default","vmSize":"Standard_E8s_v3","count":3}]`),
+			errorMatcher: IsInvalidNodePool,
+		},
+		{
+			name:         "case 4: node pool named after the cluster",
+			annotation:   to.StringPtr(`[{"name":"eggs2","vmSize":"Standard_E8s_v3","count":3}]`),
+			errorMatcher: IsInvalidNodePool,
+		},
+		{
+			name:         "case 5: duplicate node pool name",
+			annotation:   to.StringPtr(`[{"name":"mem","vmSize":"Standard_E8s_v3","count":3},{"name":"mem","vmSize":"Standard_E8s_v3","count":1}]`),
+			errorMatcher: IsInvalidNodePool,
+		},
+		{
+			name:         "case 6: invalid node pool name",
+			annotation:   to.StringPtr(`[{"name":"Mem_Pool","vmSize":"Standard_E8s_v3","count":3}]`),
+			errorMatcher: IsInvalidNodePool,
+		},
+		{
+			name:         "case 7: missing VM size",
+			annotation:   to.StringPtr(`[{"name":"mem","count":3}]`),
+			errorMatcher: IsInvalidNodePool,
+		},
+		{
+			name:         "case 8: no workers",
+			annotation:   to.StringPtr(`[{"name":"mem","vmSize":"Standard_E8s_v3","count":0}]`),
+			errorMatcher: IsInvalidNodePool,
+		},
+		{
+			name:         "case 9: invalid taint effect",
+			annotation:   to.StringPtr(`[{"name":"mem","vmSize":"Standard_E8s_v3","count":3,"taints":[{"key":"dedicated","effect":"Never"}]}]`),
+			errorMatcher: IsInvalidNodePool,
+		},
+		{
+			name:         "case 10: invalid label",
+			annotation:   to.StringPtr(`[{"name":"mem","vmSize":"Standard_E8s_v3","count":3,"labels":{"pool":"not valid"}}]`),
+			errorMatcher: IsInvalidNodePool,
+		},
+		{
+			name:         "case 11: invalid availability zone",
+			annotation:   to.StringPtr(`[{"name":"mem","vmSize":"Standard_E8s_v3","count":3,"zones":[4]}]`),
+			errorMatcher: IsInvalidNodePool,
+		},
+		{
+			name:       "case 12: Spot node pool",
+			annotation: to.StringPtr(`[{"name":"batch","vmSize":"Standard_D8s_v3","count":2,"spot":{"maxPrice":0.05,"evictionPolicy":"Deallocate"}}]`),
+			expectedPools: []NodePool{
+				{Name: DefaultNodePoolName, VMSize: "Standard_D4s_v3", DockerVolumeSizeGB: 50, Count: 2, Zones: []int{1}},
+				{Name: "batch", VMSize: "Standard_D8s_v3", Count: 2, Spot: &NodePoolSpot{MaxPrice: 0.05, EvictionPolicy: SpotEvictionPolicyDeallocate}},
+			},
+		},
+		{
+			name:         "case 13: invalid Spot max price",
+			annotation:   to.StringPtr(`[{"name":"batch","vmSize":"Standard_D8s_v3","count":2,"spot":{"maxPrice":-2}}]`),
+			errorMatcher: IsInvalidNodePool,
+		},
+		{
+			name:         "case 14: invalid Spot eviction policy",
+			annotation:   to.StringPtr(`[{"name":"batch","vmSize":"Standard_D8s_v3","count":2,"spot":{"evictionPolicy":"Stop"}}]`),
+			errorMatcher: IsInvalidNodePool,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			cr := providerv1alpha1.AzureConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{},
+				},
+				Spec: providerv1alpha1.AzureConfigSpec{
+					Azure: providerv1alpha1.AzureConfigSpecAzure{
+						AvailabilityZones: []int{1},
+						Workers: []providerv1alpha1.AzureConfigSpecAzureNode{
+							{VMSize: "Standard_D4s_v3", DockerVolumeSizeGB: 50},
+							{VMSize: "Standard_D4s_v3", DockerVolumeSizeGB: 50},
+						},
+					},
+					Cluster: providerv1alpha1.Cluster{
+						ID: "eggs2",
+					},
+				},
+			}
+			if tc.annotation != nil {
+				cr.Annotations[AnnotationNodePools] = *tc.annotation
+			}
+
+			pools, err := NodePools(cr)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if !cmp.Equal(pools, tc.expectedPools) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedPools, pools))
+			}
+		})
+	}
+}
+
+func Test_NodePoolNames(t *testing.T) {
+	customObject := providerv1alpha1.AzureConfig{
+		Spec: providerv1alpha1.AzureConfigSpec{
+			Cluster: providerv1alpha1.Cluster{
+				ID: "eggs2",
+			},
+		},
+	}
+
+	if NodePoolVMSSName(customObject, DefaultNodePoolName) != WorkerVMSSName(customObject) {
+		t.Fatalf("Expected %s but was %s", WorkerVMSSName(customObject), NodePoolVMSSName(customObject, DefaultNodePoolName))
+	}
+	if NodePoolVMSSName(customObject, "mem") != "eggs2-worker-mem" {
+		t.Fatalf("Expected %s but was %s", "eggs2-worker-mem", NodePoolVMSSName(customObject, "mem"))
+	}
+	if NodePoolInstanceName(customObject, DefaultNodePoolName, "36") != WorkerInstanceName(customObject, "36") {
+		t.Fatalf("Expected %s but was %s", WorkerInstanceName(customObject, "36"), NodePoolInstanceName(customObject, DefaultNodePoolName, "36"))
+	}
+	if NodePoolInstanceName(customObject, "mem", "36") != "eggs2-worker-mem-000010" {
+		t.Fatalf("Expected %s but was %s", "eggs2-worker-mem-000010", NodePoolInstanceName(customObject, "mem", "36"))
+	}
+}
+
+func Test_NodePoolKubeletFlags(t *testing.T) {
+	p := NodePool{
+		Name:   "mem",
+		Labels: map[string]string{"pool": "mem", "disk": "ssd"},
+		Taints: []corev1.Taint{
+			{Key: "dedicated", Value: "mem", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "spot", Effect: corev1.TaintEffectPreferNoSchedule},
+		},
+	}
+
+	expectedLabels := "azure-operator.giantswarm.io/node-pool=mem,disk=ssd,pool=mem"
+	if p.KubeletLabels() != expectedLabels {
+		t.Fatalf("Expected %s but was %s", expectedLabels, p.KubeletLabels())
+	}
+
+	expectedTaints := "dedicated=mem:NoSchedule,spot:PreferNoSchedule"
+	if p.KubeletTaints() != expectedTaints {
+		t.Fatalf("Expected %s but was %s", expectedTaints, p.KubeletTaints())
+	}
+
+	s := NodePool{
+		Name: "batch",
+		Spot: &NodePoolSpot{},
+	}
+
+	expectedLabels = "azure-operator.giantswarm.io/node-pool=batch,kubernetes.azure.com/scalesetpriority=spot"
+	if s.KubeletLabels() != expectedLabels {
+		t.Fatalf("Expected %s but was %s", expectedLabels, s.KubeletLabels())
+	}
+
+	expectedTaints = "kubernetes.azure.com/scalesetpriority=spot:NoSchedule"
+	if s.KubeletTaints() != expectedTaints {
+		t.Fatalf("Expected %s but was %s", expectedTaints, s.KubeletTaints())
+	}
+
+	s.Taints = []corev1.Taint{{Key: LabelSpot, Value: LabelSpotValue, Effect: corev1.TaintEffectPreferNoSchedule}}
+	expectedTaints = "kubernetes.azure.com/scalesetpriority=spot:PreferNoSchedule"
+	if s.KubeletTaints() != expectedTaints {
+		t.Fatalf("Expected %s but was %s", expectedTaints, s.KubeletTaints())
+	}
+
+	if s.SpotEvictionPolicy() != SpotEvictionPolicyDelete {
+		t.Fatalf("Expected %s but was %s", SpotEvictionPolicyDelete, s.SpotEvictionPolicy())
+	}
+	if s.SpotMaxPrice() != SpotMaxPriceOnDemand {
+		t.Fatalf("Expected %d but was %f", SpotMaxPriceOnDemand, s.SpotMaxPrice())
+	}
+
+	d := NodePool{Name: DefaultNodePoolName}
+	if d.KubeletLabels() != "" {
+		t.Fatalf("Expected no labels but was %s", d.KubeletLabels())
+	}
+}
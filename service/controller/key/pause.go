@@ -0,0 +1,51 @@
+package key
+
+import (
+	"strconv"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+)
+
+const (
+	// AnnotationPaused pauses the reconciliation of a cluster when set to
+	// "true". The cluster's status is still updated, but none of its resources
+	// get created, updated or deleted.
+	AnnotationPaused = "azure-operator.giantswarm.io/paused"
+	// AnnotationPausedUntil optionally limits a pause to the given RFC 3339
+	// timestamp, so that a forgotten pause resumes on its own.
+	AnnotationPausedUntil = "azure-operator.giantswarm.io/paused-until"
+)
+
+// IsPaused returns true when the reconciliation of the given cluster is paused
+// at the given time. Pauses with a malformed expiry do not expire, so that a
+// typo never resumes the reconciliation of a cluster under investigation.
+func IsPaused(customObject providerv1alpha1.AzureConfig, now time.Time) bool {
+	paused, _ := strconv.ParseBool(customObject.GetAnnotations()[AnnotationPaused])
+	if !paused {
+		return false
+	}
+
+	until, ok := PausedUntil(customObject)
+	if !ok {
+		return true
+	}
+
+	return now.Before(until)
+}
+
+// PausedUntil returns the expiry of the pause of the given cluster. False is
+// returned when the pause does not expire.
+func PausedUntil(customObject providerv1alpha1.AzureConfig) (time.Time, bool) {
+	v, ok := customObject.GetAnnotations()[AnnotationPausedUntil]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	until, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return until, true
+}
@@ -0,0 +1,72 @@
+package key
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_IsPaused(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		annotations    map[string]string
+		expectedResult bool
+	}{
+		{
+			name:           "case 0: annotations not set",
+			expectedResult: false,
+		},
+		{
+			name:           "case 1: paused",
+			annotations:    map[string]string{AnnotationPaused: "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "case 2: explicitly not paused",
+			annotations:    map[string]string{AnnotationPaused: "false"},
+			expectedResult: false,
+		},
+		{
+			name:           "case 3: paused until later",
+			annotations:    map[string]string{AnnotationPaused: "true", AnnotationPausedUntil: "2020-06-01T13:00:00Z"},
+			expectedResult: true,
+		},
+		{
+			name:           "case 4: pause expired",
+			annotations:    map[string]string{AnnotationPaused: "true", AnnotationPausedUntil: "2020-06-01T11:00:00Z"},
+			expectedResult: false,
+		},
+		{
+			name:           "case 5: malformed expiry does not expire",
+			annotations:    map[string]string{AnnotationPaused: "true", AnnotationPausedUntil: "tomorrow"},
+			expectedResult: true,
+		},
+		{
+			name:           "case 6: expiry without pause",
+			annotations:    map[string]string{AnnotationPausedUntil: "2020-06-01T13:00:00Z"},
+			expectedResult: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			customObject := providerv1alpha1.AzureConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+
+			result := IsPaused(customObject, now)
+			if result != tc.expectedResult {
+				t.Fatalf("result == %t, want %t", result, tc.expectedResult)
+			}
+		})
+	}
+}
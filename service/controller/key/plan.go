@@ -0,0 +1,50 @@
+package key
+
+import (
+	"strconv"
+	"strings"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+)
+
+const (
+	// AnnotationDryRun overrides the operator wide dry-run setting of a
+	// cluster when set to "true" or "false". ARM templates of clusters in
+	// dry-run mode are only deployed once the changes they make got approved.
+	AnnotationDryRun = "azure-operator.giantswarm.io/dry-run"
+	// AnnotationApprovedPlans holds the comma separated IDs of the deployment
+	// plans of a cluster in dry-run mode which may be applied.
+	AnnotationApprovedPlans = "azure-operator.giantswarm.io/approved-plans"
+)
+
+// IsDryRun returns true when the given cluster is in dry-run mode. Clusters
+// without a valid AnnotationDryRun annotation use the given default.
+func IsDryRun(customObject providerv1alpha1.AzureConfig, defaultValue bool) bool {
+	v, ok := customObject.GetAnnotations()[AnnotationDryRun]
+	if !ok {
+		return defaultValue
+	}
+
+	dryRun, err := strconv.ParseBool(v)
+	if err != nil {
+		return defaultValue
+	}
+
+	return dryRun
+}
+
+// IsPlanApproved returns true when the deployment plan with the given ID is
+// listed in the AnnotationApprovedPlans annotation of the given cluster.
+func IsPlanApproved(customObject providerv1alpha1.AzureConfig, id string) bool {
+	if id == "" {
+		return false
+	}
+
+	for _, approved := range strings.Split(customObject.GetAnnotations()[AnnotationApprovedPlans], ",") {
+		if strings.TrimSpace(approved) == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,95 @@
+package key
+
+import (
+	"encoding/json"
+	"regexp"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+)
+
+const (
+	// AnnotationRemoteCommand requests a script to be run on the instances of
+	// a cluster as a JSON RemoteCommand object. Each request runs once. Its
+	// results are stored in the config map named by
+	// RemoteCommandResultsConfigMapName.
+	AnnotationRemoteCommand = "azure-operator.giantswarm.io/remote-command"
+
+	// RemoteCommandRoleMaster selects the master instances.
+	RemoteCommandRoleMaster = "master"
+	// RemoteCommandRoleWorker selects the worker instances of a node pool.
+	RemoteCommandRoleWorker = "worker"
+)
+
+var remoteCommandIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// RemoteCommand is a script requested to be run on the instances of a cluster
+// with the AnnotationRemoteCommand annotation.
+type RemoteCommand struct {
+	// ID identifies the request. A request is run again when its ID changes.
+	ID      string `json:"id"`
+	Script  string `json:"script"`
+	Version string `json:"version"`
+	// Role is either RemoteCommandRoleMaster or RemoteCommandRoleWorker.
+	Role string `json:"role"`
+	// NodePool is the node pool of the worker instances. Empty defaults to
+	// DefaultNodePoolName.
+	NodePool string `json:"nodePool,omitempty"`
+	// Instances are the IDs of the instances the script is run on. Empty
+	// selects all instances of the role.
+	Instances []string `json:"instances,omitempty"`
+}
+
+// VMSSName returns the name of the VMSS of the instances selected by the
+// request.
+func (c RemoteCommand) VMSSName(customObject providerv1alpha1.AzureConfig) string {
+	if c.Role == RemoteCommandRoleMaster {
+		return MasterVMSSName(customObject)
+	}
+
+	return NodePoolVMSSName(customObject, c.NodePoolName())
+}
+
+// NodePoolName returns the name of the node pool of the worker instances
+// selected by the request.
+func (c RemoteCommand) NodePoolName() string {
+	if c.NodePool == "" {
+		return DefaultNodePoolName
+	}
+
+	return c.NodePool
+}
+
+// RemoteCommandRequest returns the script requested with the
+// AnnotationRemoteCommand annotation of the given cluster, or nil when the
+// annotation is not set.
+func RemoteCommandRequest(customObject providerv1alpha1.AzureConfig) (*RemoteCommand, error) {
+	v, ok := customObject.GetAnnotations()[AnnotationRemoteCommand]
+	if !ok {
+		return nil, nil
+	}
+
+	var c RemoteCommand
+	err := json.Unmarshal([]byte(v), &c)
+	if err != nil {
+		return nil, microerror.Maskf(invalidAnnotationError, "annotation %#q must be a JSON remote command: %s", AnnotationRemoteCommand, err)
+	}
+
+	if !remoteCommandIDRegexp.MatchString(c.ID) {
+		return nil, microerror.Maskf(invalidAnnotationError, "ID of annotation %#q must only contain alphanumeric characters, '-' and '_'", AnnotationRemoteCommand)
+	}
+	if c.Script == "" || c.Version == "" {
+		return nil, microerror.Maskf(invalidAnnotationError, "script and version of annotation %#q must not be empty", AnnotationRemoteCommand)
+	}
+	switch c.Role {
+	case RemoteCommandRoleMaster:
+		if c.NodePool != "" {
+			return nil, microerror.Maskf(invalidAnnotationError, "node pool of annotation %#q must be empty for role %#q", AnnotationRemoteCommand, c.Role)
+		}
+	case RemoteCommandRoleWorker:
+	default:
+		return nil, microerror.Maskf(invalidAnnotationError, "role of annotation %#q must be %#q or %#q", AnnotationRemoteCommand, RemoteCommandRoleMaster, RemoteCommandRoleWorker)
+	}
+
+	return &c, nil
+}
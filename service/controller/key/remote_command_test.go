@@ -0,0 +1,99 @@
+package key
+
+import (
+	"strconv"
+	"testing"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_RemoteCommandRequest(t *testing.T) {
+	testCases := []struct {
+		name           string
+		annotations    map[string]string
+		expectedResult *RemoteCommand
+		errorMatcher   func(err error) bool
+	}{
+		{
+			name: "case 0: annotation not set",
+		},
+		{
+			name:        "case 1: all masters",
+			annotations: map[string]string{AnnotationRemoteCommand: `{"id":"inc-42","script":"node-diagnostics","version":"1","role":"master"}`},
+			expectedResult: &RemoteCommand{
+				ID:      "inc-42",
+				Script:  "node-diagnostics",
+				Version: "1",
+				Role:    RemoteCommandRoleMaster,
+			},
+		},
+		{
+			name:        "case 2: selected workers of a node pool",
+			annotations: map[string]string{AnnotationRemoteCommand: `{"id":"inc-43","script":"restart-kubelet","version":"1","role":"worker","nodePool":"gpu","instances":["3","5"]}`},
+			expectedResult: &RemoteCommand{
+				ID:        "inc-43",
+				Script:    "restart-kubelet",
+				Version:   "1",
+				Role:      RemoteCommandRoleWorker,
+				NodePool:  "gpu",
+				Instances: []string{"3", "5"},
+			},
+		},
+		{
+			name:         "case 3: invalid JSON",
+			annotations:  map[string]string{AnnotationRemoteCommand: `restart-kubelet`},
+			errorMatcher: IsInvalidAnnotation,
+		},
+		{
+			name:         "case 4: invalid ID",
+			annotations:  map[string]string{AnnotationRemoteCommand: `{"id":"inc 42","script":"node-diagnostics","version":"1","role":"master"}`},
+			errorMatcher: IsInvalidAnnotation,
+		},
+		{
+			name:         "case 5: missing version",
+			annotations:  map[string]string{AnnotationRemoteCommand: `{"id":"inc-42","script":"node-diagnostics","role":"master"}`},
+			errorMatcher: IsInvalidAnnotation,
+		},
+		{
+			name:         "case 6: unknown role",
+			annotations:  map[string]string{AnnotationRemoteCommand: `{"id":"inc-42","script":"node-diagnostics","version":"1","role":"bastion"}`},
+			errorMatcher: IsInvalidAnnotation,
+		},
+		{
+			name:         "case 7: node pool of masters",
+			annotations:  map[string]string{AnnotationRemoteCommand: `{"id":"inc-42","script":"node-diagnostics","version":"1","role":"master","nodePool":"gpu"}`},
+			errorMatcher: IsInvalidAnnotation,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			customObject := providerv1alpha1.AzureConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+
+			result, err := RemoteCommandRequest(customObject)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if !cmp.Equal(result, tc.expectedResult) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedResult, result))
+			}
+		})
+	}
+}
@@ -85,19 +85,31 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) (interf
 	}
 
 	{
-		b, err := cc.CloudConfig.NewWorkerTemplate(ctx, ignitionTemplateData, encrypter)
+		pools, err := key.NodePools(cr)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
-		k := key.BlobName(cr, prefixWorker)
-		containerObjectState := ContainerObjectState{
-			Body:               b,
-			ContainerName:      containerName,
-			Key:                k,
-			StorageAccountName: storageAccountName,
-		}
 
-		output = append(output, containerObjectState)
+		// Every worker node pool has its own cloudconfig, because the labels and
+		// taints of the nodes differ between the pools.
+		for _, p := range pools {
+			data := ignitionTemplateData
+			data.NodePool = p
+
+			b, err := cc.CloudConfig.NewWorkerTemplate(ctx, data, encrypter)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+			k := key.NodePoolBlobName(cr, p.Name)
+			containerObjectState := ContainerObjectState{
+				Body:               b,
+				ContainerName:      containerName,
+				Key:                k,
+				StorageAccountName: storageAccountName,
+			}
+
+			output = append(output, containerObjectState)
+		}
 	}
 
 	return output, nil
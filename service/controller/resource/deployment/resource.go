@@ -11,10 +11,15 @@ import (
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/giantswarm/operatorkit/controller/context/reconciliationcanceledcontext"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
 	"github.com/giantswarm/azure-operator/v4/service/controller/debugger"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/planner"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/quota"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
 )
@@ -31,42 +36,63 @@ const (
 )
 
 type Config struct {
-	Debugger  *debugger.Debugger
-	G8sClient versioned.Interface
-	Logger    micrologger.Logger
+	Debugger      *debugger.Debugger
+	EventRecorder record.EventRecorder
+	G8sClient     versioned.Interface
+	Logger        micrologger.Logger
 
 	Azure setting.Azure
+	// Planner approves the deployment of the ARM template.
+	Planner planner.Interface
+	// QuotaChecker blocks the creation of clusters exceeding the compute
+	// quota of the subscription.
+	QuotaChecker quota.Interface
 }
 
 type Resource struct {
-	debugger  *debugger.Debugger
-	g8sClient versioned.Interface
-	logger    micrologger.Logger
-
-	azure setting.Azure
+	debugger      *debugger.Debugger
+	eventRecorder record.EventRecorder
+	g8sClient     versioned.Interface
+	logger        micrologger.Logger
+
+	azure        setting.Azure
+	planner      planner.Interface
+	quotaChecker quota.Interface
 }
 
 func New(config Config) (*Resource, error) {
 	if config.Debugger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Debugger must not be empty", config)
 	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.G8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.G8sClient must not be empty", config)
 	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if config.Planner == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Planner must not be empty", config)
+	}
+	if config.QuotaChecker == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.QuotaChecker must not be empty", config)
+	}
 
 	if err := config.Azure.Validate(); err != nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Azure.%s", config, err)
 	}
 
 	r := &Resource{
-		debugger:  config.Debugger,
-		g8sClient: config.G8sClient,
-		logger:    config.Logger,
+		debugger:      config.Debugger,
+		eventRecorder: config.EventRecorder,
+		g8sClient:     config.G8sClient,
+		logger:        config.Logger,
 
-		azure: config.Azure,
+		azure:        config.Azure,
+		planner:      config.Planner,
+		quotaChecker: config.QuotaChecker,
 	}
 
 	return r, nil
@@ -89,6 +115,18 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 
 	d, err := deploymentsClient.Get(ctx, key.ClusterID(cr), mainDeploymentName)
 	if IsNotFound(err) {
+		sufficient, err := r.checkClusterQuota(ctx, cr)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if !sufficient {
+			r.logger.LogCtx(ctx, "level", "debug", "message", "insufficient quota for creating the cluster")
+			r.logger.LogCtx(ctx, "level", "debug", "message", "did not ensure deployment")
+			reconciliationcanceledcontext.SetCanceled(ctx)
+			r.logger.LogCtx(ctx, "level", "debug", "message", "canceling reconciliation")
+			return nil
+		}
+
 		params := map[string]interface{}{
 			"initialProvisioning": "Yes",
 		}
@@ -100,11 +138,12 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	} else {
 		s := *d.Properties.ProvisioningState
+		r.debugger.ReportDeployment(key.ClusterID(cr), d)
 
 		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deployment is in state '%s'", s))
 
 		if !key.IsSucceededProvisioningState(s) {
-			r.debugger.LogFailedDeployment(ctx, d, err)
+			r.debugger.LogFailedDeployment(ctx, &cr, d, err)
 		}
 		if !key.IsFinalProvisioningState(s) {
 			reconciliationcanceledcontext.SetCanceled(ctx)
@@ -154,6 +193,16 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 
 	r.logger.LogCtx(ctx, "level", "debug", "message", "template or parameters changed")
 
+	approved, err := r.planner.Approved(ctx, cr, mainDeploymentName, deployment)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	if !approved {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "deployment awaits approval")
+		r.logger.LogCtx(ctx, "level", "debug", "message", "did not ensure deployment")
+		return nil
+	}
+
 	res, err := deploymentsClient.CreateOrUpdate(ctx, key.ClusterID(cr), mainDeploymentName, deployment)
 	if err != nil {
 		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deployment failed; deployment: %#v", deployment), "stack", microerror.JSON(microerror.Mask(err)))
@@ -169,6 +218,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 	}
 
 	r.logger.LogCtx(ctx, "level", "debug", "message", "ensured deployment")
+	r.eventRecorder.Eventf(&cr, corev1.EventTypeNormal, recorder.ReasonDeploymentUpdated, "deployment %s updated", mainDeploymentName)
 
 	if desiredDeploymentTemplateChk != "" {
 		err = r.setResourceStatus(cr, DeploymentTemplateChecksum, desiredDeploymentTemplateChk)
@@ -209,6 +259,22 @@ func (r *Resource) Name() string {
 	return Name
 }
 
+// checkClusterQuota returns whether the compute quota of the subscription
+// covers all the instances of the given cluster.
+func (r *Resource) checkClusterQuota(ctx context.Context, customObject providerv1alpha1.AzureConfig) (bool, error) {
+	demands, err := quota.ClusterDemands(customObject)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	sufficient, err := r.quotaChecker.Sufficient(ctx, customObject, r.azure.Location, "cluster creation", demands)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	return sufficient, nil
+}
+
 func (r *Resource) enrichControllerContext(ctx context.Context, customObject providerv1alpha1.AzureConfig) error {
 	cc, err := controllercontext.FromContext(ctx)
 	if err != nil {
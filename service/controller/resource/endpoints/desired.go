@@ -2,12 +2,15 @@ package endpoints
 
 import (
 	"context"
+	"fmt"
 
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/microerror"
 	v1 "k8s.io/api/core/v1"
 	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/giantswarm/azure-operator/v4/pkg/project"
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
 
@@ -17,7 +20,12 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) (interf
 		return nil, microerror.Mask(err)
 	}
 
-	masterNICPrivateIPs, err := r.getMasterNICPrivateIPs(ctx, key.ClusterID(cr), key.MasterVMSSName(cr))
+	masterNICs, err := r.getMasterNICs(ctx, key.ClusterID(cr), key.MasterVMSSName(cr))
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	masterNICPrivateIPs, err := r.getHealthyMasterPrivateIPs(ctx, cr, masterNICs)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
@@ -54,3 +62,66 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) (interf
 
 	return endpoints, nil
 }
+
+// getHealthyMasterPrivateIPs returns the private IPs of the masters whose node
+// is Ready in the tenant cluster. The IPs of all masters are returned while
+// the tenant cluster is not reachable or no master is Ready, e.g. during
+// cluster creation, so that the endpoints never run empty.
+func (r *Resource) getHealthyMasterPrivateIPs(ctx context.Context, cr providerv1alpha1.AzureConfig, nics []masterNIC) ([]string, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	readyNodes := map[string]bool{}
+	if cc.Client.TenantCluster.K8s != nil {
+		nodeList, err := cc.Client.TenantCluster.K8s.CoreV1().Nodes().List(apismetav1.ListOptions{})
+		if err != nil {
+			r.logger.LogCtx(ctx, "level", "debug", "message", "failed to list tenant cluster nodes", "stack", fmt.Sprintf("%#v", err))
+		} else {
+			for _, n := range nodeList.Items {
+				readyNodes[n.GetName()] = isReady(n)
+			}
+		}
+	}
+
+	ips := filterHealthyMasterIPs(nics, func(instanceID string) bool {
+		return instanceID != "" && readyNodes[key.MasterInstanceName(cr, instanceID)]
+	})
+
+	if len(ips) < len(nics) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("found %d of %d masters healthy", len(ips), len(nics)))
+	}
+
+	return ips, nil
+}
+
+// filterHealthyMasterIPs returns the private IPs of the given network
+// interfaces whose instance is healthy, or all of them when none is.
+func filterHealthyMasterIPs(nics []masterNIC, isHealthy func(instanceID string) bool) []string {
+	var all []string
+	var healthy []string
+	for _, nic := range nics {
+		all = append(all, nic.PrivateIP)
+
+		if isHealthy(nic.InstanceID) {
+			healthy = append(healthy, nic.PrivateIP)
+		}
+	}
+
+	if len(healthy) == 0 {
+		return all
+	}
+
+	return healthy
+}
+
+func isReady(n v1.Node) bool {
+	for _, c := range n.Status.Conditions {
+		if c.Type == v1.NodeReady && c.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,48 @@
+package endpoints
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_Resource_Endpoints_filterHealthyMasterIPs(t *testing.T) {
+	t.Parallel()
+	nics := []masterNIC{
+		{InstanceID: "0", PrivateIP: "10.0.0.4"},
+		{InstanceID: "1", PrivateIP: "10.0.0.5"},
+		{InstanceID: "2", PrivateIP: "10.0.0.6"},
+	}
+
+	testCases := []struct {
+		description string
+		healthy     map[string]bool
+		expectedIPs []string
+	}{
+		{
+			description: "all masters healthy, return all IPs",
+			healthy:     map[string]bool{"0": true, "1": true, "2": true},
+			expectedIPs: []string{"10.0.0.4", "10.0.0.5", "10.0.0.6"},
+		},
+		{
+			description: "one master unhealthy, return IPs of healthy masters",
+			healthy:     map[string]bool{"0": true, "2": true},
+			expectedIPs: []string{"10.0.0.4", "10.0.0.6"},
+		},
+		{
+			description: "no master healthy, return all IPs",
+			healthy:     map[string]bool{},
+			expectedIPs: []string{"10.0.0.4", "10.0.0.5", "10.0.0.6"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ips := filterHealthyMasterIPs(nics, func(instanceID string) bool {
+				return tc.healthy[instanceID]
+			})
+			if !reflect.DeepEqual(tc.expectedIPs, ips) {
+				t.Errorf("expected '%v' got '%v'", tc.expectedIPs, ips)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package endpoints
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
 	"github.com/giantswarm/microerror"
@@ -9,8 +10,14 @@ import (
 	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
 )
 
-func (r *Resource) getMasterNICPrivateIPs(ctx context.Context, resourceGroupName, virtualMachineScaleSetName string) ([]string, error) {
-	var ips []string
+// masterNIC is the network interface of a master instance.
+type masterNIC struct {
+	InstanceID string
+	PrivateIP  string
+}
+
+func (r *Resource) getMasterNICs(ctx context.Context, resourceGroupName, virtualMachineScaleSetName string) ([]masterNIC, error) {
+	var nics []masterNIC
 
 	interfacesClient, err := r.getInterfacesClient(ctx)
 	if err != nil {
@@ -40,7 +47,16 @@ func (r *Resource) getMasterNICPrivateIPs(ctx context.Context, resourceGroupName
 				return nil, microerror.Mask(privateIPAddressEmptyError)
 			}
 
-			ips = append(ips, privateIP)
+			var instanceID string
+			if networkInterface.VirtualMachine != nil && networkInterface.VirtualMachine.ID != nil {
+				id := *networkInterface.VirtualMachine.ID
+				instanceID = id[strings.LastIndex(id, "/")+1:]
+			}
+
+			nics = append(nics, masterNIC{
+				InstanceID: instanceID,
+				PrivateIP:  privateIP,
+			})
 		}
 
 		err := result.Next()
@@ -49,7 +65,7 @@ func (r *Resource) getMasterNICPrivateIPs(ctx context.Context, resourceGroupName
 		}
 	}
 
-	return ips, nil
+	return nics, nil
 }
 
 func (r *Resource) getInterfacesClient(ctx context.Context) (*network.InterfacesClient, error) {
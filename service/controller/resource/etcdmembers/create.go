@@ -0,0 +1,229 @@
+package etcdmembers
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/certs"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// EnsureCreated points the DNS records of the etcd members to the private IPs
+// of the master instances. Records keep pointing to the same master as long as
+// it exists, so that members keep their identity across reconciliation loops.
+// Members verify each other against the etcd certificate of the cluster, which
+// is issued outside of the operator. Records are only created once it covers
+// the member domains, so masters wait for their member record instead of
+// forming a cluster whose peers reject each other.
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if !key.IsHighlyAvailable(cr) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "control plane is not highly available")
+		return nil
+	}
+
+	{
+		tlsCerts, err := r.certsSearcher.SearchTLS(key.ClusterID(cr), certs.EtcdCert)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		var domains []string
+		for i := 0; i < key.MasterCount(cr); i++ {
+			domains = append(domains, key.EtcdMemberDomain(cr, i))
+		}
+
+		uncovered, err := uncoveredDomains(tlsCerts.Crt, domains)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if len(uncovered) > 0 {
+			r.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("etcd certificate does not cover the etcd member domains %s", strings.Join(uncovered, ", ")))
+			r.logger.LogCtx(ctx, "level", "debug", "message", "canceling resource")
+			return nil
+		}
+	}
+
+	ips, err := r.getMasterPrivateIPs(ctx, cr)
+	if IsNotFound(err) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "did not find the master network interfaces")
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	recordSetsClient, err := r.getRecordSetsClient(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	zone := key.ClusterDNSDomain(cr)
+
+	var current []string
+	for i := 0; i < key.MasterCount(cr); i++ {
+		name := key.EtcdMemberName(i)
+
+		record, err := recordSetsClient.Get(ctx, key.ResourceGroupName(cr), zone, name, dns.A)
+		if IsNotFound(err) {
+			current = append(current, "")
+			continue
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		var ip string
+		if record.RecordSetProperties != nil && record.ARecords != nil && len(*record.ARecords) > 0 {
+			ip = to.String((*record.ARecords)[0].Ipv4Address)
+		}
+		current = append(current, ip)
+	}
+
+	desired := assignMembers(current, ips)
+
+	for i := range desired {
+		if desired[i] == current[i] {
+			continue
+		}
+
+		name := key.EtcdMemberName(i)
+
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("pointing etcd member DNS record %#q to %s", name, desired[i]))
+
+		params := dns.RecordSet{
+			RecordSetProperties: &dns.RecordSetProperties{
+				TTL: to.Int64Ptr(recordTTL),
+				ARecords: &[]dns.ARecord{
+					{Ipv4Address: to.StringPtr(desired[i])},
+				},
+			},
+		}
+
+		_, err = recordSetsClient.CreateOrUpdate(ctx, key.ResourceGroupName(cr), zone, name, dns.A, params, "", "")
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("pointed etcd member DNS record %#q to %s", name, desired[i]))
+	}
+
+	return nil
+}
+
+func (r *Resource) getMasterPrivateIPs(ctx context.Context, cr providerv1alpha1.AzureConfig) ([]string, error) {
+	interfacesClient, err := r.getInterfacesClient(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	result, err := interfacesClient.ListVirtualMachineScaleSetNetworkInterfaces(ctx, key.ResourceGroupName(cr), key.MasterVMSSName(cr))
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var ips []string
+	for result.NotDone() {
+		for _, nic := range result.Values() {
+			if nic.IPConfigurations == nil {
+				continue
+			}
+
+			for _, c := range *nic.IPConfigurations {
+				if c.InterfaceIPConfigurationPropertiesFormat == nil || to.String(c.PrivateIPAddress) == "" {
+					continue
+				}
+
+				ips = append(ips, to.String(c.PrivateIPAddress))
+			}
+		}
+
+		err := result.Next()
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return ips, nil
+}
+
+// assignMembers returns the IPs the member DNS records should point to, given
+// the IPs they currently point to and the IPs of the master instances. Records
+// pointing to masters are kept. Records which are missing or point to masters
+// which are gone get the remaining masters assigned, in lexical order of their
+// IPs. Records without a master to point to keep their IP.
+func assignMembers(current []string, ips []string) []string {
+	assigned := map[string]bool{}
+	for _, ip := range ips {
+		assigned[ip] = false
+	}
+
+	desired := make([]string, len(current))
+	for i, ip := range current {
+		if _, ok := assigned[ip]; ok && !assigned[ip] {
+			desired[i] = ip
+			assigned[ip] = true
+		}
+	}
+
+	var remaining []string
+	for _, ip := range ips {
+		if !assigned[ip] {
+			remaining = append(remaining, ip)
+			assigned[ip] = true
+		}
+	}
+	sort.Strings(remaining)
+
+	for i := range desired {
+		if desired[i] != "" {
+			continue
+		}
+
+		if len(remaining) == 0 {
+			desired[i] = current[i]
+			continue
+		}
+
+		desired[i] = remaining[0]
+		remaining = remaining[1:]
+	}
+
+	return desired
+}
+
+// uncoveredDomains returns the given domains the given PEM encoded certificate
+// is not valid for.
+func uncoveredDomains(crt []byte, domains []string) ([]string, error) {
+	block, _ := pem.Decode(crt)
+	if block == nil {
+		return nil, microerror.Maskf(executionFailedError, "etcd certificate is not valid PEM")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var uncovered []string
+	for _, d := range domains {
+		err := certificate.VerifyHostname(d)
+		if err != nil {
+			uncovered = append(uncovered, d)
+		}
+	}
+
+	return uncovered, nil
+}
@@ -0,0 +1,164 @@
+package etcdmembers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_assignMembers(t *testing.T) {
+	testCases := []struct {
+		name            string
+		current         []string
+		ips             []string
+		expectedDesired []string
+	}{
+		{
+			name:            "case 0: new cluster",
+			current:         []string{"", "", ""},
+			ips:             []string{"10.0.0.6", "10.0.0.4", "10.0.0.5"},
+			expectedDesired: []string{"10.0.0.4", "10.0.0.5", "10.0.0.6"},
+		},
+		{
+			name:            "case 1: records up to date",
+			current:         []string{"10.0.0.6", "10.0.0.4", "10.0.0.5"},
+			ips:             []string{"10.0.0.4", "10.0.0.5", "10.0.0.6"},
+			expectedDesired: []string{"10.0.0.6", "10.0.0.4", "10.0.0.5"},
+		},
+		{
+			name:            "case 2: master got replaced",
+			current:         []string{"10.0.0.4", "10.0.0.5", "10.0.0.6"},
+			ips:             []string{"10.0.0.4", "10.0.0.7", "10.0.0.6"},
+			expectedDesired: []string{"10.0.0.4", "10.0.0.7", "10.0.0.6"},
+		},
+		{
+			name:            "case 3: master is gone without replacement yet",
+			current:         []string{"10.0.0.4", "10.0.0.5", "10.0.0.6"},
+			ips:             []string{"10.0.0.4", "10.0.0.6"},
+			expectedDesired: []string{"10.0.0.4", "10.0.0.5", "10.0.0.6"},
+		},
+		{
+			name:            "case 4: masters are not created yet",
+			current:         []string{"", "", ""},
+			ips:             []string{"10.0.0.4"},
+			expectedDesired: []string{"10.0.0.4", "", ""},
+		},
+		{
+			name:            "case 5: more masters than members",
+			current:         []string{"10.0.0.4", "", "10.0.0.6"},
+			ips:             []string{"10.0.0.4", "10.0.0.6", "10.0.0.8", "10.0.0.7"},
+			expectedDesired: []string{"10.0.0.4", "10.0.0.7", "10.0.0.6"},
+		},
+		{
+			name:            "case 6: records pointing to the same master",
+			current:         []string{"10.0.0.4", "10.0.0.4", "10.0.0.6"},
+			ips:             []string{"10.0.0.4", "10.0.0.5", "10.0.0.6"},
+			expectedDesired: []string{"10.0.0.4", "10.0.0.5", "10.0.0.6"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			desired := assignMembers(tc.current, tc.ips)
+
+			if !cmp.Equal(desired, tc.expectedDesired) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedDesired, desired))
+			}
+		})
+	}
+}
+
+func Test_uncoveredDomains(t *testing.T) {
+	testCases := []struct {
+		name              string
+		crt               []byte
+		domains           []string
+		expectedUncovered []string
+		errorMatcher      func(error) bool
+	}{
+		{
+			name:    "case 0: certificate covers the member domains",
+			crt:     newTestCertificate(t, "etcd.a1b2c.k8s.example.com", "etcd1.a1b2c.k8s.example.com", "etcd2.a1b2c.k8s.example.com", "etcd3.a1b2c.k8s.example.com"),
+			domains: []string{"etcd1.a1b2c.k8s.example.com", "etcd2.a1b2c.k8s.example.com", "etcd3.a1b2c.k8s.example.com"},
+		},
+		{
+			name:    "case 1: wildcard certificate covers the member domains",
+			crt:     newTestCertificate(t, "*.a1b2c.k8s.example.com"),
+			domains: []string{"etcd1.a1b2c.k8s.example.com", "etcd2.a1b2c.k8s.example.com", "etcd3.a1b2c.k8s.example.com"},
+		},
+		{
+			name:              "case 2: certificate only covers the etcd domain",
+			crt:               newTestCertificate(t, "etcd.a1b2c.k8s.example.com"),
+			domains:           []string{"etcd1.a1b2c.k8s.example.com", "etcd2.a1b2c.k8s.example.com", "etcd3.a1b2c.k8s.example.com"},
+			expectedUncovered: []string{"etcd1.a1b2c.k8s.example.com", "etcd2.a1b2c.k8s.example.com", "etcd3.a1b2c.k8s.example.com"},
+		},
+		{
+			name:              "case 3: certificate misses a member domain",
+			crt:               newTestCertificate(t, "etcd1.a1b2c.k8s.example.com", "etcd2.a1b2c.k8s.example.com", "etcd3.a1b2c.k8s.example.com"),
+			domains:           []string{"etcd1.a1b2c.k8s.example.com", "etcd2.a1b2c.k8s.example.com", "etcd3.a1b2c.k8s.example.com", "etcd4.a1b2c.k8s.example.com", "etcd5.a1b2c.k8s.example.com"},
+			expectedUncovered: []string{"etcd4.a1b2c.k8s.example.com", "etcd5.a1b2c.k8s.example.com"},
+		},
+		{
+			name:         "case 4: invalid certificate",
+			crt:          []byte("foo"),
+			domains:      []string{"etcd1.a1b2c.k8s.example.com"},
+			errorMatcher: IsExecutionFailed,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			uncovered, err := uncoveredDomains(tc.crt, tc.domains)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if !cmp.Equal(uncovered, tc.expectedUncovered) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedUncovered, uncovered))
+			}
+		})
+	}
+}
+
+func newTestCertificate(t *testing.T, dnsNames ...string) []byte {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -0,0 +1,11 @@
+package etcdmembers
+
+import (
+	"context"
+)
+
+// EnsureDeleted is a no-op. The member DNS records are deleted along with the
+// DNS zone of the cluster.
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	return nil
+}
@@ -0,0 +1,74 @@
+package etcdmembers
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
+	"github.com/giantswarm/certs"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+)
+
+const (
+	// Name is the identifier of the resource.
+	Name = "etcdmembers"
+
+	recordTTL = 60
+)
+
+// Config contains information required by Resource.
+type Config struct {
+	CertsSearcher certs.Interface
+	Logger        micrologger.Logger
+}
+
+// Resource points the DNS records of the etcd members of highly available
+// control planes to the master instances. Masters find their member by these
+// records, and members reach each other through them.
+type Resource struct {
+	certsSearcher certs.Interface
+	logger        micrologger.Logger
+}
+
+// New validates Config and creates a new Resource with it.
+func New(config Config) (*Resource, error) {
+	if config.CertsSearcher == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CertsSearcher must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	r := &Resource{
+		certsSearcher: config.CertsSearcher,
+		logger:        config.Logger,
+	}
+
+	return r, nil
+}
+
+// Name returns the resource name.
+func (r *Resource) Name() string {
+	return Name
+}
+
+func (r *Resource) getInterfacesClient(ctx context.Context) (*network.InterfacesClient, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return cc.AzureClientSet.InterfacesClient, nil
+}
+
+func (r *Resource) getRecordSetsClient(ctx context.Context) (*dns.RecordSetsClient, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return cc.AzureClientSet.DNSRecordSetsClient, nil
+}
@@ -0,0 +1,112 @@
+package instance
+
+import (
+	"context"
+	"strconv"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// When the cluster autoscaler manages the workers of a cluster, the capacity
+// of the VMSS of the default node pool is authoritative as long as it is within the bounds
+// declared in the AzureConfig. The capacity at the start of an upgrade is
+// persisted in the resource status, because the capacity changes while old
+// workers are replaced and must be restored once the upgrade is done.
+
+// getWorkerCount returns the desired number of workers of the given node
+// pool. For the default node pool of autoscaled clusters this is the capacity
+// persisted at the start of an ongoing upgrade, or the current capacity
+// otherwise.
+func (r *Resource) getWorkerCount(ctx context.Context, customObject providerv1alpha1.AzureConfig, pool key.NodePool) (int, error) {
+	if !pool.IsDefault() || !key.IsWorkerAutoscalingEnabled(customObject) {
+		return pool.Count, nil
+	}
+
+	s, err := r.getResourceStatus(customObject, WorkerCapacity)
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+	if s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, microerror.Mask(err)
+		}
+
+		return n, nil
+	}
+
+	n, err := r.getAutoscaledWorkerCount(ctx, customObject)
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+
+	return n, nil
+}
+
+// recordUpgradeWorkerCount persists the current capacity of the VMSS of the
+// default node pool of an autoscaled cluster before old workers are replaced.
+func (r *Resource) recordUpgradeWorkerCount(ctx context.Context, customObject providerv1alpha1.AzureConfig, pool key.NodePool) error {
+	if !pool.IsDefault() || !key.IsWorkerAutoscalingEnabled(customObject) {
+		return nil
+	}
+
+	n, err := r.getAutoscaledWorkerCount(ctx, customObject)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.setResourceStatus(customObject, WorkerCapacity, strconv.Itoa(n))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// clearUpgradeWorkerCount removes the capacity persisted for the upgrade of an
+// autoscaled cluster, so that the live capacity is used again.
+func (r *Resource) clearUpgradeWorkerCount(customObject providerv1alpha1.AzureConfig, pool key.NodePool) error {
+	if !pool.IsDefault() || !key.IsWorkerAutoscalingEnabled(customObject) {
+		return nil
+	}
+
+	err := r.setResourceStatus(customObject, WorkerCapacity, "")
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// getAutoscaledWorkerCount returns the capacity of the VMSS of the default
+// node pool bounded by the worker counts declared for the cluster. Before the
+// VMSS exists the number of workers in the spec is used.
+func (r *Resource) getAutoscaledWorkerCount(ctx context.Context, customObject providerv1alpha1.AzureConfig) (int, error) {
+	n := key.WorkerCount(customObject)
+	{
+		capacity, err := r.getInstancesCount(ctx, customObject, key.WorkerVMSSName)
+		if IsScaleSetNotFound(err) {
+			// Fall through.
+		} else if err != nil {
+			return 0, microerror.Mask(err)
+		} else {
+			n = int(capacity)
+		}
+	}
+
+	return boundWorkerCount(n, key.WorkerMinCount(customObject), key.WorkerMaxCount(customObject)), nil
+}
+
+func boundWorkerCount(n, min, max int) int {
+	if n < min {
+		return min
+	}
+	if n > max {
+		return max
+	}
+
+	return n
+}
@@ -3,6 +3,7 @@ package instance
 import (
 	"context"
 	"fmt"
+	"time"
 
 	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/microerror"
@@ -13,6 +14,13 @@ import (
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/masters"
 )
 
+const (
+	// Deadlines of the states waiting for the tenant cluster. When exceeded
+	// the state machine escalates to ManualInterventionRequired.
+	drainDeadline          = 2 * time.Hour
+	waitForWorkersDeadline = 1 * time.Hour
+)
+
 // configureStateMachine configures and returns state machine that is driven by
 // EnsureCreated.
 func (r *Resource) configureStateMachine() {
@@ -26,6 +34,9 @@ func (r *Resource) configureStateMachine() {
 			ClusterUpgradeRequirementCheck: r.clusterUpgradeRequirementCheckTransition,
 			ScaleUpWorkerVMSS:              r.scaleUpWorkerVMSSTransition,
 
+			CanaryCheck:  r.canaryCheckTransition,
+			CanaryFailed: r.canaryFailedTransition,
+
 			WaitNewVMSSWorkers: r.waitNewVMSSWorkersTransition,
 
 			CordonOldVMSS:    r.cordonOldVMSSTransition,
@@ -36,12 +47,41 @@ func (r *Resource) configureStateMachine() {
 			DrainOldVMSS:        r.drainOldVMSSTransition,
 			DrainOldWorkerNodes: r.drainOldWorkerNodesTransition,
 
+			ManualInterventionRequired: r.manualInterventionRequiredTransition,
+
 			TerminateOldVMSS:            r.terminateOldVmssTransition,
 			TerminateOldWorkerInstances: r.terminateOldWorkersTransition,
 
 			ScaleDownWorkerVMSS: r.scaleDownWorkerVMSSTransition,
 			DeploymentCompleted: r.deploymentCompletedTransition,
+
+			RollbackWorkers:           r.rollbackWorkersTransition,
+			WaitForRollbackDeployment: r.waitForRollbackDeploymentTransition,
+			UpgradeFailed:             r.upgradeFailedTransition,
 		},
+		Timeouts: state.TimeoutMap{
+			WaitNewVMSSWorkers:          {Deadline: waitForWorkersDeadline, Escalate: ManualInterventionRequired},
+			WaitForWorkersToBecomeReady: {Deadline: waitForWorkersDeadline, Escalate: ManualInterventionRequired},
+			DrainOldVMSS:                {Deadline: drainDeadline, Escalate: ManualInterventionRequired},
+			DrainOldWorkerNodes:         {Deadline: drainDeadline, Escalate: ManualInterventionRequired},
+			RollbackWorkers:             {Deadline: waitForWorkersDeadline, Escalate: ManualInterventionRequired},
+			WaitForRollbackDeployment:   {Deadline: waitForWorkersDeadline, Escalate: ManualInterventionRequired},
+		},
+		EnteredAtFunc:  r.stateEnteredAt,
+		EscalationFunc: r.escalateState,
+	}
+
+	// New worker instances which do not register as nodes in time keep the
+	// state machine in CordonOldWorkers, the ones which do not become ready in
+	// WaitForWorkersToBecomeReady. Both get the upgrade rolled back.
+	if r.rollback.Timeout > 0 {
+		sm.Timeouts[CordonOldWorkers] = state.Timeout{Deadline: r.rollback.Timeout, Escalate: RollbackWorkers}
+		sm.Timeouts[WaitForWorkersToBecomeReady] = state.Timeout{Deadline: r.rollback.Timeout, Escalate: RollbackWorkers}
+	}
+
+	// Canaries which do not pass the health gates in time halt the upgrade.
+	if r.canary.Timeout > 0 {
+		sm.Timeouts[CanaryCheck] = state.Timeout{Deadline: r.canary.Timeout, Escalate: CanaryFailed}
 	}
 
 	r.stateMachine = sm
@@ -72,8 +112,17 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("current state: %s", currentState))
 		newState, err = r.stateMachine.Execute(ctx, obj, currentState)
 		if err != nil {
+			r.recordTransition(ctx, cr, currentState, currentState, err)
+
+			countErr := r.countUpgradeFailure(ctx, cr, currentState, err)
+			if countErr != nil {
+				r.logger.LogCtx(ctx, "level", "warning", "message", "failed to count upgrade failure", "stack", fmt.Sprintf("%#v", countErr))
+			}
+
 			return microerror.Mask(err)
 		}
+
+		state.ReportState(Name, key.ClusterID(cr), newState)
 	}
 
 	if newState != currentState {
@@ -84,6 +133,11 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 			return microerror.Mask(err)
 		}
 		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("set resource status to '%s/%s'", Stage, newState))
+		r.recordTransition(ctx, cr, currentState, newState, nil)
+		err = r.resetUpgradeFailures(cr)
+		if err != nil {
+			return microerror.Mask(err)
+		}
 		r.logger.LogCtx(ctx, "level", "debug", "message", "canceling reconciliation")
 		reconciliationcanceledcontext.SetCanceled(ctx)
 	} else {
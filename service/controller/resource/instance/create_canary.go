@@ -0,0 +1,161 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/blobclient"
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/healthgate"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// canaryCheckTransition runs the health gates against the tenant cluster once
+// the canary of the node pool being upgraded registered as node. The rollout
+// continues when all gates pass and halts in CanaryFailed when one fails.
+func (r *Resource) canaryCheckTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	if cc.Client.TenantCluster.K8s == nil {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "tenant cluster client not available yet")
+		return currentState, nil
+	}
+
+	pool, err := r.getUpgradingNodePool(ctx, cr)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	vmssName := nodePoolVMSSNameFunc(pool.Name)
+
+	instances, err := r.allInstances(ctx, cr, vmssName)
+	if IsScaleSetNotFound(err) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find the scale set '%s'", vmssName(cr)))
+		return currentState, nil
+	} else if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	nodeList, err := cc.Client.TenantCluster.K8s.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	_, newNodes := sortNodesByTenantVMState(nodeList.Items, instances, cr, nodePoolInstanceNameFunc(pool.Name))
+	if len(newNodes) == 0 {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("canary of node pool %#q did not register as node yet", pool.Name))
+		return currentState, nil
+	}
+
+	// Pick the same node across reconciliation loops.
+	sort.Slice(newNodes, func(i, j int) bool {
+		return newNodes[i].GetName() < newNodes[j].GetName()
+	})
+	canary := newNodes[0]
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("checking health gates for canary node %s", canary.GetName()))
+
+	result, err := healthgate.Run(ctx, r.canaryGates, cr, cc.Client.TenantCluster.K8s, canary)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	switch result.Status {
+	case healthgate.StatusPassed:
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("canary node %s passed the health gates", canary.GetName()))
+
+		err = r.setResourceStatus(cr, CanaryNodePool, pool.Name)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		r.eventRecorder.Eventf(&cr, corev1.EventTypeNormal, recorder.ReasonCanaryPassed, "canary node %s of node pool %s passed the health gates: %s", canary.GetName(), pool.Name, result.Message)
+
+		return ScaleUpWorkerVMSS, nil
+
+	case healthgate.StatusFailed:
+		reason := fmt.Sprintf("canary node %s of node pool %s failed the health gates: %s", canary.GetName(), pool.Name, result.Message)
+
+		r.logger.LogCtx(ctx, "level", "debug", "message", reason)
+
+		err = r.setResourceStatus(cr, CanaryFailureReason, reason)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		r.eventRecorder.Eventf(&cr, corev1.EventTypeWarning, recorder.ReasonCanaryFailed, "%s", reason)
+
+		return CanaryFailed, nil
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("health gates pending for canary node %s: %s", canary.GetName(), result.Message))
+
+	return currentState, nil
+}
+
+// canaryFailedTransition halts the upgrade of the worker instances after a
+// canary failed. The upgrade continues without canary when canaries get
+// disabled for the cluster, and starts over when the desired deployment
+// changes, e.g. because the cluster got upgraded to a release fixing the
+// failure.
+func (r *Resource) canaryFailedTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	var newState state.State
+	if !key.IsCanaryEnabled(cr, r.canary.Enabled) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "canaries got disabled, continuing the upgrade")
+
+		newState = ScaleUpWorkerVMSS
+	} else {
+		desiredDeploymentTemplateChk, desiredDeploymentParametersChk, err := r.desiredDeploymentChecksums(ctx, cr)
+		if blobclient.IsBlobNotFound(err) {
+			r.logger.LogCtx(ctx, "level", "debug", "message", "ignition blob not found")
+			return currentState, nil
+		} else if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		currentDeploymentTemplateChk, err := r.getResourceStatus(cr, DeploymentTemplateChecksum)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		currentDeploymentParametersChk, err := r.getResourceStatus(cr, DeploymentParametersChecksum)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		if currentDeploymentTemplateChk == desiredDeploymentTemplateChk && currentDeploymentParametersChk == desiredDeploymentParametersChk {
+			r.logger.LogCtx(ctx, "level", "debug", "message", "upgrade halted after the canary failed")
+			return currentState, nil
+		}
+
+		r.logger.LogCtx(ctx, "level", "debug", "message", "template or parameters changed")
+
+		newState = DeploymentUninitialized
+	}
+
+	err = r.setResourceStatus(cr, CanaryFailureReason, "")
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	return newState, nil
+}
@@ -2,6 +2,8 @@ package instance
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
@@ -29,7 +31,42 @@ func (r *Resource) clusterUpgradeRequirementCheckTransition(ctx context.Context,
 
 	if !isCreating && anyOldNodes {
 		// Only continue rolling nodes when cluster is not creating and there
-		// are old nodes in tenant cluster.
+		// are old nodes in tenant cluster. The node pools are rolled one after
+		// another, starting with the first one having old nodes.
+		open, err := r.maintenance.Open(cr, time.Now())
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+		if !open {
+			r.logger.LogCtx(ctx, "level", "debug", "message", "waiting for a maintenance window to roll worker nodes")
+			return currentState, nil
+		}
+
+		pool, found, err := r.findOutdatedNodePool(ctx, cr, "")
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+		if !found {
+			// Old nodes which do not belong to any node pool, e.g. the ones of
+			// the legacy VMSS, are replaced along with the default node pool.
+			pool, err = key.NodePoolByName(cr, key.DefaultNodePoolName)
+			if err != nil {
+				return "", microerror.Mask(err)
+			}
+		}
+
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("rolling node pool %#q", pool.Name))
+
+		err = r.setUpgradingNodePool(cr, pool.Name)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		err = r.recordUpgradeWorkerCount(ctx, cr, pool)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
 		return ScaleUpWorkerVMSS, nil
 	}
 
@@ -41,13 +41,20 @@ func (r *Resource) cordonOldWorkersTransition(ctx context.Context, obj interface
 		return currentState, nil
 	}
 
+	pool, err := r.getUpgradingNodePool(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	vmssName := nodePoolVMSSNameFunc(pool.Name)
+
 	r.logger.LogCtx(ctx, "level", "debug", "message", "finding all worker VMSS instances")
 
 	var allWorkerInstances []compute.VirtualMachineScaleSetVM
 	{
-		allWorkerInstances, err = r.allInstances(ctx, cr, key.WorkerVMSSName)
+		allWorkerInstances, err = r.allInstances(ctx, cr, vmssName)
 		if IsScaleSetNotFound(err) {
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find the scale set '%s'", key.WorkerVMSSName(cr)))
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find the scale set '%s'", vmssName(cr)))
 
 			return currentState, nil
 		} else if err != nil {
@@ -67,29 +74,41 @@ func (r *Resource) cordonOldWorkersTransition(ctx context.Context, obj interface
 		nodes = nodeList.Items
 	}
 
-	oldNodes, newNodes := sortNodesByTenantVMState(nodes, allWorkerInstances, cr, key.WorkerInstanceName)
-	if len(newNodes) < len(oldNodes) {
-		// Wait until there's enough new nodes up.
-		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("number of new nodes (%d) is smaller than number of old nodes (%d)", len(newNodes), len(oldNodes)))
+	oldNodes, newNodes := sortNodesByTenantVMState(nodes, allWorkerInstances, cr, nodePoolInstanceNameFunc(pool.Name))
+	if len(oldNodes)+len(newNodes) < len(allWorkerInstances) {
+		// Wait until the surged nodes are up.
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("only %d of %d worker VMSS instances registered as nodes", len(oldNodes)+len(newNodes), len(allWorkerInstances)))
 		r.logger.LogCtx(ctx, "level", "debug", "message", "canceling resource")
 		return currentState, nil
 	}
 
+	workerCount, err := r.getWorkerCount(ctx, cr, pool)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	rollingUpdate, err := r.getRollingUpdate(cr, workerCount)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	batch := nextBatch(oldNodes, rollingUpdate.batchSize())
+
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("found %d old and %d new nodes from tenant cluster", len(oldNodes), len(newNodes)))
-	r.logger.LogCtx(ctx, "level", "debug", "message", "ensuring old nodes are cordoned")
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("ensuring next batch of %d old nodes is cordoned", len(batch)))
 
-	oldNodesCordoned, err := r.ensureNodesCordoned(ctx, oldNodes)
+	oldNodesCordoned, err := r.ensureNodesCordoned(ctx, batch)
 	if err != nil {
 		return "", microerror.Mask(err)
 	}
 
-	if oldNodesCordoned < len(oldNodes) {
-		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("not all old nodes are still cordoned; %d pending", len(oldNodes)-oldNodesCordoned))
+	if oldNodesCordoned < len(batch) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("not all old nodes of the batch are still cordoned; %d pending", len(batch)-oldNodesCordoned))
 
 		return currentState, nil
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("ensured all old nodes (%d) are cordoned", oldNodesCordoned))
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("ensured next batch of old nodes (%d) is cordoned", oldNodesCordoned))
 
 	return WaitForWorkersToBecomeReady, nil
 }
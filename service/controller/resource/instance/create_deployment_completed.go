@@ -36,6 +36,7 @@ func (r *Resource) deploymentCompletedTransition(ctx context.Context, obj interf
 	}
 
 	s := *d.Properties.ProvisioningState
+	r.debugger.ReportDeployment(key.ClusterID(cr), d)
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deployment is in state '%s'", s))
 
 	group, err := groupsClient.Get(ctx, key.ClusterID(cr))
@@ -79,6 +80,13 @@ func (r *Resource) deploymentCompletedTransition(ctx context.Context, obj interf
 
 			r.logger.LogCtx(ctx, "level", "debug", "message", "template and parameters unchanged")
 
+			// Remediating unhealthy workers must not keep the cluster from
+			// being upgraded, so failures are only logged.
+			err = r.remediateUnhealthyWorkers(ctx, cr)
+			if err != nil {
+				r.logger.LogCtx(ctx, "level", "warning", "message", "failed to remediate unhealthy workers", "stack", fmt.Sprintf("%#v", err))
+			}
+
 			return currentState, nil
 		}
 	} else if key.IsFinalProvisioningState(s) {
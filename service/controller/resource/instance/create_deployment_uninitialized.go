@@ -51,6 +51,16 @@ func (r *Resource) deploymentUninitializedTransition(ctx context.Context, obj in
 	} else if err != nil {
 		return currentState, microerror.Mask(err)
 	} else {
+		approved, err := r.planner.Approved(ctx, cr, key.WorkersVmssDeploymentName, computedDeployment)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+		if !approved {
+			r.logger.LogCtx(ctx, "level", "debug", "message", "deployment awaits approval")
+			r.logger.LogCtx(ctx, "level", "debug", "message", "did not ensure deployment")
+			return currentState, nil
+		}
+
 		res, err := deploymentsClient.CreateOrUpdate(ctx, key.ClusterID(cr), key.WorkersVmssDeploymentName, computedDeployment)
 		if err != nil {
 			return currentState, microerror.Mask(err)
@@ -95,8 +105,37 @@ func (r *Resource) deploymentUninitializedTransition(ctx context.Context, obj in
 			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Unable to get a valid Checksum for %s", DeploymentParametersChecksum))
 		}
 
+		// Keep the applied deployment, so that a failed upgrade can be
+		// rolled back to the deployment it replaced.
+		err = r.recordDeployment(ctx, cr, deploymentRecord{
+			TemplateChecksum:   deploymentTemplateChk,
+			ParametersChecksum: deploymentParametersChk,
+			Deployment:         computedDeployment,
+		})
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		// Every upgrade starts with a new canary.
+		canaryNodePool, err := r.getResourceStatus(cr, CanaryNodePool)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+		if canaryNodePool != "" {
+			err = r.setResourceStatus(cr, CanaryNodePool, "")
+			if err != nil {
+				return currentState, microerror.Mask(err)
+			}
+		}
+
 		// Start watcher on the instances to avoid stuck VMs to block the deployment progress forever
-		r.instanceWatchdog.DeleteFailedVMSS(ctx, key.ResourceGroupName(cr), key.WorkerVMSSName(cr))
+		pools, err := key.NodePools(cr)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+		for _, p := range pools {
+			r.instanceWatchdog.DeleteFailedVMSS(ctx, &cr, key.ResourceGroupName(cr), key.NodePoolVMSSName(cr, p.Name))
+		}
 
 		r.logger.LogCtx(ctx, "level", "debug", "message", "canceling reconciliation")
 		reconciliationcanceledcontext.SetCanceled(ctx)
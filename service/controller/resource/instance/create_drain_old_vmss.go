@@ -4,10 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	corev1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/core/v1alpha1"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/giantswarm/microerror"
-	"k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
@@ -19,27 +17,7 @@ func (r *Resource) drainOldVMSSTransition(ctx context.Context, obj interface{},
 		return "", microerror.Mask(err)
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", "finding all drainerconfigs") // nolint: errcheck
-
-	drainerConfigs := make(map[string]corev1alpha1.DrainerConfig)
-	{
-		n := metav1.NamespaceAll
-		o := metav1.ListOptions{
-			LabelSelector: fmt.Sprintf("%s=%s", key.ClusterIDLabel, key.ClusterID(cr)),
-		}
-
-		list, err := r.g8sClient.CoreV1alpha1().DrainerConfigs(n).List(o)
-		if err != nil {
-			return "", microerror.Mask(err)
-		}
-
-		for _, dc := range list.Items {
-			drainerConfigs[dc.Name] = dc
-		}
-	}
-
-	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("found %d drainerconfigs", len(drainerConfigs))) // nolint: errcheck
-	r.logger.LogCtx(ctx, "level", "debug", "message", "finding all worker VMSS instances")                         // nolint: errcheck
+	r.logger.LogCtx(ctx, "level", "debug", "message", "finding all worker VMSS instances") // nolint: errcheck
 
 	allWorkerInstances, err := r.allInstances(ctx, cr, key.LegacyWorkerVMSSName)
 	if IsScaleSetNotFound(err) {
@@ -49,9 +27,9 @@ func (r *Resource) drainOldVMSSTransition(ctx context.Context, obj interface{},
 	}
 
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("found %d worker VMSS instances", len(allWorkerInstances))) // nolint: errcheck
-	r.logger.LogCtx(ctx, "level", "debug", "message", "ensuring that drainerconfig exists for all old worker nodes")          // nolint: errcheck
+	r.logger.LogCtx(ctx, "level", "debug", "message", "ensuring that all old worker nodes are drained")                       // nolint: errcheck
 
-	var nodesPendingDraining int
+	var oldInstances []compute.VirtualMachineScaleSetVM
 	for _, i := range allWorkerInstances {
 		old, err := r.isWorkerInstanceFromPreviousRelease(ctx, cr, i)
 		if err != nil {
@@ -63,45 +41,14 @@ func (r *Resource) drainOldVMSSTransition(ctx context.Context, obj interface{},
 			continue
 		}
 
-		n := key.LegacyWorkerInstanceName(cr, *i.InstanceID)
-
-		dc, drainerConfigExists := drainerConfigs[n]
-		if !drainerConfigExists {
-			nodesPendingDraining++
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("creating drainerconfig for %s", n)) // nolint: errcheck
-			err = r.createDrainerConfig(ctx, cr, key.LegacyWorkerInstanceName(cr, *i.InstanceID))
-			if err != nil {
-				return "", microerror.Mask(err)
-			}
-		}
-
-		if drainerConfigExists && dc.Status.HasTimeoutCondition() {
-			nodesPendingDraining++
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("drainerconfig for %s already exists but has timed out", n)) // nolint: errcheck
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deleting drainerconfig for %s", n))                         // nolint: errcheck
-
-			err = r.g8sClient.CoreV1alpha1().DrainerConfigs(dc.Namespace).Delete(dc.Name, &metav1.DeleteOptions{})
-			if errors.IsNotFound(err) {
-				r.logger.LogCtx(ctx, "level", "debug", "message", "did not delete drainer config for tenant cluster node") // nolint: errcheck
-				r.logger.LogCtx(ctx, "level", "debug", "message", "drainer config for tenant cluster node does not exist") // nolint: errcheck
-			} else if err != nil {
-				return "", microerror.Mask(err)
-			}
-
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("creating drainerconfig for %s", n)) // nolint: errcheck
-			err = r.createDrainerConfig(ctx, cr, key.LegacyWorkerInstanceName(cr, *i.InstanceID))
-			if err != nil {
-				return "", microerror.Mask(err)
-			}
-		}
+		oldInstances = append(oldInstances, i)
+	}
 
-		if drainerConfigExists && !dc.Status.HasTimeoutCondition() && !dc.Status.HasDrainedCondition() {
-			nodesPendingDraining++
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("drainerconfig for %s already exists", n)) // nolint: errcheck
-		}
+	nodesPendingDraining, err := r.drainNodes(ctx, cr, oldInstances, key.LegacyWorkerInstanceName)
+	if err != nil {
+		return "", microerror.Mask(err)
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", "ensured that drainerconfig exists for all old worker nodes")       // nolint: errcheck
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("%d nodes are pending draining", nodesPendingDraining)) // nolint: errcheck
 
 	if nodesPendingDraining > 0 {
@@ -109,17 +56,11 @@ func (r *Resource) drainOldVMSSTransition(ctx context.Context, obj interface{},
 		return currentState, nil
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", "deleting all drainerconfigs") // nolint: errcheck
-
-	// Delete DrainerConfigs now that all nodes have been DRAINED.
-	for _, dc := range drainerConfigs {
-		err = r.g8sClient.CoreV1alpha1().DrainerConfigs(dc.Namespace).Delete(dc.Name, &metav1.DeleteOptions{})
-		if err != nil {
-			return "", microerror.Mask(err)
-		}
+	// Drop the drain state now that all nodes have been DRAINED.
+	err = r.drainer.Cleanup(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", "deleted all drainerconfigs") // nolint: errcheck
-
 	return TerminateOldVMSS, nil
 }
@@ -4,12 +4,11 @@ import (
 	"context"
 	"fmt"
 
-	corev1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/core/v1alpha1"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/microerror"
-	"k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/drainer"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
@@ -20,89 +19,40 @@ func (r *Resource) drainOldWorkerNodesTransition(ctx context.Context, obj interf
 		return "", microerror.Mask(err)
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", "finding all drainerconfigs")
-
-	drainerConfigs := make(map[string]corev1alpha1.DrainerConfig)
-	{
-		n := metav1.NamespaceAll
-		o := metav1.ListOptions{
-			LabelSelector: fmt.Sprintf("%s=%s", key.ClusterIDLabel, key.ClusterID(cr)),
-		}
-
-		list, err := r.g8sClient.CoreV1alpha1().DrainerConfigs(n).List(o)
-		if err != nil {
-			return "", microerror.Mask(err)
-		}
-
-		for _, dc := range list.Items {
-			drainerConfigs[dc.Name] = dc
-		}
+	pool, err := r.getUpgradingNodePool(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("found %d drainerconfigs", len(drainerConfigs)))
+	vmssName := nodePoolVMSSNameFunc(pool.Name)
+	instanceName := nodePoolInstanceNameFunc(pool.Name)
+
 	r.logger.LogCtx(ctx, "level", "debug", "message", "finding all worker VMSS instances")
 
-	allWorkerInstances, err := r.allInstances(ctx, cr, key.WorkerVMSSName)
+	allWorkerInstances, err := r.allInstances(ctx, cr, vmssName)
 	if IsScaleSetNotFound(err) {
-		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find the scale set '%s'", key.WorkerVMSSName(cr)))
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find the scale set '%s'", vmssName(cr)))
 	} else if err != nil {
 		return "", microerror.Mask(err)
 	}
 
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("found %d worker VMSS instances", len(allWorkerInstances)))
-	r.logger.LogCtx(ctx, "level", "debug", "message", "ensuring that drainerconfig exists for all old worker nodes")
-
-	var nodesPendingDraining int
-	for _, i := range allWorkerInstances {
-		old, err := r.isWorkerInstanceFromPreviousRelease(ctx, cr, i)
-		if err != nil {
-			return DeploymentUninitialized, nil
-		}
-
-		if old == nil || !*old {
-			// Node is a new one or we weren't able to check it's status, don't drain it.
-			continue
-		}
-
-		n := key.WorkerInstanceName(cr, *i.InstanceID)
 
-		dc, drainerConfigExists := drainerConfigs[n]
-		if !drainerConfigExists {
-			nodesPendingDraining++
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("creating drainerconfig for %s", n))
-			err = r.createDrainerConfig(ctx, cr, key.WorkerInstanceName(cr, *i.InstanceID))
-			if err != nil {
-				return "", microerror.Mask(err)
-			}
-		}
+	// Only the old worker nodes cordoned for the current batch are drained.
+	// New nodes, nodes we weren't able to check the status of and old nodes
+	// left for later batches are not drained.
+	batch, _, err := r.sortWorkerInstancesByBatch(ctx, cr, pool, allWorkerInstances)
+	if err != nil {
+		return DeploymentUninitialized, nil
+	}
 
-		if drainerConfigExists && dc.Status.HasTimeoutCondition() {
-			nodesPendingDraining++
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("drainerconfig for %s already exists but has timed out", n))
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deleting drainerconfig for %s", n))
-
-			err = r.g8sClient.CoreV1alpha1().DrainerConfigs(dc.Namespace).Delete(dc.Name, &metav1.DeleteOptions{})
-			if errors.IsNotFound(err) {
-				r.logger.LogCtx(ctx, "level", "debug", "message", "did not delete drainer config for tenant cluster node")
-				r.logger.LogCtx(ctx, "level", "debug", "message", "drainer config for tenant cluster node does not exist")
-			} else if err != nil {
-				return "", microerror.Mask(err)
-			}
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("ensuring that the batch of %d old worker nodes is drained", len(batch)))
 
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("creating drainerconfig for %s", n))
-			err = r.createDrainerConfig(ctx, cr, key.WorkerInstanceName(cr, *i.InstanceID))
-			if err != nil {
-				return "", microerror.Mask(err)
-			}
-		}
-
-		if drainerConfigExists && !dc.Status.HasTimeoutCondition() && !dc.Status.HasDrainedCondition() {
-			nodesPendingDraining++
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("drainerconfig for %s already exists", n))
-		}
+	nodesPendingDraining, err := r.drainNodes(ctx, cr, batch, instanceName)
+	if err != nil {
+		return "", microerror.Mask(err)
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", "ensured that drainerconfig exists for all old worker nodes")
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("%d nodes are pending draining", nodesPendingDraining))
 
 	if nodesPendingDraining > 0 {
@@ -110,59 +60,44 @@ func (r *Resource) drainOldWorkerNodesTransition(ctx context.Context, obj interf
 		return currentState, nil
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", "deleting all drainerconfigs")
-
-	// Delete DrainerConfigs now that all nodes have been DRAINED.
-	for _, dc := range drainerConfigs {
-		err = r.g8sClient.CoreV1alpha1().DrainerConfigs(dc.Namespace).Delete(dc.Name, &metav1.DeleteOptions{})
-		if err != nil {
-			return "", microerror.Mask(err)
-		}
+	// Drop the drain state now that all nodes have been DRAINED.
+	err = r.drainer.Cleanup(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", "deleted all drainerconfigs")
-
 	return TerminateOldWorkerInstances, nil
 }
 
-func (r *Resource) createDrainerConfig(ctx context.Context, customObject providerv1alpha1.AzureConfig, nodeName string) error {
-	r.logger.LogCtx(ctx, "level", "debug", "message", "creating drainer config for tenant cluster node")
-
-	n := key.ClusterID(customObject)
-	c := &corev1alpha1.DrainerConfig{
-		ObjectMeta: metav1.ObjectMeta{
-			Labels: map[string]string{
-				key.ClusterIDLabel: key.ClusterID(customObject),
-			},
-			Name: nodeName,
-		},
-		Spec: corev1alpha1.DrainerConfigSpec{
-			Guest: corev1alpha1.DrainerConfigSpecGuest{
-				Cluster: corev1alpha1.DrainerConfigSpecGuestCluster{
-					API: corev1alpha1.DrainerConfigSpecGuestClusterAPI{
-						Endpoint: key.ClusterAPIEndpoint(customObject),
-					},
-					ID: key.ClusterID(customObject),
-				},
-				Node: corev1alpha1.DrainerConfigSpecGuestNode{
-					Name: nodeName,
-				},
-			},
-			VersionBundle: corev1alpha1.DrainerConfigSpecVersionBundle{
-				Version: "0.2.0",
-			},
-		},
-	}
+// drainNodes ensures that the nodes of the given instances get drained and
+// returns the number of nodes still being drained. Draining nodes which timed
+// out is started over.
+func (r *Resource) drainNodes(ctx context.Context, customObject providerv1alpha1.AzureConfig, instances []compute.VirtualMachineScaleSetVM, instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string) (int, error) {
+	var nodesPendingDraining int
+	for _, i := range instances {
+		n := instanceNameFunc(customObject, *i.InstanceID)
 
-	_, err := r.g8sClient.CoreV1alpha1().DrainerConfigs(n).Create(c)
-	if errors.IsAlreadyExists(err) {
-		r.logger.LogCtx(ctx, "level", "debug", "message", "did not create drainer config for tenant cluster node")
-		r.logger.LogCtx(ctx, "level", "debug", "message", "drainer config for tenant cluster node does already exist")
-	} else if err != nil {
-		return microerror.Mask(err)
-	} else {
-		r.logger.LogCtx(ctx, "level", "debug", "message", "created drainer config for tenant cluster node")
+		status, err := r.drainer.Drain(ctx, customObject, n)
+		if err != nil {
+			return 0, microerror.Mask(err)
+		}
+
+		switch status {
+		case drainer.StatusDrained:
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("node %s is drained", n))
+		case drainer.StatusTimedOut:
+			nodesPendingDraining++
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("draining node %s timed out, starting over", n))
+
+			err = r.drainer.Reset(ctx, customObject, n)
+			if err != nil {
+				return 0, microerror.Mask(err)
+			}
+		default:
+			nodesPendingDraining++
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("node %s is being drained", n))
+		}
 	}
 
-	return nil
+	return nodesPendingDraining, nil
 }
@@ -0,0 +1,12 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
+)
+
+func (r *Resource) manualInterventionRequiredTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	r.logger.LogCtx(ctx, "level", "error", "message", "The reconciliation on the instance resource can't continue. Manual intervention needed.")
+	return currentState, nil
+}
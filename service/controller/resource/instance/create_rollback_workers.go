@@ -0,0 +1,300 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/blobclient"
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// rollbackWorkersTransition rolls a failed upgrade of the worker instances
+// back. The old nodes of the node pool being upgraded are uncordoned and the
+// instances created for the upgrade are deleted. Once they are gone, the
+// deployment replaced by the upgrade is applied again, which restores the
+// capacity of the VMSSs with instances of the previous release.
+//
+// Node pools upgraded before the failing one keep their new instances. Only
+// the model of their VMSSs is rolled back.
+func (r *Resource) rollbackWorkersTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	history, err := r.getDeploymentHistory(cr)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	if history.Previous == nil {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "no previous deployment found to roll back to")
+		r.eventRecorder.Eventf(&cr, corev1.EventTypeWarning, recorder.ReasonUpgradeFailed, "%s upgrade failed and cannot be rolled back: no previous deployment found", Name)
+		return ManualInterventionRequired, nil
+	}
+
+	if cc.Client.TenantCluster.K8s == nil {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "tenant cluster client not available yet")
+		return currentState, nil
+	}
+
+	pool, err := r.getUpgradingNodePool(ctx, cr)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	vmssName := nodePoolVMSSNameFunc(pool.Name)
+
+	var instances []compute.VirtualMachineScaleSetVM
+	{
+		instances, err = r.allInstances(ctx, cr, vmssName)
+		if IsScaleSetNotFound(err) {
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find the scale set '%s'", vmssName(cr)))
+		} else if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+	}
+
+	var nodes []corev1.Node
+	{
+		nodeList, err := cc.Client.TenantCluster.K8s.CoreV1().Nodes().List(metav1.ListOptions{})
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+		nodes = nodeList.Items
+	}
+
+	oldNodes, newInstances := sortWorkerInstancesForRollback(cr, pool, instances, nodes)
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", "ensuring old nodes are uncordoned")
+
+	for _, n := range oldNodes {
+		if !n.Spec.Unschedulable {
+			continue
+		}
+
+		err = r.drainer.Reset(ctx, cr, n.GetName())
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		err = r.uncordonNode(ctx, n.GetName())
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", "ensured old nodes are uncordoned")
+
+	if len(newInstances) > 0 {
+		c, err := r.getScaleSetsClient(ctx)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		var ids []string
+		for _, i := range newInstances {
+			ids = append(ids, *i.InstanceID)
+		}
+
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deleting %d worker instances created for the upgrade", len(ids)))
+
+		res, err := c.DeleteInstances(ctx, key.ResourceGroupName(cr), vmssName(cr), compute.VirtualMachineScaleSetVMInstanceRequiredIDs{InstanceIds: to.StringSlicePtr(ids)})
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+		_, err = c.DeleteInstancesResponder(res.Response())
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deleted %d worker instances created for the upgrade", len(ids)))
+
+		// Wait for the instances to be gone before the capacity gets
+		// restored, so that Azure does not count them as part of it.
+		return currentState, nil
+	}
+
+	// The parameters of deployments read back from the deployment history
+	// cannot be checksummed anymore, so the plan is identified by the
+	// checksums recorded along with the deployment.
+	approved, err := r.planner.ApprovedWithChecksums(ctx, cr, key.WorkersVmssDeploymentName, history.Previous.Deployment, history.Previous.TemplateChecksum, history.Previous.ParametersChecksum)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	if !approved {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "previous deployment awaits approval")
+		return currentState, nil
+	}
+
+	deploymentsClient, err := r.getDeploymentsClient(ctx)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", "applying previous deployment")
+
+	res, err := deploymentsClient.CreateOrUpdate(ctx, key.ClusterID(cr), key.WorkersVmssDeploymentName, history.Previous.Deployment)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	_, err = deploymentsClient.CreateOrUpdateResponder(res.Response())
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", "applied previous deployment")
+
+	return WaitForRollbackDeployment, nil
+}
+
+// waitForRollbackDeploymentTransition waits for the previous deployment of the
+// worker instances to be applied again and marks the upgrade as failed.
+func (r *Resource) waitForRollbackDeploymentTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	deploymentsClient, err := r.getDeploymentsClient(ctx)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	d, err := deploymentsClient.Get(ctx, key.ClusterID(cr), key.WorkersVmssDeploymentName)
+	if IsDeploymentNotFound(err) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "previous deployment not found")
+		return RollbackWorkers, nil
+	} else if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	s := *d.Properties.ProvisioningState
+	r.debugger.ReportDeployment(key.ClusterID(cr), d)
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deployment is in state '%s'", s))
+
+	if !key.IsSucceededProvisioningState(s) {
+		if key.IsFinalProvisioningState(s) {
+			r.debugger.LogFailedDeployment(ctx, &cr, d, err)
+			r.eventRecorder.Eventf(&cr, corev1.EventTypeWarning, recorder.ReasonUpgradeFailed, "%s upgrade failed and rolling it back failed: deployment is in state %s", Name, s)
+			return ManualInterventionRequired, nil
+		}
+
+		return currentState, nil
+	}
+
+	history, err := r.getDeploymentHistory(cr)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	// The deployment history is updated last, so that all the steps below
+	// are repeated when one of them fails.
+	if history.Previous != nil {
+		statuses := []struct {
+			t string
+			s string
+		}{
+			{t: FailedTemplateChecksum, s: history.Current.TemplateChecksum},
+			{t: FailedParametersChecksum, s: history.Current.ParametersChecksum},
+			{t: DeploymentTemplateChecksum, s: history.Previous.TemplateChecksum},
+			{t: DeploymentParametersChecksum, s: history.Previous.ParametersChecksum},
+		}
+		for _, st := range statuses {
+			err = r.setResourceStatus(cr, st.t, st.s)
+			if err != nil {
+				return currentState, microerror.Mask(err)
+			}
+		}
+
+		err = r.setDeploymentHistory(cr, history.rollBack())
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+	}
+
+	pool, err := r.getUpgradingNodePool(ctx, cr)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	err = r.clearUpgradeWorkerCount(cr, pool)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	err = r.setUpgradingNodePool(cr, "")
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	reason, err := r.getResourceStatus(cr, UpgradeFailureReason)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	r.eventRecorder.Eventf(&cr, corev1.EventTypeWarning, recorder.ReasonUpgradeRolledBack, "%s upgrade failed and got rolled back: %s", Name, reason)
+
+	return UpgradeFailed, nil
+}
+
+// upgradeFailedTransition keeps the worker instances at the previous
+// deployment until the desired deployment differs from the one which failed,
+// e.g. because the cluster got upgraded to a release fixing the failure.
+func (r *Resource) upgradeFailedTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	desiredDeploymentTemplateChk, desiredDeploymentParametersChk, err := r.desiredDeploymentChecksums(ctx, cr)
+	if blobclient.IsBlobNotFound(err) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "ignition blob not found")
+		return currentState, nil
+	} else if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	failedDeploymentTemplateChk, err := r.getResourceStatus(cr, FailedTemplateChecksum)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	failedDeploymentParametersChk, err := r.getResourceStatus(cr, FailedParametersChecksum)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	if failedDeploymentTemplateChk == desiredDeploymentTemplateChk && failedDeploymentParametersChk == desiredDeploymentParametersChk {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "desired deployment is the one which failed")
+
+		// Remediating unhealthy workers must not keep the cluster from
+		// being upgraded, so failures are only logged.
+		err = r.remediateUnhealthyWorkers(ctx, cr)
+		if err != nil {
+			r.logger.LogCtx(ctx, "level", "warning", "message", "failed to remediate unhealthy workers", "stack", fmt.Sprintf("%#v", err))
+		}
+
+		return currentState, nil
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", "desired deployment differs from the one which failed")
+
+	for _, t := range []string{FailedTemplateChecksum, FailedParametersChecksum, UpgradeFailureReason} {
+		err = r.setResourceStatus(cr, t, "")
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+	}
+
+	return DeploymentUninitialized, nil
+}
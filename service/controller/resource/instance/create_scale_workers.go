@@ -3,11 +3,17 @@ package instance
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/quota"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/vmsscheck"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
@@ -18,50 +24,123 @@ const (
 	ProvisioningStateSucceeded = "Succeeded"
 )
 
-// The goal of scaleUpWorkerVMSSTransition is to double the desired number
-// of nodes in worker VMSS in order to provide 1:1 mapping between new
-// up-to-date nodes when draining and terminating old nodes.
-// This will be done in subsequent reconciliation loops to avoid hitting the
-// VMSS api too hard.
+// The goal of scaleUpWorkerVMSSTransition is to surge the number of nodes in
+// worker VMSS above the desired number of nodes, so that new up-to-date nodes
+// are available when draining and terminating the next batch of old nodes.
+// The surge is limited by the rolling update settings of the cluster.
 func (r *Resource) scaleUpWorkerVMSSTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
 	cr, err := key.ToCustomResource(obj)
 	if err != nil {
 		return "", microerror.Mask(err)
 	}
 
-	// If the old VMSS is still present, we should skip this step.
-	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Checking if the legacy VMSS %s is still present", key.LegacyWorkerVMSSName(cr))) // nolint: errcheck
-	legacyVmss, err := r.getScaleSet(ctx, key.ResourceGroupName(cr), key.LegacyWorkerVMSSName(cr))
-	if IsScaleSetNotFound(err) {
-		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("The legacy VMSS %s is not present", key.LegacyWorkerVMSSName(cr))) // nolint: errcheck
-	} else if err != nil {
+	pool, err := r.getUpgradingNodePool(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	// Every batch of old workers starts here, so this is where rolling the
+	// workers pauses when the maintenance window closes.
+	open, err := r.maintenance.Open(cr, time.Now())
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+	if !open {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("maintenance window closed, waiting for the next one to roll the next batch of node pool %#q", pool.Name))
+		return currentState, nil
+	}
+
+	// The legacy VMSS is replaced along with the default node pool.
+	if pool.IsDefault() {
+		// If the old VMSS is still present, we should skip this step.
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Checking if the legacy VMSS %s is still present", key.LegacyWorkerVMSSName(cr))) // nolint: errcheck
+		legacyVmss, err := r.getScaleSet(ctx, key.ResourceGroupName(cr), key.LegacyWorkerVMSSName(cr))
+		if IsScaleSetNotFound(err) {
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("The legacy VMSS %s is not present", key.LegacyWorkerVMSSName(cr))) // nolint: errcheck
+		} else if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		if legacyVmss != nil {
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("The legacy VMSS %s is still present", key.LegacyWorkerVMSSName(cr))) // nolint: errcheck
+
+			// The legacy VMSS was found, check the scaling.
+			legacyVmssHasInstancesRunning := *legacyVmss.Sku.Capacity > 0
+			if legacyVmssHasInstancesRunning {
+				// The legacy VMSS has still instances running, skip scaling up.
+				r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("The legacy VMSS %s has %d instances: skipping scale up", key.LegacyWorkerVMSSName(cr), *legacyVmss.Sku.Capacity)) // nolint: errcheck
+				return WaitNewVMSSWorkers, nil
+			}
+
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("The legacy VMSS %s has 0 instances", key.LegacyWorkerVMSSName(cr))) // nolint: errcheck
+		}
+	}
+
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	if cc.Client.TenantCluster.K8s == nil {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "tenant cluster client not available yet")
+		return currentState, nil
+	}
+
+	vmssName := nodePoolVMSSNameFunc(pool.Name)
+
+	workerCount, err := r.getWorkerCount(ctx, cr, pool)
+	if err != nil {
 		return "", microerror.Mask(err)
 	}
 
-	if legacyVmss != nil {
-		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("The legacy VMSS %s is still present", key.LegacyWorkerVMSSName(cr))) // nolint: errcheck
+	rollingUpdate, err := r.getRollingUpdate(cr, workerCount)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	var oldNodes []corev1.Node
+	{
+		allWorkerInstances, err := r.allInstances(ctx, cr, vmssName)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		nodeList, err := cc.Client.TenantCluster.K8s.CoreV1().Nodes().List(metav1.ListOptions{})
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		oldNodes, _ = sortNodesByTenantVMState(nodeList.Items, allWorkerInstances, cr, nodePoolInstanceNameFunc(pool.Name))
+	}
+
+	desiredWorkerCount := rollingUpdate.desiredCapacity(workerCount, len(oldNodes))
 
-		// The legacy VMSS was found, check the scaling.
-		legacyVmssHasInstancesRunning := *legacyVmss.Sku.Capacity > 0
-		if legacyVmssHasInstancesRunning {
-			// The legacy VMSS has still instances running, skip scaling up.
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("The legacy VMSS %s has %d instances: skipping scale up", key.LegacyWorkerVMSSName(cr), *legacyVmss.Sku.Capacity)) // nolint: errcheck
-			return WaitNewVMSSWorkers, nil
+	// Upgrades of clusters with canaries bring up a single new worker per
+	// node pool first, the others only follow once it passed the health
+	// gates.
+	var canary bool
+	if key.IsCanaryEnabled(cr, r.canary.Enabled) && len(oldNodes) > 0 {
+		canaryNodePool, err := r.getResourceStatus(cr, CanaryNodePool)
+		if err != nil {
+			return "", microerror.Mask(err)
 		}
 
-		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("The legacy VMSS %s has 0 instances", key.LegacyWorkerVMSSName(cr))) // nolint: errcheck
+		if canaryNodePool != pool.Name {
+			canary = true
+			desiredWorkerCount = int64(workerCount + 1)
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("bringing up a canary for node pool %#q", pool.Name))
+		}
 	}
 
-	desiredWorkerCount := int64(key.WorkerCount(cr) * 2)
-	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("The desired number of workers is: %d", desiredWorkerCount))
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("The desired number of workers of node pool %#q is: %d (%d old workers left, max surge %d)", pool.Name, desiredWorkerCount, len(oldNodes), rollingUpdate.maxSurge))
 
-	currentWorkerCount, err := r.getInstancesCount(ctx, cr, key.WorkerVMSSName)
+	currentWorkerCount, err := r.getInstancesCount(ctx, cr, vmssName)
 	if err != nil {
 		return "", microerror.Mask(err)
 	}
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("The current number of workers is: %d", currentWorkerCount))
 
-	allReady, err := vmsscheck.InstancesAreRunning(ctx, r.logger, key.ResourceGroupName(cr), key.WorkerVMSSName(cr))
+	allReady, err := vmsscheck.InstancesAreRunning(ctx, r.logger, key.ResourceGroupName(cr), vmssName(cr))
 	if vmsscheck.IsVMSSUnsafeError(err) {
 		// VMSS rate limits are not safe, let's wait for next reconciliation loop.
 		return ScaleUpWorkerVMSS, nil
@@ -75,19 +154,35 @@ func (r *Resource) scaleUpWorkerVMSSTransition(ctx context.Context, obj interfac
 
 	// All workers ready, we can scale up if needed.
 	if desiredWorkerCount > currentWorkerCount {
-		// Raise the worker count by one
-		err = r.scaleVMSS(ctx, cr, key.WorkerVMSSName, currentWorkerCount+1)
+		demands := []quota.Demand{
+			{VMSize: pool.VMSize, Count: desiredWorkerCount - currentWorkerCount, Spot: pool.IsSpot()},
+		}
+
+		sufficient, err := r.quotaChecker.Sufficient(ctx, cr, r.azure.Location, fmt.Sprintf("scaling up VMSS %s", vmssName(cr)), demands)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+		if !sufficient {
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("insufficient quota for scaling worker VMSS %s to %d nodes", vmssName(cr), desiredWorkerCount))
+			return ScaleUpWorkerVMSS, nil
+		}
+
+		err = r.scaleVMSS(ctx, cr, vmssName, desiredWorkerCount)
 		if err != nil {
 			return "", microerror.Mask(err)
 		}
 
-		r.instanceWatchdog.GuardVMSS(ctx, key.ResourceGroupName(cr), key.WorkerVMSSName(cr))
-		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("scaled worker VMSS to %d nodes", currentWorkerCount+1))
+		r.instanceWatchdog.GuardVMSS(ctx, &cr, key.ResourceGroupName(cr), vmssName(cr))
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("scaled worker VMSS %s to %d nodes", vmssName(cr), desiredWorkerCount))
 
 		// Let's stay in the current state.
 		return ScaleUpWorkerVMSS, nil
 	}
 
+	if canary {
+		return CanaryCheck, nil
+	}
+
 	// We didn't scale up the VMSS, ready to move to next step.
 	return CordonOldWorkers, nil
 }
@@ -126,17 +221,66 @@ func (r *Resource) scaleDownWorkerVMSSTransition(ctx context.Context, obj interf
 		return "", microerror.Mask(err)
 	}
 
-	desiredWorkerCount := int64(key.WorkerCount(cr))
+	pool, err := r.getUpgradingNodePool(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	vmssName := nodePoolVMSSNameFunc(pool.Name)
+
+	workerCount, err := r.getWorkerCount(ctx, cr, pool)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	desiredWorkerCount := int64(workerCount)
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("scaling worker VMSS to %d nodes", desiredWorkerCount))
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("scaling worker VMSS %s to %d nodes", vmssName(cr), desiredWorkerCount))
 
 	// Scale down to the desired number of nodes in worker VMSS.
-	err = r.scaleVMSS(ctx, cr, key.WorkerVMSSName, desiredWorkerCount)
+	err = r.scaleVMSS(ctx, cr, vmssName, desiredWorkerCount)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("scaled worker VMSS %s to %d nodes", vmssName(cr), desiredWorkerCount))
+
+	// The upgrade is done, from now on the cluster autoscaler manages the
+	// capacity again.
+	err = r.clearUpgradeWorkerCount(cr, pool)
 	if err != nil {
 		return "", microerror.Mask(err)
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("scaled worker VMSS to %d nodes", desiredWorkerCount))
+	// Continue with the next node pool having old workers, if any.
+	next, found, err := r.findOutdatedNodePool(ctx, cr, pool.Name)
+	if IsClientNotFound(err) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "tenant cluster client not available yet")
+		return currentState, nil
+	} else if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	if found {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("rolling node pool %#q", next.Name))
+
+		err = r.setUpgradingNodePool(cr, next.Name)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		err = r.recordUpgradeWorkerCount(ctx, cr, next)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		return ScaleUpWorkerVMSS, nil
+	}
+
+	err = r.setUpgradingNodePool(cr, "")
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
 
 	return DeploymentCompleted, nil
 }
@@ -152,16 +296,24 @@ func (r *Resource) scaleVMSS(ctx context.Context, customObject providerv1alpha1.
 		return microerror.Mask(err)
 	}
 
+	direction := scaleDirection(*vmss.Sku.Capacity, nodeCount)
+
 	*vmss.Sku.Capacity = nodeCount
 	res, err := c.CreateOrUpdate(ctx, key.ResourceGroupName(customObject), deploymentNameFunc(customObject), vmss)
 	if err != nil {
+		scaleOperationsCounter.WithLabelValues(key.ClusterID(customObject), direction, scaleResultFailure).Inc()
 		return microerror.Mask(err)
 	}
 
 	_, err = c.CreateOrUpdateResponder(res.Response())
 	if err != nil {
+		scaleOperationsCounter.WithLabelValues(key.ClusterID(customObject), direction, scaleResultFailure).Inc()
 		return microerror.Mask(err)
 	}
 
+	scaleOperationsCounter.WithLabelValues(key.ClusterID(customObject), direction, scaleResultSuccess).Inc()
+
+	r.eventRecorder.Eventf(&customObject, corev1.EventTypeNormal, recorder.ReasonVMSSScaled, "scaled VMSS %s to %d instances", deploymentNameFunc(customObject), nodeCount)
+
 	return nil
 }
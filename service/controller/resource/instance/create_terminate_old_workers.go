@@ -18,12 +18,19 @@ func (r *Resource) terminateOldWorkersTransition(ctx context.Context, obj interf
 		return DeploymentUninitialized, microerror.Mask(err)
 	}
 
+	pool, err := r.getUpgradingNodePool(ctx, cr)
+	if err != nil {
+		return DeploymentUninitialized, microerror.Mask(err)
+	}
+
+	vmssName := nodePoolVMSSNameFunc(pool.Name)
+
 	r.logger.LogCtx(ctx, "level", "debug", "message", "finding all worker VMSS instances")
 	var allWorkerInstances []compute.VirtualMachineScaleSetVM
 	{
-		allWorkerInstances, err = r.allInstances(ctx, cr, key.WorkerVMSSName)
+		allWorkerInstances, err = r.allInstances(ctx, cr, vmssName)
 		if IsScaleSetNotFound(err) {
-			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find the scale set '%s'", key.WorkerVMSSName(cr)))
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find the scale set '%s'", vmssName(cr)))
 			r.logger.LogCtx(ctx, "level", "debug", "message", "restarting upgrade process")
 
 			return DeploymentUninitialized, nil
@@ -39,22 +46,22 @@ func (r *Resource) terminateOldWorkersTransition(ctx context.Context, obj interf
 		return DeploymentUninitialized, microerror.Mask(err)
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", "filtering instance IDs for old instances")
+	r.logger.LogCtx(ctx, "level", "debug", "message", "filtering instance IDs for the batch of old instances")
 
 	g := key.ResourceGroupName(cr)
-	s := key.WorkerVMSSName(cr)
+	s := vmssName(cr)
 	var ids compute.VirtualMachineScaleSetVMInstanceRequiredIDs
+	var remaining []compute.VirtualMachineScaleSetVM
 	{
+		var batch []compute.VirtualMachineScaleSetVM
+		batch, remaining, err = r.sortWorkerInstancesByBatch(ctx, cr, pool, allWorkerInstances)
+		if err != nil {
+			return DeploymentUninitialized, nil
+		}
+
 		var strIds []string
-		for _, i := range allWorkerInstances {
-			old, err := r.isWorkerInstanceFromPreviousRelease(ctx, cr, i)
-			if err != nil {
-				return DeploymentUninitialized, nil
-			}
-
-			if old != nil && *old {
-				strIds = append(strIds, *i.InstanceID)
-			}
+		for _, i := range batch {
+			strIds = append(strIds, *i.InstanceID)
 		}
 
 		ids = compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
@@ -62,19 +69,29 @@ func (r *Resource) terminateOldWorkersTransition(ctx context.Context, obj interf
 		}
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", "filtered instance IDs for old instances")
-	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("terminating %d old worker instances", len(*ids.InstanceIds)))
+	r.logger.LogCtx(ctx, "level", "debug", "message", "filtered instance IDs for the batch of old instances")
+	if len(*ids.InstanceIds) > 0 {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("terminating %d old worker instances", len(*ids.InstanceIds)))
 
-	res, err := c.DeleteInstances(ctx, g, s, ids)
-	if err != nil {
-		return DeploymentUninitialized, microerror.Mask(err)
-	}
-	_, err = c.DeleteInstancesResponder(res.Response())
-	if err != nil {
-		return DeploymentUninitialized, microerror.Mask(err)
+		res, err := c.DeleteInstances(ctx, g, s, ids)
+		if err != nil {
+			return DeploymentUninitialized, microerror.Mask(err)
+		}
+		_, err = c.DeleteInstancesResponder(res.Response())
+		if err != nil {
+			return DeploymentUninitialized, microerror.Mask(err)
+		}
+
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("terminated %d old worker instances", len(*ids.InstanceIds)))
+	} else {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "no old worker instances to terminate")
 	}
 
-	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("terminated %d old worker instances", len(*ids.InstanceIds)))
+	if len(remaining) > 0 {
+		// Continue with the next batch of old worker instances.
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("%d old worker instances left for the next batches", len(remaining)))
+		return ScaleUpWorkerVMSS, nil
+	}
 
 	return ScaleDownWorkerVMSS, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/microerror"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +37,29 @@ func (r *Resource) waitForWorkersToBecomeReadyTransition(ctx context.Context, ob
 		return "", microerror.Mask(err)
 	}
 
+	pool, err := r.getUpgradingNodePool(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	notReady, err := r.countNewNodesNotReady(ctx, cr, pool)
+	if IsScaleSetNotFound(err) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find the scale set '%s'", key.NodePoolVMSSName(cr, pool.Name)))
+		return currentState, nil
+	} else if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	if notReady > 0 {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("found %d new worker nodes of node pool %#q which are not Ready", notReady, pool.Name))
+		return currentState, nil
+	}
+
+	// The legacy VMSS is replaced along with the default node pool.
+	if !pool.IsDefault() {
+		return DrainOldWorkerNodes, nil
+	}
+
 	// If the old VMSS still exists, we want to go to a different state.
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Checking if the legacy VMSS %s is still present", key.LegacyWorkerVMSSName(cr))) // nolint: errcheck
 	vmss, err := r.getScaleSet(ctx, key.ResourceGroupName(cr), key.LegacyWorkerVMSSName(cr))
@@ -60,6 +84,36 @@ func (r *Resource) waitForWorkersToBecomeReadyTransition(ctx context.Context, ob
 	return DrainOldWorkerNodes, nil
 }
 
+// countNewNodesNotReady returns the number of nodes of new worker instances of
+// the given node pool which are not ready.
+func (r *Resource) countNewNodesNotReady(ctx context.Context, customObject providerv1alpha1.AzureConfig, pool key.NodePool) (int, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+
+	instances, err := r.allInstances(ctx, customObject, nodePoolVMSSNameFunc(pool.Name))
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+
+	nodeList, err := cc.Client.TenantCluster.K8s.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+
+	_, newNodes := sortNodesByTenantVMState(nodeList.Items, instances, customObject, nodePoolInstanceNameFunc(pool.Name))
+
+	var notReady int
+	for _, n := range newNodes {
+		if !isReady(n) {
+			notReady++
+		}
+	}
+
+	return notReady, nil
+}
+
 func countReadyNodes(ctx context.Context, nodeRoleMatchFunc func(corev1.Node) bool) (int, error) {
 	cc, err := controllercontext.FromContext(ctx)
 	if err != nil {
@@ -2,8 +2,27 @@ package instance
 
 import (
 	"context"
+
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
 
 func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.deleteDeploymentHistory(ctx, cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	deleteClusterMetrics(key.ClusterID(cr))
+	r.debugger.DeleteCluster(key.ClusterID(cr))
+	state.DeleteCluster(key.ClusterID(cr))
+
 	return nil
 }
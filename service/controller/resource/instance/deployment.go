@@ -10,6 +10,7 @@ import (
 	"github.com/giantswarm/operatorkit/controller/context/resourcecanceledcontext"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
+	"github.com/giantswarm/azure-operator/v4/pkg/checksum"
 	"github.com/giantswarm/azure-operator/v4/pkg/helpers/vmss"
 	"github.com/giantswarm/azure-operator/v4/pkg/project"
 	"github.com/giantswarm/azure-operator/v4/service/controller/blobclient"
@@ -30,9 +31,14 @@ func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureC
 
 	prefixWorker := key.PrefixWorker()
 
-	workerBlobName := key.BlobName(obj, prefixWorker)
-	cloudConfigURLs := []string{
-		workerBlobName,
+	pools, err := key.NodePools(obj)
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Mask(err)
+	}
+
+	var cloudConfigURLs []string
+	for _, p := range pools {
+		cloudConfigURLs = append(cloudConfigURLs, key.NodePoolBlobName(obj, p.Name))
 	}
 
 	distroVersion, err := key.OSVersion(cc.Release.Release)
@@ -82,25 +88,44 @@ func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureC
 	containerName := key.BlobContainerName()
 
 	// Workers cloudconfig
-	workerBlobURL, err := blobclient.GetBlobURL(workerBlobName, containerName, storageAccountName, primaryKey, cc.ContainerURL)
-	if err != nil {
-		return azureresource.Deployment{}, microerror.Mask(err)
+	var workerCloudConfig string
+	nodePoolCloudConfigs := map[string]string{}
+	for _, p := range pools {
+		blobURL, err := blobclient.GetBlobURL(key.NodePoolBlobName(obj, p.Name), containerName, storageAccountName, primaryKey, cc.ContainerURL)
+		if err != nil {
+			return azureresource.Deployment{}, microerror.Mask(err)
+		}
+		c, err := vmss.RenderCloudConfig(blobURL, encryptionKey, initialVector, prefixWorker)
+		if err != nil {
+			return azureresource.Deployment{}, microerror.Mask(err)
+		}
+
+		if p.IsDefault() {
+			workerCloudConfig = c
+		} else {
+			nodePoolCloudConfigs[p.Name] = c
+		}
 	}
-	workerCloudConfig, err := vmss.RenderCloudConfig(workerBlobURL, encryptionKey, initialVector, prefixWorker)
+
+	// The number of workers of the additional node pools is part of the node
+	// pool configuration, only the default node pool may be autoscaled.
+	workerCount, err := r.getWorkerCount(ctx, obj, pools[0])
 	if err != nil {
 		return azureresource.Deployment{}, microerror.Mask(err)
 	}
 
 	defaultParams := map[string]interface{}{
-		"apiLBBackendPoolID":    cc.APILBBackendPoolID,
-		"azureOperatorVersion":  project.Version(),
-		"clusterID":             key.ClusterID(obj),
-		"etcdLBBackendPoolID":   cc.EtcdLBBackendPoolID,
-		"vmssMSIEnabled":        r.azure.MSI.Enabled,
-		"workerCloudConfigData": workerCloudConfig,
-		"workerNodes":           vmss.GetWorkerNodesConfiguration(obj, distroVersion),
-		"workerSubnetID":        cc.WorkerSubnetID,
-		"zones":                 key.AvailabilityZones(obj, location),
+		"apiLBBackendPoolID":      cc.APILBBackendPoolID,
+		"azureOperatorVersion":    project.Version(),
+		"clusterID":               key.ClusterID(obj),
+		"etcdLBBackendPoolID":     cc.EtcdLBBackendPoolID,
+		"nodePoolCloudConfigData": nodePoolCloudConfigs,
+		"nodePools":               vmss.GetWorkerNodePoolsConfiguration(obj, pools, location),
+		"vmssMSIEnabled":          r.azure.MSI.Enabled,
+		"workerCloudConfigData":   workerCloudConfig,
+		"workerCount":             workerCount,
+		"workerNodes":             vmss.GetWorkerNodesConfiguration(obj, distroVersion),
+		"workerSubnetID":          cc.WorkerSubnetID,
 	}
 
 	armTemplate, err := template.GetARMTemplate()
@@ -118,3 +143,35 @@ func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureC
 
 	return d, nil
 }
+
+// desiredDeploymentChecksums returns the checksums of the template and the
+// parameters of the deployment the worker instances of the given cluster
+// should have.
+func (r *Resource) desiredDeploymentChecksums(ctx context.Context, customObject providerv1alpha1.AzureConfig) (string, string, error) {
+	groupsClient, err := r.getGroupsClient(ctx)
+	if err != nil {
+		return "", "", microerror.Mask(err)
+	}
+
+	group, err := groupsClient.Get(ctx, key.ClusterID(customObject))
+	if err != nil {
+		return "", "", microerror.Mask(err)
+	}
+
+	computedDeployment, err := r.newDeployment(ctx, customObject, nil, *group.Location)
+	if err != nil {
+		return "", "", microerror.Mask(err)
+	}
+
+	templateChk, err := checksum.GetDeploymentTemplateChecksum(computedDeployment)
+	if err != nil {
+		return "", "", microerror.Mask(err)
+	}
+
+	parametersChk, err := checksum.GetDeploymentParametersChecksum(computedDeployment)
+	if err != nil {
+		return "", "", microerror.Mask(err)
+	}
+
+	return templateChk, parametersChk, nil
+}
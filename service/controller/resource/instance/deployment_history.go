@@ -0,0 +1,192 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/pkg/project"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+const (
+	deploymentHistoryCurrentKey  = "current"
+	deploymentHistoryPreviousKey = "previous"
+)
+
+// deploymentRecord is a deployment of the worker instances along with the
+// checksums of its template and parameters.
+type deploymentRecord struct {
+	TemplateChecksum   string                   `json:"templateChecksum"`
+	ParametersChecksum string                   `json:"parametersChecksum"`
+	Deployment         azureresource.Deployment `json:"deployment"`
+}
+
+// deploymentHistory holds the current deployment of the worker instances and
+// the one it replaced, which failed upgrades get rolled back to. Either may
+// be nil.
+//
+// The history is kept in a secret of the control plane rather than in the
+// resource status, because the parameters of the deployment carry the
+// encrypted cloudconfig of the workers along with its encryption key.
+type deploymentHistory struct {
+	Current  *deploymentRecord
+	Previous *deploymentRecord
+}
+
+// record returns the history after the given deployment got applied. The
+// current deployment becomes the previous one, unless the given deployment is
+// the current one already.
+func (h deploymentHistory) record(d deploymentRecord) deploymentHistory {
+	if h.Current != nil && h.Current.TemplateChecksum == d.TemplateChecksum && h.Current.ParametersChecksum == d.ParametersChecksum {
+		return h
+	}
+
+	return deploymentHistory{
+		Current:  &d,
+		Previous: h.Current,
+	}
+}
+
+// rollBack returns the history after the previous deployment got applied
+// again. The current deployment is dropped, so that a failed upgrade is never
+// rolled back to.
+func (h deploymentHistory) rollBack() deploymentHistory {
+	return deploymentHistory{
+		Current: h.Previous,
+	}
+}
+
+func (r *Resource) getDeploymentHistory(customObject providerv1alpha1.AzureConfig) (deploymentHistory, error) {
+	secret, err := r.k8sClient.CoreV1().Secrets(key.CertificateEncryptionNamespace).Get(key.WorkersDeploymentHistorySecretName(customObject), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return deploymentHistory{}, nil
+	} else if err != nil {
+		return deploymentHistory{}, microerror.Mask(err)
+	}
+
+	h, err := decodeDeploymentHistory(secret.Data)
+	if err != nil {
+		return deploymentHistory{}, microerror.Mask(err)
+	}
+
+	return h, nil
+}
+
+func (r *Resource) setDeploymentHistory(customObject providerv1alpha1.AzureConfig, h deploymentHistory) error {
+	data, err := encodeDeploymentHistory(h)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	secret := &corev1.Secret{
+		Type: corev1.SecretTypeOpaque,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.WorkersDeploymentHistorySecretName(customObject),
+			Namespace: key.CertificateEncryptionNamespace,
+			Labels: map[string]string{
+				key.LabelCluster:      key.ClusterID(customObject),
+				key.LabelManagedBy:    project.Name(),
+				key.LabelOrganization: key.ClusterCustomer(customObject),
+			},
+		},
+		Data: data,
+	}
+
+	_, err = r.k8sClient.CoreV1().Secrets(key.CertificateEncryptionNamespace).Update(secret)
+	if apierrors.IsNotFound(err) {
+		_, err = r.k8sClient.CoreV1().Secrets(key.CertificateEncryptionNamespace).Create(secret)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// recordDeployment adds the given deployment to the deployment history of the
+// worker instances.
+func (r *Resource) recordDeployment(ctx context.Context, customObject providerv1alpha1.AzureConfig, d deploymentRecord) error {
+	h, err := r.getDeploymentHistory(customObject)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	updated := h.record(d)
+	if updated == h {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "deployment already recorded in deployment history")
+		return nil
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", "recording deployment in deployment history")
+
+	err = r.setDeploymentHistory(customObject, updated)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", "recorded deployment in deployment history")
+
+	return nil
+}
+
+func (r *Resource) deleteDeploymentHistory(ctx context.Context, customObject providerv1alpha1.AzureConfig) error {
+	r.logger.LogCtx(ctx, "level", "debug", "message", "deleting deployment history secret")
+
+	err := r.k8sClient.CoreV1().Secrets(key.CertificateEncryptionNamespace).Delete(key.WorkersDeploymentHistorySecretName(customObject), &metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "deployment history secret already deleted")
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "deleted deployment history secret")
+	}
+
+	return nil
+}
+
+func decodeDeploymentHistory(data map[string][]byte) (deploymentHistory, error) {
+	var h deploymentHistory
+
+	for k, d := range map[string]**deploymentRecord{deploymentHistoryCurrentKey: &h.Current, deploymentHistoryPreviousKey: &h.Previous} {
+		b, ok := data[k]
+		if !ok {
+			continue
+		}
+
+		var rec deploymentRecord
+		err := json.Unmarshal(b, &rec)
+		if err != nil {
+			return deploymentHistory{}, microerror.Maskf(invalidDeploymentHistoryError, "%s deployment: %s", k, err)
+		}
+		*d = &rec
+	}
+
+	return h, nil
+}
+
+func encodeDeploymentHistory(h deploymentHistory) (map[string][]byte, error) {
+	data := map[string][]byte{}
+
+	for k, d := range map[string]*deploymentRecord{deploymentHistoryCurrentKey: h.Current, deploymentHistoryPreviousKey: h.Previous} {
+		if d == nil {
+			continue
+		}
+
+		b, err := json.Marshal(d)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		data[k] = b
+	}
+
+	return data, nil
+}
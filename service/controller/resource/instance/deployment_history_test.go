@@ -0,0 +1,110 @@
+package instance
+
+import (
+	"strconv"
+	"testing"
+
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_deploymentHistory_record(t *testing.T) {
+	v1 := deploymentRecord{TemplateChecksum: "t1", ParametersChecksum: "p1"}
+	v2 := deploymentRecord{TemplateChecksum: "t1", ParametersChecksum: "p2"}
+	v3 := deploymentRecord{TemplateChecksum: "t3", ParametersChecksum: "p2"}
+
+	testCases := []struct {
+		name            string
+		history         deploymentHistory
+		deployment      deploymentRecord
+		expectedHistory deploymentHistory
+	}{
+		{
+			name:            "case 0: first deployment",
+			history:         deploymentHistory{},
+			deployment:      v1,
+			expectedHistory: deploymentHistory{Current: &v1},
+		},
+		{
+			name:            "case 1: current deployment applied again",
+			history:         deploymentHistory{Current: &v1},
+			deployment:      v1,
+			expectedHistory: deploymentHistory{Current: &v1},
+		},
+		{
+			name:            "case 2: parameters changed",
+			history:         deploymentHistory{Current: &v1},
+			deployment:      v2,
+			expectedHistory: deploymentHistory{Current: &v2, Previous: &v1},
+		},
+		{
+			name:            "case 3: template changed",
+			history:         deploymentHistory{Current: &v2, Previous: &v1},
+			deployment:      v3,
+			expectedHistory: deploymentHistory{Current: &v3, Previous: &v2},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			h := tc.history.record(tc.deployment)
+
+			if !cmp.Equal(h, tc.expectedHistory) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedHistory, h))
+			}
+		})
+	}
+}
+
+func Test_deploymentHistory_rollBack(t *testing.T) {
+	v1 := deploymentRecord{TemplateChecksum: "t1", ParametersChecksum: "p1"}
+	v2 := deploymentRecord{TemplateChecksum: "t1", ParametersChecksum: "p2"}
+
+	h := deploymentHistory{Current: &v2, Previous: &v1}.rollBack()
+
+	expected := deploymentHistory{Current: &v1}
+	if !cmp.Equal(h, expected) {
+		t.Fatalf("\n\n%s\n", cmp.Diff(expected, h))
+	}
+}
+
+func Test_encodeDeploymentHistory(t *testing.T) {
+	h := deploymentHistory{
+		Current: &deploymentRecord{
+			TemplateChecksum:   "t2",
+			ParametersChecksum: "p2",
+			Deployment: azureresource.Deployment{
+				Properties: &azureresource.DeploymentProperties{
+					Mode:       azureresource.Incremental,
+					Parameters: map[string]interface{}{"clusterID": map[string]interface{}{"Value": "eggs2"}},
+					Template:   map[string]interface{}{"contentVersion": "1.0.0.0"},
+				},
+			},
+		},
+		Previous: &deploymentRecord{
+			TemplateChecksum:   "t1",
+			ParametersChecksum: "p1",
+		},
+	}
+
+	data, err := encodeDeploymentHistory(h)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	decoded, err := decodeDeploymentHistory(data)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	if !cmp.Equal(decoded, h) {
+		t.Fatalf("\n\n%s\n", cmp.Diff(h, decoded))
+	}
+
+	_, err = decodeDeploymentHistory(map[string][]byte{deploymentHistoryCurrentKey: []byte("{")})
+	if !IsInvalidDeploymentHistory(err) {
+		t.Fatalf("error == %#v, want matching", err)
+	}
+}
@@ -26,6 +26,15 @@ var executionFailedError = &microerror.Error{
 	Kind: "executionFailedError",
 }
 
+var invalidDeploymentHistoryError = &microerror.Error{
+	Kind: "invalidDeploymentHistoryError",
+}
+
+// IsInvalidDeploymentHistory asserts invalidDeploymentHistoryError.
+func IsInvalidDeploymentHistory(err error) bool {
+	return microerror.Cause(err) == invalidDeploymentHistoryError
+}
+
 var invalidConfigError = &microerror.Error{
 	Kind: "invalidConfigError",
 }
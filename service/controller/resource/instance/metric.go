@@ -0,0 +1,56 @@
+package instance
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	PrometheusNamespace = "azure_operator"
+	PrometheusSubsystem = "vmss"
+)
+
+const (
+	scaleDirectionDown = "down"
+	scaleDirectionNone = "none"
+	scaleDirectionUp   = "up"
+
+	scaleResultFailure = "failure"
+	scaleResultSuccess = "success"
+)
+
+var (
+	scaleOperationsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "scale_operations_total",
+			Help:      "Number of scale operations executed against worker VMSSs.",
+		},
+		[]string{"cluster_id", "direction", "result"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(scaleOperationsCounter)
+}
+
+// deleteClusterMetrics removes the scale operation metrics of the given
+// cluster.
+func deleteClusterMetrics(clusterID string) {
+	for _, direction := range []string{scaleDirectionDown, scaleDirectionNone, scaleDirectionUp} {
+		for _, result := range []string{scaleResultFailure, scaleResultSuccess} {
+			scaleOperationsCounter.DeleteLabelValues(clusterID, direction, result)
+		}
+	}
+}
+
+func scaleDirection(current, desired int64) string {
+	switch {
+	case desired > current:
+		return scaleDirectionUp
+	case desired < current:
+		return scaleDirectionDown
+	default:
+		return scaleDirectionNone
+	}
+}
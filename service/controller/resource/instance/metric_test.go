@@ -0,0 +1,23 @@
+package instance
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func Test_deleteClusterMetrics(t *testing.T) {
+	series := testutil.CollectAndCount(scaleOperationsCounter)
+
+	scaleOperationsCounter.WithLabelValues("a1b2c", scaleDirectionUp, scaleResultSuccess).Inc()
+	scaleOperationsCounter.WithLabelValues("a1b2c", scaleDirectionDown, scaleResultFailure).Inc()
+	scaleOperationsCounter.WithLabelValues("d3e4f", scaleDirectionUp, scaleResultSuccess).Inc()
+
+	deleteClusterMetrics("a1b2c")
+
+	if n := testutil.CollectAndCount(scaleOperationsCounter); n != series+1 {
+		t.Fatalf("series == %d, want %d", n, series+1)
+	}
+
+	deleteClusterMetrics("d3e4f")
+}
@@ -0,0 +1,118 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// The worker node pools of a cluster are upgraded one after another, in the
+// order returned by key.NodePools. The name of the node pool whose workers are
+// currently being replaced is persisted in the resource status.
+
+// getUpgradingNodePool returns the node pool whose workers are currently being
+// replaced. The default node pool is returned when no node pool is persisted
+// or the persisted node pool does not exist anymore.
+func (r *Resource) getUpgradingNodePool(ctx context.Context, customObject providerv1alpha1.AzureConfig) (key.NodePool, error) {
+	name, err := r.getResourceStatus(customObject, NodePool)
+	if err != nil {
+		return key.NodePool{}, microerror.Mask(err)
+	}
+	if name == "" {
+		name = key.DefaultNodePoolName
+	}
+
+	pool, err := key.NodePoolByName(customObject, name)
+	if key.IsNotFound(err) {
+		r.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("node pool %#q does not exist anymore", name))
+
+		pool, err = key.NodePoolByName(customObject, key.DefaultNodePoolName)
+		if err != nil {
+			return key.NodePool{}, microerror.Mask(err)
+		}
+	} else if err != nil {
+		return key.NodePool{}, microerror.Mask(err)
+	}
+
+	return pool, nil
+}
+
+// setUpgradingNodePool persists the node pool whose workers are replaced
+// next. The empty name clears the persisted node pool.
+func (r *Resource) setUpgradingNodePool(customObject providerv1alpha1.AzureConfig, name string) error {
+	err := r.setResourceStatus(customObject, NodePool, name)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// findOutdatedNodePool returns the first node pool after the node pool with
+// the given name which has workers of a previous release. Passing the empty
+// name considers all node pools. False is returned when all node pools are up
+// to date.
+func (r *Resource) findOutdatedNodePool(ctx context.Context, customObject providerv1alpha1.AzureConfig, after string) (key.NodePool, bool, error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return key.NodePool{}, false, microerror.Mask(err)
+	}
+
+	if cc.Client.TenantCluster.K8s == nil {
+		return key.NodePool{}, false, clientNotFoundError
+	}
+
+	pools, err := key.NodePools(customObject)
+	if err != nil {
+		return key.NodePool{}, false, microerror.Mask(err)
+	}
+
+	nodeList, err := cc.Client.TenantCluster.K8s.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return key.NodePool{}, false, microerror.Mask(err)
+	}
+
+	skip := after != ""
+	for _, p := range pools {
+		if skip {
+			skip = p.Name != after
+			continue
+		}
+
+		instances, err := r.allInstances(ctx, customObject, nodePoolVMSSNameFunc(p.Name))
+		if IsScaleSetNotFound(err) {
+			continue
+		} else if err != nil {
+			return key.NodePool{}, false, microerror.Mask(err)
+		}
+
+		oldNodes, _ := sortNodesByTenantVMState(nodeList.Items, instances, customObject, nodePoolInstanceNameFunc(p.Name))
+		if len(oldNodes) > 0 {
+			return p, true, nil
+		}
+	}
+
+	return key.NodePool{}, false, nil
+}
+
+// nodePoolVMSSNameFunc returns a function returning the name of the VMSS of
+// the node pool with the given name.
+func nodePoolVMSSNameFunc(pool string) func(customObject providerv1alpha1.AzureConfig) string {
+	return func(customObject providerv1alpha1.AzureConfig) string {
+		return key.NodePoolVMSSName(customObject, pool)
+	}
+}
+
+// nodePoolInstanceNameFunc returns a function returning the node names of the
+// instances of the node pool with the given name.
+func nodePoolInstanceNameFunc(pool string) func(customObject providerv1alpha1.AzureConfig, instanceID string) string {
+	return func(customObject providerv1alpha1.AzureConfig, instanceID string) string {
+		return key.NodePoolInstanceName(customObject, pool, instanceID)
+	}
+}
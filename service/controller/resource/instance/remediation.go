@@ -0,0 +1,507 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/drainer"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
+)
+
+// Worker instances whose nodes are not ready for longer than the unhealthy
+// period, or which did not register a node within the unhealthy period, are
+// remediated while the cluster is not being upgraded. Their nodes are drained
+// and the instances get reimaged or deleted. The instances being remediated
+// are persisted in the resource status, so that remediations carry on across
+// reconciliation loops. At most the configured number of instances of a
+// cluster are remediated at once, so that a cluster wide outage, e.g. of the
+// network, does not take down all the workers.
+
+const (
+	// remediationPhaseObserved tracks instances without node until the
+	// unhealthy period is over.
+	remediationPhaseObserved = "Observed"
+	// remediationPhaseDraining is the phase of instances whose nodes are
+	// being drained.
+	remediationPhaseDraining = "Draining"
+	// remediationPhaseReplacing is the phase of instances which got reimaged
+	// or deleted and whose replacement is awaited.
+	remediationPhaseReplacing = "Replacing"
+)
+
+// remediation is an instance being remediated.
+type remediation struct {
+	NodePool   string    `json:"nodePool"`
+	InstanceID string    `json:"instanceID"`
+	Node       string    `json:"node,omitempty"`
+	Phase      string    `json:"phase"`
+	Since      time.Time `json:"since"`
+}
+
+func (m remediation) isActive() bool {
+	return m.Phase == remediationPhaseDraining || m.Phase == remediationPhaseReplacing
+}
+
+// remediateUnhealthyWorkers remediates the worker instances of the given
+// cluster whose nodes are unhealthy.
+func (r *Resource) remediateUnhealthyWorkers(ctx context.Context, customObject providerv1alpha1.AzureConfig) error {
+	maxConcurrent, err := key.WorkerMaxConcurrentRemediations(customObject, r.remediation.MaxConcurrent)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	if maxConcurrent == 0 {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "remediation of unhealthy workers is disabled")
+		return nil
+	}
+
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if cc.Client.TenantCluster.K8s == nil {
+		return clientNotFoundError
+	}
+
+	pools, err := key.NodePools(customObject)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	nodeList, err := cc.Client.TenantCluster.K8s.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	nodeMap := make(map[string]corev1.Node)
+	for _, n := range nodeList.Items {
+		nodeMap[n.GetName()] = n
+	}
+
+	remediations, err := r.getRemediations(customObject)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var active int
+	for _, m := range remediations {
+		if m.isActive() {
+			active++
+		}
+	}
+
+	now := time.Now()
+
+	var updated []remediation
+	for _, p := range pools {
+		// Spot instances are expected to go away at any time. Their
+		// replacement is up to the VMSS.
+		if p.IsSpot() {
+			continue
+		}
+
+		instances, err := r.allInstances(ctx, customObject, nodePoolVMSSNameFunc(p.Name))
+		if IsScaleSetNotFound(err) {
+			continue
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		instanceMap := make(map[string]compute.VirtualMachineScaleSetVM)
+		for _, i := range instances {
+			instanceMap[*i.InstanceID] = i
+		}
+
+		poolRemediations := make(map[string]remediation)
+		for _, m := range remediations {
+			if m.NodePool == p.Name {
+				poolRemediations[m.InstanceID] = m
+			}
+		}
+
+		// Carry on with the remediations of instances which are gone.
+		for id, m := range poolRemediations {
+			if _, ok := instanceMap[id]; ok {
+				continue
+			}
+
+			if m.isActive() {
+				active--
+			}
+
+			// Reimaged instances being gone means they got replaced some
+			// other way.
+			if m.Phase == remediationPhaseReplacing && r.remediation.Action == setting.RemediationActionDelete {
+				err = r.completeRemediation(ctx, customObject, p, m)
+				if err != nil {
+					return microerror.Mask(err)
+				}
+			}
+		}
+
+		for _, i := range instances {
+			id := *i.InstanceID
+			m, tracked := poolRemediations[id]
+
+			var node *corev1.Node
+			{
+				n, found := nodeMap[key.NodePoolInstanceName(customObject, p.Name, id)]
+				if !found && p.IsDefault() {
+					n, found = nodeMap[key.LegacyWorkerInstanceName(customObject, id)]
+				}
+				if found {
+					node = &n
+				}
+			}
+
+			if tracked && m.isActive() {
+				var done bool
+				m, done, err = r.carryOnRemediation(ctx, customObject, p, i, node, m, now)
+				if err != nil {
+					return microerror.Mask(err)
+				}
+				if done {
+					active--
+				} else {
+					updated = append(updated, m)
+				}
+				continue
+			}
+
+			// Instances being created, updated or deleted are left alone.
+			if i.ProvisioningState == nil || !key.IsSucceededProvisioningState(*i.ProvisioningState) {
+				continue
+			}
+
+			var unhealthy bool
+			if node == nil {
+				if !tracked {
+					m = remediation{
+						NodePool:   p.Name,
+						InstanceID: id,
+						Phase:      remediationPhaseObserved,
+						Since:      now,
+					}
+				}
+				unhealthy = now.Sub(m.Since) > r.remediation.UnhealthyPeriod
+			} else {
+				unhealthy = isNodeUnhealthy(*node, now, r.remediation.UnhealthyPeriod)
+			}
+
+			if !unhealthy {
+				if node == nil {
+					updated = append(updated, m)
+				}
+				continue
+			}
+
+			if active >= maxConcurrent {
+				r.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("not remediating instance %s of node pool %#q, %d instances are already being remediated", id, p.Name, active))
+				if node == nil {
+					updated = append(updated, m)
+				}
+				continue
+			}
+
+			m = remediation{
+				NodePool:   p.Name,
+				InstanceID: id,
+				Phase:      remediationPhaseDraining,
+				Since:      now,
+			}
+			if node != nil {
+				m.Node = node.GetName()
+				r.eventRecorder.Eventf(&customObject, corev1.EventTypeWarning, recorder.ReasonNodeUnhealthy, "node %s of instance %s of node pool %s is not ready for more than %s, remediating", node.GetName(), id, p.Name, r.remediation.UnhealthyPeriod)
+			} else {
+				r.eventRecorder.Eventf(&customObject, corev1.EventTypeWarning, recorder.ReasonNodeUnhealthy, "instance %s of node pool %s has no node for more than %s, remediating", id, p.Name, r.remediation.UnhealthyPeriod)
+			}
+			active++
+
+			var done bool
+			m, done, err = r.carryOnRemediation(ctx, customObject, p, i, node, m, now)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			if done {
+				active--
+			} else {
+				updated = append(updated, m)
+			}
+		}
+	}
+
+	err = r.setRemediations(customObject, remediations, updated)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// carryOnRemediation moves the given remediation of the given existing
+// instance forward. It returns the updated remediation and whether the
+// remediation is done.
+func (r *Resource) carryOnRemediation(ctx context.Context, customObject providerv1alpha1.AzureConfig, pool key.NodePool, instance compute.VirtualMachineScaleSetVM, node *corev1.Node, m remediation, now time.Time) (remediation, bool, error) {
+	switch m.Phase {
+	case remediationPhaseDraining:
+		// Instances without node have nothing to drain.
+		if m.Node != "" {
+			status, err := r.drainer.Drain(ctx, customObject, m.Node)
+			if err != nil {
+				return remediation{}, false, microerror.Mask(err)
+			}
+
+			switch status {
+			case drainer.StatusDrained:
+				r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("node %s is drained", m.Node))
+			case drainer.StatusTimedOut:
+				// The node is not ready, so pods might never terminate. It is
+				// replaced anyway.
+				r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("draining node %s timed out", m.Node))
+			default:
+				r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("node %s is being drained", m.Node))
+				return m, false, nil
+			}
+		}
+
+		err := r.replaceInstance(ctx, customObject, pool, *instance.InstanceID)
+		if err != nil {
+			return remediation{}, false, microerror.Mask(err)
+		}
+
+		m.Phase = remediationPhaseReplacing
+		m.Since = now
+
+		return m, false, nil
+
+	case remediationPhaseReplacing:
+		// Deleted instances are done once they are gone.
+		if r.remediation.Action == setting.RemediationActionReimage && node != nil && isNodeRecovered(*node, m.Since) {
+			err := r.completeRemediation(ctx, customObject, pool, m)
+			if err != nil {
+				return remediation{}, false, microerror.Mask(err)
+			}
+
+			return m, true, nil
+		}
+
+		if now.Sub(m.Since) > r.remediation.UnhealthyPeriod {
+			r.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("instance %s of node pool %#q did not recover within %s, remediating again", *instance.InstanceID, pool.Name, r.remediation.UnhealthyPeriod))
+
+			m.Phase = remediationPhaseDraining
+			m.Since = now
+		}
+
+		return m, false, nil
+	}
+
+	return m, false, nil
+}
+
+// replaceInstance reimages or deletes the given instance of the given node
+// pool, depending on the configured remediation action. The operation is not
+// awaited.
+func (r *Resource) replaceInstance(ctx context.Context, customObject providerv1alpha1.AzureConfig, pool key.NodePool, instanceID string) error {
+	c, err := r.getVMsClient(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	g := key.ResourceGroupName(customObject)
+	s := key.NodePoolVMSSName(customObject, pool.Name)
+
+	switch r.remediation.Action {
+	case setting.RemediationActionDelete:
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deleting instance %s of VMSS %s", instanceID, s))
+
+		_, err = c.Delete(ctx, g, s, instanceID)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deleted instance %s of VMSS %s", instanceID, s))
+		r.eventRecorder.Eventf(&customObject, corev1.EventTypeWarning, recorder.ReasonInstanceDeleted, "deleted unhealthy instance %s of VMSS %s", instanceID, s)
+	default:
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("reimaging instance %s of VMSS %s", instanceID, s))
+
+		_, err = c.Reimage(ctx, g, s, instanceID, nil)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("reimaged instance %s of VMSS %s", instanceID, s))
+		r.eventRecorder.Eventf(&customObject, corev1.EventTypeWarning, recorder.ReasonInstanceReimaged, "reimaged unhealthy instance %s of VMSS %s", instanceID, s)
+	}
+
+	return nil
+}
+
+// completeRemediation finishes the given remediation. Deleting instances
+// decreases the capacity of their VMSS, so it is restored. The nodes of
+// reimaged instances keep their name and get uncordoned.
+func (r *Resource) completeRemediation(ctx context.Context, customObject providerv1alpha1.AzureConfig, pool key.NodePool, m remediation) error {
+	vmssNameFunc := nodePoolVMSSNameFunc(pool.Name)
+
+	if r.remediation.Action == setting.RemediationActionDelete {
+		desired, err := r.getWorkerCount(ctx, customObject, pool)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		capacity, err := r.getInstancesCount(ctx, customObject, vmssNameFunc)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if capacity < int64(desired) {
+			err = r.scaleVMSS(ctx, customObject, vmssNameFunc, int64(desired))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	} else if m.Node != "" {
+		err := r.uncordonNode(ctx, m.Node)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if m.Node != "" {
+		err := r.drainer.Reset(ctx, customObject, m.Node)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	r.eventRecorder.Eventf(&customObject, corev1.EventTypeNormal, recorder.ReasonInstanceRemediated, "remediated instance %s of VMSS %s", m.InstanceID, vmssNameFunc(customObject))
+
+	return nil
+}
+
+func (r *Resource) uncordonNode(ctx context.Context, nodeName string) error {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("uncordoning node %s", nodeName))
+
+	patch := []byte(`{"spec":{"unschedulable":false}}`)
+	_, err = cc.Client.TenantCluster.K8s.CoreV1().Nodes().Patch(nodeName, types.MergePatchType, patch)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("uncordoned node %s", nodeName))
+
+	return nil
+}
+
+// getRemediations returns the remediations persisted in the resource status.
+func (r *Resource) getRemediations(customObject providerv1alpha1.AzureConfig) ([]remediation, error) {
+	s, err := r.getResourceStatus(customObject, Remediations)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	remediations, err := decodeRemediations(s)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return remediations, nil
+}
+
+// setRemediations persists the given remediations in the resource status,
+// unless they did not change.
+func (r *Resource) setRemediations(customObject providerv1alpha1.AzureConfig, current, desired []remediation) error {
+	c, err := encodeRemediations(current)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	d, err := encodeRemediations(desired)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if c == d {
+		return nil
+	}
+
+	err = r.setResourceStatus(customObject, Remediations, d)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func decodeRemediations(s string) ([]remediation, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var remediations []remediation
+	err := json.Unmarshal([]byte(s), &remediations)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return remediations, nil
+}
+
+func encodeRemediations(remediations []remediation) (string, error) {
+	if len(remediations) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(remediations)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return string(b), nil
+}
+
+// isNodeUnhealthy returns true when the given node is not ready for longer
+// than the given period. Nodes which never reported their readiness are
+// considered not ready since their creation.
+func isNodeUnhealthy(n corev1.Node, now time.Time, period time.Duration) bool {
+	since := n.GetCreationTimestamp().Time
+	for _, c := range n.Status.Conditions {
+		if c.Type != corev1.NodeReady {
+			continue
+		}
+		if c.Status == corev1.ConditionTrue {
+			return false
+		}
+
+		since = c.LastTransitionTime.Time
+	}
+
+	return now.Sub(since) > period
+}
+
+// isNodeRecovered returns true when the given node became ready after the given
+// time.
+func isNodeRecovered(n corev1.Node, after time.Time) bool {
+	for _, c := range n.Status.Conditions {
+		if c.Type == corev1.NodeReady && c.Status == corev1.ConditionTrue {
+			return c.LastTransitionTime.Time.After(after)
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,153 @@
+package instance
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_isNodeUnhealthy(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name           string
+		node           corev1.Node
+		expectedResult bool
+	}{
+		{
+			name:           "case 0: ready node",
+			node:           newReadyConditionNode(corev1.ConditionTrue, now.Add(-time.Hour)),
+			expectedResult: false,
+		},
+		{
+			name:           "case 1: node not ready for less than the unhealthy period",
+			node:           newReadyConditionNode(corev1.ConditionFalse, now.Add(-5*time.Minute)),
+			expectedResult: false,
+		},
+		{
+			name:           "case 2: node not ready for more than the unhealthy period",
+			node:           newReadyConditionNode(corev1.ConditionFalse, now.Add(-15*time.Minute)),
+			expectedResult: true,
+		},
+		{
+			name:           "case 3: node readiness unknown for more than the unhealthy period",
+			node:           newReadyConditionNode(corev1.ConditionUnknown, now.Add(-15*time.Minute)),
+			expectedResult: true,
+		},
+		{
+			name: "case 4: new node without readiness",
+			node: corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(now.Add(-time.Minute)),
+				},
+			},
+			expectedResult: false,
+		},
+		{
+			name: "case 5: old node without readiness",
+			node: corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+				},
+			},
+			expectedResult: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			result := isNodeUnhealthy(tc.node, now, 10*time.Minute)
+			if result != tc.expectedResult {
+				t.Fatalf("result == %t, want %t", result, tc.expectedResult)
+			}
+		})
+	}
+}
+
+func Test_isNodeRecovered(t *testing.T) {
+	replaced := time.Now().Add(-10 * time.Minute)
+
+	testCases := []struct {
+		name           string
+		node           corev1.Node
+		expectedResult bool
+	}{
+		{
+			name:           "case 0: node still not ready",
+			node:           newReadyConditionNode(corev1.ConditionFalse, replaced.Add(-time.Hour)),
+			expectedResult: false,
+		},
+		{
+			name:           "case 1: node ready since before the replacement",
+			node:           newReadyConditionNode(corev1.ConditionTrue, replaced.Add(-time.Minute)),
+			expectedResult: false,
+		},
+		{
+			name:           "case 2: node ready since the replacement",
+			node:           newReadyConditionNode(corev1.ConditionTrue, replaced.Add(5*time.Minute)),
+			expectedResult: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			result := isNodeRecovered(tc.node, replaced)
+			if result != tc.expectedResult {
+				t.Fatalf("result == %t, want %t", result, tc.expectedResult)
+			}
+		})
+	}
+}
+
+func Test_encodeRemediations(t *testing.T) {
+	since := time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	remediations := []remediation{
+		{NodePool: "default", InstanceID: "3", Node: "eggs2-worker-000003", Phase: remediationPhaseDraining, Since: since},
+		{NodePool: "mem", InstanceID: "7", Phase: remediationPhaseObserved, Since: since},
+	}
+
+	s, err := encodeRemediations(remediations)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	decoded, err := decodeRemediations(s)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	if !cmp.Equal(decoded, remediations) {
+		t.Fatalf("\n\n%s\n", cmp.Diff(remediations, decoded))
+	}
+
+	s, err = encodeRemediations(nil)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+	if s != "" {
+		t.Fatalf("Expected empty status but was %s", s)
+	}
+}
+
+func newReadyConditionNode(status corev1.ConditionStatus, lastTransition time.Time) corev1.Node {
+	return corev1.Node{
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{
+					Type:               corev1.NodeReady,
+					Status:             status,
+					LastTransitionTime: metav1.NewTime(lastTransition),
+				},
+			},
+		},
+	}
+}
@@ -11,10 +11,16 @@ import (
 	"github.com/giantswarm/micrologger"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
 	"github.com/giantswarm/azure-operator/v4/service/controller/debugger"
 	"github.com/giantswarm/azure-operator/v4/service/controller/encrypter"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/drainer"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/healthgate"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/maintenance"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/planner"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/quota"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/vmsscheck"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
@@ -26,30 +32,73 @@ const (
 )
 
 type Config struct {
-	Debugger  *debugger.Debugger
-	G8sClient versioned.Interface
-	K8sClient kubernetes.Interface
-	Logger    micrologger.Logger
-
-	Azure            setting.Azure
+	Debugger      *debugger.Debugger
+	EventRecorder record.EventRecorder
+	G8sClient     versioned.Interface
+	K8sClient     kubernetes.Interface
+	Logger        micrologger.Logger
+
+	Azure setting.Azure
+	// Canary holds the defaults of the canary phase of worker upgrades, which
+	// can be overridden per cluster using annotations.
+	Canary setting.Canary
+	// CanaryGates are the health gates canary nodes have to pass before the
+	// other workers get replaced.
+	CanaryGates []healthgate.Gate
+	// Drainer drains the nodes of old worker instances before they get
+	// terminated.
+	Drainer          drainer.Interface
 	InstanceWatchdog vmsscheck.InstanceWatchdog
+	// Maintenance restricts rolling upgrades of the worker instances to the
+	// maintenance windows of the cluster.
+	Maintenance maintenance.Interface
+	// Planner approves the deployment of the ARM template.
+	Planner planner.Interface
+	// QuotaChecker blocks surging the capacity of worker VMSSs beyond the
+	// compute quota of the subscription.
+	QuotaChecker quota.Interface
+	// Remediation holds the settings of the replacement of worker instances
+	// whose nodes are unhealthy.
+	Remediation setting.Remediation
+	// Rollback holds the settings of rolling back failed upgrades of the
+	// worker instances.
+	Rollback setting.Rollback
+	// RollingUpdate holds the defaults of the rolling update settings, which
+	// can be overridden per cluster using annotations.
+	RollingUpdate setting.RollingUpdate
 }
 
 type Resource struct {
-	debugger     *debugger.Debugger
-	g8sClient    versioned.Interface
-	k8sClient    kubernetes.Interface
-	logger       micrologger.Logger
-	stateMachine state.Machine
+	debugger      *debugger.Debugger
+	eventRecorder record.EventRecorder
+	g8sClient     versioned.Interface
+	k8sClient     kubernetes.Interface
+	logger        micrologger.Logger
+	stateMachine  state.Machine
 
 	azure            setting.Azure
+	canary           setting.Canary
+	canaryGates      []healthgate.Gate
+	drainer          drainer.Interface
 	instanceWatchdog vmsscheck.InstanceWatchdog
+	maintenance      maintenance.Interface
+	planner          planner.Interface
+	quotaChecker     quota.Interface
+	remediation      setting.Remediation
+	rollback         setting.Rollback
+	rollingUpdate    setting.RollingUpdate
 }
 
 func New(config Config) (*Resource, error) {
 	if config.Debugger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Debugger must not be empty", config)
 	}
+	if config.Drainer == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Drainer must not be empty", config)
+	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.G8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.G8sClient must not be empty", config)
 	}
@@ -62,19 +111,50 @@ func New(config Config) (*Resource, error) {
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if config.Maintenance == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Maintenance must not be empty", config)
+	}
+	if config.Planner == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Planner must not be empty", config)
+	}
+	if config.QuotaChecker == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.QuotaChecker must not be empty", config)
+	}
 
 	if err := config.Azure.Validate(); err != nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Azure.%s", config, err)
 	}
+	if err := config.Canary.Validate(); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Canary.%s", config, err)
+	}
+	if err := config.Remediation.Validate(); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Remediation.%s", config, err)
+	}
+	if err := config.Rollback.Validate(); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Rollback.%s", config, err)
+	}
+	if err := config.RollingUpdate.Validate(); err != nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.RollingUpdate.%s", config, err)
+	}
 
 	r := &Resource{
-		debugger:  config.Debugger,
-		g8sClient: config.G8sClient,
-		k8sClient: config.K8sClient,
-		logger:    config.Logger,
+		debugger:      config.Debugger,
+		eventRecorder: config.EventRecorder,
+		g8sClient:     config.G8sClient,
+		k8sClient:     config.K8sClient,
+		logger:        config.Logger,
 
 		azure:            config.Azure,
+		canary:           config.Canary,
+		canaryGates:      config.CanaryGates,
+		drainer:          config.Drainer,
 		instanceWatchdog: config.InstanceWatchdog,
+		maintenance:      config.Maintenance,
+		planner:          config.Planner,
+		quotaChecker:     config.QuotaChecker,
+		remediation:      config.Remediation,
+		rollback:         config.Rollback,
+		rollingUpdate:    config.RollingUpdate,
 	}
 
 	r.configureStateMachine()
@@ -0,0 +1,131 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// upgradeStates are the states of the state machine replacing old worker
+// instances in which failures count towards rolling the upgrade back.
+var upgradeStates = map[state.State]bool{
+	ScaleUpWorkerVMSS:           true,
+	CordonOldWorkers:            true,
+	WaitForWorkersToBecomeReady: true,
+	DrainOldWorkerNodes:         true,
+	TerminateOldWorkerInstances: true,
+}
+
+// countUpgradeFailure counts the given failure of the transition of the given
+// state. Once the failures of the state reach the configured maximum, the
+// state machine is moved to RollbackWorkers.
+func (r *Resource) countUpgradeFailure(ctx context.Context, customObject providerv1alpha1.AzureConfig, currentState state.State, transitionErr error) error {
+	if r.rollback.MaxFailures == 0 || !upgradeStates[currentState] {
+		return nil
+	}
+
+	s, err := r.getResourceStatus(customObject, UpgradeFailures)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var failures int
+	if s != "" {
+		failures, err = strconv.Atoi(s)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+	failures++
+
+	if failures < r.rollback.MaxFailures {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("upgrade failed %d of %d times before rolling back", failures, r.rollback.MaxFailures))
+
+		err = r.setResourceStatus(customObject, UpgradeFailures, strconv.Itoa(failures))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	}
+
+	reason := fmt.Sprintf("state %s failed %d times, last with: %s", currentState, failures, transitionErr)
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("rolling back upgrade: %s", reason))
+
+	err = r.setResourceStatus(customObject, UpgradeFailureReason, reason)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	err = r.setResourceStatus(customObject, UpgradeFailures, "")
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	err = r.setResourceStatus(customObject, Stage, RollbackWorkers)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	r.recordTransition(ctx, customObject, currentState, RollbackWorkers, nil)
+
+	return nil
+}
+
+// resetUpgradeFailures clears the failures counted by countUpgradeFailure.
+func (r *Resource) resetUpgradeFailures(customObject providerv1alpha1.AzureConfig) error {
+	s, err := r.getResourceStatus(customObject, UpgradeFailures)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	if s == "" {
+		return nil
+	}
+
+	err = r.setResourceStatus(customObject, UpgradeFailures, "")
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// sortWorkerInstancesForRollback sorts the given worker instances of the given
+// node pool into the nodes of old instances, which are kept when rolling back
+// an upgrade, and the instances created for the upgrade, which are deleted.
+// Rolling back happens before the previous deployment is applied again, so
+// instances running the latest model of the VMSS were created for the upgrade,
+// whether or not their nodes joined. All others are old instances, which are
+// kept even when they have no node.
+func sortWorkerInstancesForRollback(customObject providerv1alpha1.AzureConfig, pool key.NodePool, instances []compute.VirtualMachineScaleSetVM, nodes []corev1.Node) (oldNodes []corev1.Node, newInstances []compute.VirtualMachineScaleSetVM) {
+	nodeMap := make(map[string]corev1.Node)
+	for _, n := range nodes {
+		nodeMap[n.GetName()] = n
+	}
+
+	for _, i := range instances {
+		if i.VirtualMachineScaleSetVMProperties != nil && to.Bool(i.LatestModelApplied) {
+			newInstances = append(newInstances, i)
+			continue
+		}
+
+		n, found := nodeMap[key.NodePoolInstanceName(customObject, pool.Name, *i.InstanceID)]
+		if !found && pool.IsDefault() {
+			n, found = nodeMap[key.LegacyWorkerInstanceName(customObject, *i.InstanceID)]
+		}
+		if !found {
+			continue
+		}
+
+		oldNodes = append(oldNodes, n)
+	}
+
+	return oldNodes, newInstances
+}
@@ -0,0 +1,269 @@
+package instance
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	g8sfake "github.com/giantswarm/apiextensions/pkg/clientset/versioned/fake"
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/giantswarm/azure-operator/v4/pkg/checksum"
+	"github.com/giantswarm/azure-operator/v4/pkg/label"
+	"github.com/giantswarm/azure-operator/v4/pkg/project"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/planner"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// Test_rollback_previousDeploymentApproved ensures the previous deployment
+// read back from the deployment history can be approved in dry-run mode,
+// although its parameters cannot be checksummed anymore.
+func Test_rollback_previousDeploymentApproved(t *testing.T) {
+	deployment := azureresource.Deployment{
+		Properties: &azureresource.DeploymentProperties{
+			Mode: azureresource.Incremental,
+			Parameters: key.ToParameters(map[string]interface{}{
+				"vmSize":                "Standard_D4s_v3",
+				"workerCloudConfigData": base64.StdEncoding.EncodeToString([]byte(`{"ignition":{"config":{}}}`)),
+			}),
+			Template: map[string]interface{}{"resources": []interface{}{}},
+		},
+	}
+
+	templateChk, err := checksum.GetDeploymentTemplateChecksum(deployment)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+	parametersChk, err := checksum.GetDeploymentParametersChecksum(deployment)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	var previous deploymentRecord
+	{
+		data, err := encodeDeploymentHistory(deploymentHistory{Current: &deploymentRecord{TemplateChecksum: templateChk, ParametersChecksum: parametersChk, Deployment: deployment}})
+		if err != nil {
+			t.Fatalf("error == %#v, want nil", err)
+		}
+		h, err := decodeDeploymentHistory(data)
+		if err != nil {
+			t.Fatalf("error == %#v, want nil", err)
+		}
+		previous = *h.Current
+	}
+
+	testCases := []struct {
+		name               string
+		annotations        map[string]string
+		templateChecksum   string
+		parametersChecksum string
+		expectedApproved   bool
+		errorMatcher       func(error) bool
+	}{
+		{
+			name:               "case 0: plan of the previous deployment approved",
+			annotations:        map[string]string{key.AnnotationApprovedPlans: planner.PlanID(templateChk, parametersChk)},
+			templateChecksum:   previous.TemplateChecksum,
+			parametersChecksum: previous.ParametersChecksum,
+			expectedApproved:   true,
+		},
+		{
+			name:               "case 1: dry-run disabled for the cluster",
+			annotations:        map[string]string{key.AnnotationDryRun: "false"},
+			templateChecksum:   previous.TemplateChecksum,
+			parametersChecksum: previous.ParametersChecksum,
+			expectedApproved:   true,
+		},
+		{
+			name:             "case 2: previous deployment recorded without checksums",
+			annotations:      map[string]string{key.AnnotationApprovedPlans: planner.PlanID(templateChk, parametersChk)},
+			templateChecksum: previous.TemplateChecksum,
+			errorMatcher:     planner.IsInvalidChecksum,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			cr := providerv1alpha1.AzureConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+
+			c := planner.Config{
+				EventRecorder: record.NewFakeRecorder(10),
+				G8sClient:     g8sfake.NewSimpleClientset(),
+				Logger:        microloggertest.New(),
+
+				DryRun: true,
+			}
+
+			p, err := planner.New(c)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			approved, err := p.ApprovedWithChecksums(context.Background(), cr, key.WorkersVmssDeploymentName, previous.Deployment, tc.templateChecksum, tc.parametersChecksum)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if approved != tc.expectedApproved {
+				t.Fatalf("approved == %t, want %t", approved, tc.expectedApproved)
+			}
+		})
+	}
+}
+
+func Test_sortWorkerInstancesForRollback(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		pool                 key.NodePool
+		instances            []compute.VirtualMachineScaleSetVM
+		nodes                []corev1.Node
+		expectedOldNodes     []string
+		expectedNewInstances []string
+	}{
+		{
+			name: "case 0: old and new instances of the default node pool",
+			pool: key.NodePool{Name: key.DefaultNodePoolName},
+			instances: []compute.VirtualMachineScaleSetVM{
+				newTestInstance("0", false),
+				newTestInstance("1", false),
+				newTestInstance("2", true),
+				newTestInstance("3", true),
+			},
+			nodes: []corev1.Node{
+				newVersionedNode("eggs2-worker-000000", "4.0.0"),
+				newVersionedNode("eggs2-worker-000001", "4.0.0"),
+				newVersionedNode("eggs2-worker-000002", project.Version()),
+			},
+			expectedOldNodes:     []string{"eggs2-worker-000000", "eggs2-worker-000001"},
+			expectedNewInstances: []string{"2", "3"},
+		},
+		{
+			name: "case 1: node which did not finish bootstrapping",
+			pool: key.NodePool{Name: "mem"},
+			instances: []compute.VirtualMachineScaleSetVM{
+				newTestInstance("0", false),
+				newTestInstance("1", true),
+			},
+			nodes: []corev1.Node{
+				newVersionedNode("eggs2-worker-mem-000000", "4.0.0"),
+				newVersionedNode("eggs2-worker-mem-000001", ""),
+			},
+			expectedOldNodes:     []string{"eggs2-worker-mem-000000"},
+			expectedNewInstances: []string{"1"},
+		},
+		{
+			name: "case 2: nothing to roll back",
+			pool: key.NodePool{Name: key.DefaultNodePoolName},
+			instances: []compute.VirtualMachineScaleSetVM{
+				newTestInstance("0", false),
+			},
+			nodes: []corev1.Node{
+				newVersionedNode("eggs2-worker-000000", "4.0.0"),
+			},
+			expectedOldNodes: []string{"eggs2-worker-000000"},
+		},
+		{
+			name: "case 3: old instance without node is kept",
+			pool: key.NodePool{Name: key.DefaultNodePoolName},
+			instances: []compute.VirtualMachineScaleSetVM{
+				newTestInstance("0", false),
+				newTestInstance("1", false),
+				newTestInstance("2", true),
+			},
+			nodes: []corev1.Node{
+				newVersionedNode("eggs2-worker-000000", "4.0.0"),
+			},
+			expectedOldNodes:     []string{"eggs2-worker-000000"},
+			expectedNewInstances: []string{"2"},
+		},
+		{
+			name: "case 4: instance without model information is kept",
+			pool: key.NodePool{Name: key.DefaultNodePoolName},
+			instances: []compute.VirtualMachineScaleSetVM{
+				{InstanceID: to.StringPtr("0")},
+			},
+			nodes: []corev1.Node{
+				newVersionedNode("eggs2-worker-000000", "4.0.0"),
+			},
+			expectedOldNodes: []string{"eggs2-worker-000000"},
+		},
+	}
+
+	cr := providerv1alpha1.AzureConfig{
+		Spec: providerv1alpha1.AzureConfigSpec{
+			Cluster: providerv1alpha1.Cluster{
+				ID: "eggs2",
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			oldNodes, newInstances := sortWorkerInstancesForRollback(cr, tc.pool, tc.instances, tc.nodes)
+
+			var oldNodeNames []string
+			for _, n := range oldNodes {
+				oldNodeNames = append(oldNodeNames, n.Name)
+			}
+			var newInstanceIDs []string
+			for _, i := range newInstances {
+				newInstanceIDs = append(newInstanceIDs, *i.InstanceID)
+			}
+
+			if !cmp.Equal(oldNodeNames, tc.expectedOldNodes) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedOldNodes, oldNodeNames))
+			}
+			if !cmp.Equal(newInstanceIDs, tc.expectedNewInstances) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedNewInstances, newInstanceIDs))
+			}
+		})
+	}
+}
+
+func newTestInstance(id string, latestModelApplied bool) compute.VirtualMachineScaleSetVM {
+	return compute.VirtualMachineScaleSetVM{
+		InstanceID: to.StringPtr(id),
+		VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{
+			LatestModelApplied: to.BoolPtr(latestModelApplied),
+		},
+	}
+}
+
+func newVersionedNode(name string, version string) corev1.Node {
+	n := corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{},
+		},
+	}
+	if version != "" {
+		n.Labels[label.OperatorVersion] = version
+	}
+
+	return n
+}
@@ -0,0 +1,178 @@
+package instance
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/coreos/go-semver/semver"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/giantswarm/azure-operator/v4/pkg/label"
+	"github.com/giantswarm/azure-operator/v4/pkg/project"
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
+)
+
+// rollingUpdate holds the rolling update settings of a cluster resolved
+// against its desired number of workers.
+//
+// Old worker instances are replaced in batches. For every batch the worker
+// VMSS is scaled up by maxSurge instances, then maxSurge+maxUnavailable old
+// instances are cordoned, drained and terminated. This is repeated until no
+// old instances are left.
+type rollingUpdate struct {
+	maxSurge       int
+	maxUnavailable int
+}
+
+// getRollingUpdate returns the rolling update settings of the given cluster
+// with the given number of workers, taking the annotations of the cluster into
+// account.
+func (r *Resource) getRollingUpdate(cr providerv1alpha1.AzureConfig, workerCount int) (rollingUpdate, error) {
+	c := setting.RollingUpdate{
+		MaxSurge:       key.WorkerMaxSurge(cr, r.rollingUpdate.MaxSurge),
+		MaxUnavailable: key.WorkerMaxUnavailable(cr, r.rollingUpdate.MaxUnavailable),
+	}
+
+	u, err := newRollingUpdate(c, workerCount)
+	if err != nil {
+		return rollingUpdate{}, microerror.Mask(err)
+	}
+
+	return u, nil
+}
+
+func newRollingUpdate(c setting.RollingUpdate, workerCount int) (rollingUpdate, error) {
+	err := c.Validate()
+	if err != nil {
+		return rollingUpdate{}, microerror.Maskf(invalidConfigError, "rolling update settings: %s", err)
+	}
+
+	surge := intstr.Parse(c.MaxSurge)
+	maxSurge, err := intstr.GetValueFromIntOrPercent(&surge, workerCount, true)
+	if err != nil {
+		return rollingUpdate{}, microerror.Mask(err)
+	}
+
+	unavailable := intstr.Parse(c.MaxUnavailable)
+	maxUnavailable, err := intstr.GetValueFromIntOrPercent(&unavailable, workerCount, false)
+	if err != nil {
+		return rollingUpdate{}, microerror.Mask(err)
+	}
+
+	// At least one instance has to be replaced at once, otherwise the
+	// upgrade would never finish.
+	if maxSurge == 0 && maxUnavailable == 0 {
+		maxSurge = 1
+	}
+
+	u := rollingUpdate{
+		maxSurge:       maxSurge,
+		maxUnavailable: maxUnavailable,
+	}
+
+	return u, nil
+}
+
+// batchSize returns the number of old instances replaced at once.
+func (u rollingUpdate) batchSize() int {
+	return u.maxSurge + u.maxUnavailable
+}
+
+// desiredCapacity returns the capacity of the worker VMSS required before the
+// next batch of old instances is taken out of service. There is no need to
+// surge above the number of old instances left.
+func (u rollingUpdate) desiredCapacity(workerCount int, oldCount int) int64 {
+	surge := u.maxSurge
+	if oldCount < surge {
+		surge = oldCount
+	}
+
+	return int64(workerCount + surge)
+}
+
+// nextBatch returns the old nodes to replace next. Nodes which are already
+// cordoned are always part of the batch, so that the batch stays the same
+// across reconciliation loops. The other nodes are picked in name order.
+func nextBatch(oldNodes []corev1.Node, size int) []corev1.Node {
+	nodes := make([]corev1.Node, len(oldNodes))
+	copy(nodes, oldNodes)
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Spec.Unschedulable != nodes[j].Spec.Unschedulable {
+			return nodes[i].Spec.Unschedulable
+		}
+
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	var batch []corev1.Node
+	for _, n := range nodes {
+		if len(batch) >= size && !n.Spec.Unschedulable {
+			break
+		}
+
+		batch = append(batch, n)
+	}
+
+	return batch
+}
+
+// sortWorkerInstancesByBatch sorts the given worker instances of the given
+// node pool into the ones in the batch currently being replaced, i.e. old
+// instances whose nodes are cordoned, and the old instances left for later
+// batches. Instances without a node are considered part of the batch.
+// Instances whose nodes did not finish bootstrapping yet and new instances are
+// in neither list.
+func (r *Resource) sortWorkerInstancesByBatch(ctx context.Context, customObject providerv1alpha1.AzureConfig, pool key.NodePool, instances []compute.VirtualMachineScaleSetVM) (batch []compute.VirtualMachineScaleSetVM, remaining []compute.VirtualMachineScaleSetVM, err error) {
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return nil, nil, microerror.Mask(err)
+	}
+
+	nodeList, err := cc.Client.TenantCluster.K8s.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, microerror.Mask(err)
+	}
+
+	nodeMap := make(map[string]corev1.Node)
+	for _, n := range nodeList.Items {
+		nodeMap[n.GetName()] = n
+	}
+
+	myVersion := semver.New(project.Version())
+
+	for _, i := range instances {
+		n, found := nodeMap[key.NodePoolInstanceName(customObject, pool.Name, *i.InstanceID)]
+		if !found && pool.IsDefault() {
+			n, found = nodeMap[key.LegacyWorkerInstanceName(customObject, *i.InstanceID)]
+		}
+		if !found {
+			batch = append(batch, i)
+			continue
+		}
+
+		v, exists := n.GetLabels()[label.OperatorVersion]
+		if !exists {
+			continue
+		}
+
+		if !semver.New(v).LessThan(*myVersion) {
+			continue
+		}
+
+		if n.Spec.Unschedulable {
+			batch = append(batch, i)
+		} else {
+			remaining = append(remaining, i)
+		}
+	}
+
+	return batch, remaining, nil
+}
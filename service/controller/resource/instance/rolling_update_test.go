@@ -0,0 +1,186 @@
+package instance
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
+)
+
+func Test_newRollingUpdate(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		setting               setting.RollingUpdate
+		workerCount           int
+		expectedRollingUpdate rollingUpdate
+		errorMatcher          func(error) bool
+	}{
+		{
+			name:                  "case 0: default doubles the workers",
+			setting:               setting.RollingUpdate{MaxSurge: "100%", MaxUnavailable: "0"},
+			workerCount:           10,
+			expectedRollingUpdate: rollingUpdate{maxSurge: 10, maxUnavailable: 0},
+		},
+		{
+			name:                  "case 1: surge rounds up and unavailable rounds down",
+			setting:               setting.RollingUpdate{MaxSurge: "25%", MaxUnavailable: "25%"},
+			workerCount:           10,
+			expectedRollingUpdate: rollingUpdate{maxSurge: 3, maxUnavailable: 2},
+		},
+		{
+			name:                  "case 2: absolute values",
+			setting:               setting.RollingUpdate{MaxSurge: "0", MaxUnavailable: "2"},
+			workerCount:           10,
+			expectedRollingUpdate: rollingUpdate{maxSurge: 0, maxUnavailable: 2},
+		},
+		{
+			name:                  "case 3: at least one instance is replaced",
+			setting:               setting.RollingUpdate{MaxSurge: "0", MaxUnavailable: "10%"},
+			workerCount:           3,
+			expectedRollingUpdate: rollingUpdate{maxSurge: 1, maxUnavailable: 0},
+		},
+		{
+			name:         "case 4: invalid value",
+			setting:      setting.RollingUpdate{MaxSurge: "many", MaxUnavailable: "0"},
+			workerCount:  3,
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name:         "case 5: negative value",
+			setting:      setting.RollingUpdate{MaxSurge: "1", MaxUnavailable: "-1"},
+			workerCount:  3,
+			errorMatcher: IsInvalidConfig,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			u, err := newRollingUpdate(tc.setting, tc.workerCount)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if u != tc.expectedRollingUpdate {
+				t.Fatalf("rolling update == %#v, want %#v", u, tc.expectedRollingUpdate)
+			}
+		})
+	}
+}
+
+func Test_rollingUpdate_desiredCapacity(t *testing.T) {
+	testCases := []struct {
+		name             string
+		rollingUpdate    rollingUpdate
+		workerCount      int
+		oldCount         int
+		expectedCapacity int64
+	}{
+		{
+			name:             "case 0: surge on top of the desired workers",
+			rollingUpdate:    rollingUpdate{maxSurge: 3, maxUnavailable: 1},
+			workerCount:      10,
+			oldCount:         10,
+			expectedCapacity: 13,
+		},
+		{
+			name:             "case 1: surge is limited to the old workers left",
+			rollingUpdate:    rollingUpdate{maxSurge: 3, maxUnavailable: 1},
+			workerCount:      10,
+			oldCount:         2,
+			expectedCapacity: 12,
+		},
+		{
+			name:             "case 2: no surge when all workers are new",
+			rollingUpdate:    rollingUpdate{maxSurge: 3, maxUnavailable: 1},
+			workerCount:      10,
+			oldCount:         0,
+			expectedCapacity: 10,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			capacity := tc.rollingUpdate.desiredCapacity(tc.workerCount, tc.oldCount)
+
+			if capacity != tc.expectedCapacity {
+				t.Fatalf("capacity == %d, want %d", capacity, tc.expectedCapacity)
+			}
+		})
+	}
+}
+
+func Test_nextBatch(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nodes         []corev1.Node
+		size          int
+		expectedNames []string
+	}{
+		{
+			name:          "case 0: nodes are picked in name order",
+			nodes:         []corev1.Node{newNode("c", false), newNode("a", false), newNode("b", false)},
+			size:          2,
+			expectedNames: []string{"a", "b"},
+		},
+		{
+			name:          "case 1: cordoned nodes are picked first",
+			nodes:         []corev1.Node{newNode("c", true), newNode("a", false), newNode("b", false)},
+			size:          2,
+			expectedNames: []string{"c", "a"},
+		},
+		{
+			name:          "case 2: all cordoned nodes are kept in the batch",
+			nodes:         []corev1.Node{newNode("c", true), newNode("a", false), newNode("b", true)},
+			size:          1,
+			expectedNames: []string{"b", "c"},
+		},
+		{
+			name:          "case 3: batch is larger than the old nodes left",
+			nodes:         []corev1.Node{newNode("a", false)},
+			size:          5,
+			expectedNames: []string{"a"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var names []string
+			for _, n := range nextBatch(tc.nodes, tc.size) {
+				names = append(names, n.Name)
+			}
+
+			if !cmp.Equal(names, tc.expectedNames) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedNames, names))
+			}
+		})
+	}
+}
+
+func newNode(name string, unschedulable bool) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: corev1.NodeSpec{
+			Unschedulable: unschedulable,
+		},
+	}
+}
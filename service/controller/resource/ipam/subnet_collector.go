@@ -7,7 +7,6 @@ import (
 	"reflect"
 	"sync"
 
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/ipam"
 	"github.com/giantswarm/k8sclient"
@@ -18,29 +17,31 @@ import (
 	client2 "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/azure-operator/v4/client"
-	"github.com/giantswarm/azure-operator/v4/pkg/credential"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
 
 type SubnetCollectorConfig struct {
-	GSClientCredentialsConfig auth.ClientCredentialsConfig
-	K8sClient                 k8sclient.Interface
-	InstallationName          string
-	Logger                    micrologger.Logger
+	AzureClientSetCache *client.Cache
+	K8sClient           k8sclient.Interface
+	InstallationName    string
+	Logger              micrologger.Logger
 
 	NetworkRange net.IPNet
 }
 
 type SubnetCollector struct {
-	gsClientCredentialsConfig auth.ClientCredentialsConfig
-	k8sclient                 k8sclient.Interface
-	installationName          string
-	logger                    micrologger.Logger
+	azureClientSetCache *client.Cache
+	k8sclient           k8sclient.Interface
+	installationName    string
+	logger              micrologger.Logger
 
 	networkRange net.IPNet
 }
 
 func NewSubnetCollector(config SubnetCollectorConfig) (*SubnetCollector, error) {
+	if config.AzureClientSetCache == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.AzureClientSetCache must not be empty", config)
+	}
 	if config.K8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
 	}
@@ -56,9 +57,10 @@ func NewSubnetCollector(config SubnetCollectorConfig) (*SubnetCollector, error)
 	}
 
 	c := &SubnetCollector{
-		k8sclient:        config.K8sClient,
-		installationName: config.InstallationName,
-		logger:           config.Logger,
+		azureClientSetCache: config.AzureClientSetCache,
+		k8sclient:           config.K8sClient,
+		installationName:    config.InstallationName,
+		logger:              config.Logger,
 
 		networkRange: config.NetworkRange,
 	}
@@ -148,12 +150,19 @@ func (c *SubnetCollector) getSubnetsFromAllSubscriptions(ctx context.Context) ([
 	var doneSubscriptions []string
 	var ret []net.IPNet
 	for _, cluster := range tenantClusterList.Items {
-		organizationAzureClientCredentialsConfig, subscriptionID, partnerID, err := credential.GetOrganizationAzureCredentials(c.k8sclient, cluster, c.gsClientCredentialsConfig.TenantID)
+		check, err := c.azureClientSetCache.CheckOrganizationCredentials(ctx, cluster)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
+		if check.Failed() {
+			// We can't use the Azure credentials of this cluster. We shouldn't
+			// block the network calculation for this reason. The result of the
+			// credential check is reported in the cluster's status and metrics.
+			c.logger.LogCtx(ctx, "level", "error", "message", fmt.Sprintf("skipping subnets of cluster %#q because its credentials are unusable", key.ClusterID(cluster)), "status", check.Status, "reason", check.Message)
+			continue
+		}
 
-		organizationAzureClientSet, err := client.NewAzureClientSet(organizationAzureClientCredentialsConfig, subscriptionID, partnerID)
+		organizationAzureClientSet, _, err := c.azureClientSetCache.GetOrganizationClientSet(cluster)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
@@ -163,12 +172,12 @@ func (c *SubnetCollector) getSubnetsFromAllSubscriptions(ctx context.Context) ([
 			continue
 		}
 
+		// The credentials are valid, so failing to list the subnets is
+		// transient. Allocating a subnet without knowing the subnets in use in
+		// this subscription risks overlapping CIDRs, so we fail instead.
 		nets, err := c.getSubnetsFromSubscription(ctx, organizationAzureClientSet)
 		if err != nil {
-			// We can't use this Azure credentials. Might be wrong in the Secret file.
-			// We shouldn't block the network calculation for this reason.
-			c.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("Error getting used subnets for subscription %s: %s", organizationAzureClientSet.SubscriptionID, err))
-			continue
+			return nil, microerror.Mask(err)
 		}
 
 		doneSubscriptions = append(doneSubscriptions, organizationAzureClientSet.SubscriptionID)
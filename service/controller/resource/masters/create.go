@@ -3,6 +3,7 @@ package masters
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/giantswarm/microerror"
 
@@ -10,6 +11,17 @@ import (
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
 
+const (
+	// Deadlines of the states waiting for the tenant cluster, for etcd to be
+	// backed up or restored or for the kubelets of the workers to be
+	// restarted. When exceeded the state machine escalates to
+	// ManualInterventionRequired or RestoreFailed.
+	etcdRestoreDeadline    = 1 * time.Hour
+	etcdSnapshotDeadline   = 1 * time.Hour
+	restartKubeletDeadline = 1 * time.Hour
+	waitForMastersDeadline = 1 * time.Hour
+)
+
 // configureStateMachine configures and returns state machine that is driven by
 // EnsureCreated.
 func (r *Resource) configureStateMachine() {
@@ -30,11 +42,22 @@ func (r *Resource) configureStateMachine() {
 			MasterInstancesUpgrading:       r.masterInstancesUpgradingTransition,
 			WaitForMastersToBecomeReady:    r.waitForMastersToBecomeReadyTransition,
 			WaitForRestore:                 r.waitForRestoreTransition,
+			VerifyRestore:                  r.verifyRestoreTransition,
+			RestoreFailed:                  r.restoreFailedTransition,
 			DeleteLegacyVMSS:               r.deleteLegacyVMSSTransition,
 			UnblockAPICalls:                r.unblockAPICallsTransition,
 			RestartKubeletOnWorkers:        r.restartKubeletOnWorkersTransition,
 			DeploymentCompleted:            r.deploymentCompletedTransition,
 		},
+		Timeouts: state.TimeoutMap{
+			WaitForBackupConfirmation:   {Deadline: etcdSnapshotDeadline, Escalate: ManualInterventionRequired},
+			WaitForMastersToBecomeReady: {Deadline: waitForMastersDeadline, Escalate: ManualInterventionRequired},
+			WaitForRestore:              {Deadline: etcdRestoreDeadline, Escalate: RestoreFailed},
+			VerifyRestore:               {Deadline: etcdRestoreDeadline, Escalate: RestoreFailed},
+			RestartKubeletOnWorkers:     {Deadline: restartKubeletDeadline, Escalate: ManualInterventionRequired},
+		},
+		EnteredAtFunc:  r.stateEnteredAt,
+		EscalationFunc: r.escalateState,
 	}
 
 	r.stateMachine = sm
@@ -59,8 +82,11 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("current state: %s", currentState))
 		newState, err = r.stateMachine.Execute(ctx, obj, currentState)
 		if err != nil {
+			r.recordTransition(ctx, cr, currentState, currentState, err)
 			return microerror.Mask(err)
 		}
+
+		state.ReportState(Name, key.ClusterID(cr), newState)
 	}
 
 	if newState != currentState {
@@ -71,6 +97,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 			return microerror.Mask(err)
 		}
 		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("set resource status to '%s/%s'", Stage, newState))
+		r.recordTransition(ctx, cr, currentState, newState, nil)
 		r.logger.LogCtx(ctx, "level", "debug", "message", "canceling reconciliation")
 	} else {
 		r.logger.LogCtx(ctx, "level", "debug", "message", "no state change")
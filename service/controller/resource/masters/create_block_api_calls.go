@@ -146,6 +146,22 @@ func (r *Resource) deleteSecurityRule(ctx context.Context, resourceGroup string,
 
 func getMasterRules() []namedRule {
 	mastersRules := []namedRule{
+		{
+			name: fmt.Sprintf("%s-storage", temporarySecurityRuleName),
+			rule: &network.SecurityRule{
+				SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+					Access:                   network.SecurityRuleAccessAllow,
+					Description:              to.StringPtr("Temporarily allow masters to download the etcd snapshot during flatcar migration"),
+					DestinationPortRange:     to.StringPtr("443"),
+					DestinationAddressPrefix: to.StringPtr("Storage"),
+					Direction:                network.SecurityRuleDirectionOutbound,
+					Protocol:                 network.SecurityRuleProtocolTCP,
+					Priority:                 to.Int32Ptr(2999),
+					SourceAddressPrefix:      to.StringPtr("*"),
+					SourcePortRange:          to.StringPtr("*"),
+				},
+			},
+		},
 		{
 			name: fmt.Sprintf("%s-outbound", temporarySecurityRuleName),
 			rule: &network.SecurityRule{
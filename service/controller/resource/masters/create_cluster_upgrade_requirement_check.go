@@ -2,6 +2,7 @@ package masters
 
 import (
 	"context"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
@@ -38,6 +39,15 @@ func (r *Resource) clusterUpgradeRequirementCheckTransition(ctx context.Context,
 	if !isCreating && anyOldNodes {
 		// Only continue rolling nodes when cluster is not creating and there
 		// are old nodes in tenant cluster.
+		open, err := r.maintenance.Open(cr, time.Now())
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+		if !open {
+			r.logger.LogCtx(ctx, "level", "debug", "message", "waiting for a maintenance window to roll master nodes")
+			return currentState, nil
+		}
+
 		return MasterInstancesUpgrading, nil
 	}
 
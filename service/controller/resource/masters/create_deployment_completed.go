@@ -36,6 +36,7 @@ func (r *Resource) deploymentCompletedTransition(ctx context.Context, obj interf
 	}
 
 	s := *d.Properties.ProvisioningState
+	r.debugger.ReportDeployment(key.ClusterID(cr), d)
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deployment is in state '%s'", s))
 
 	group, err := groupsClient.Get(ctx, key.ClusterID(cr))
@@ -31,10 +31,11 @@ func (r *Resource) deploymentInitializedTransition(ctx context.Context, obj inte
 	}
 
 	s := *d.Properties.ProvisioningState
+	r.debugger.ReportDeployment(key.ClusterID(cr), d)
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deployment is in state '%s'", s))
 
 	if !key.IsSucceededProvisioningState(s) {
-		r.debugger.LogFailedDeployment(ctx, d, err)
+		r.debugger.LogFailedDeployment(ctx, &cr, d, err)
 
 		if key.IsFinalProvisioningState(s) {
 			// Deployment is not running and not succeeded (Failed?)
@@ -51,6 +51,16 @@ func (r *Resource) deploymentUninitializedTransition(ctx context.Context, obj in
 	} else if err != nil {
 		return currentState, microerror.Mask(err)
 	} else {
+		approved, err := r.planner.Approved(ctx, cr, key.MastersVmssDeploymentName, computedDeployment)
+		if err != nil {
+			return currentState, microerror.Mask(err)
+		}
+		if !approved {
+			r.logger.LogCtx(ctx, "level", "debug", "message", "deployment awaits approval")
+			r.logger.LogCtx(ctx, "level", "debug", "message", "did not ensure deployment")
+			return currentState, nil
+		}
+
 		res, err := deploymentsClient.CreateOrUpdate(ctx, key.ClusterID(cr), key.MastersVmssDeploymentName, computedDeployment)
 		if err != nil {
 			return currentState, microerror.Mask(err)
@@ -96,7 +106,7 @@ func (r *Resource) deploymentUninitializedTransition(ctx context.Context, obj in
 		}
 
 		// Start watcher on the instances to avoid stuck VMs to block the deployment progress forever
-		r.instanceWatchdog.DeleteFailedVMSS(ctx, key.ResourceGroupName(cr), key.MasterVMSSName(cr))
+		r.instanceWatchdog.DeleteFailedVMSS(ctx, &cr, key.ResourceGroupName(cr), key.MasterVMSSName(cr))
 
 		r.logger.LogCtx(ctx, "level", "debug", "message", "canceling reconciliation")
 		reconciliationcanceledcontext.SetCanceled(ctx)
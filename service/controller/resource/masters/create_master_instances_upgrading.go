@@ -3,16 +3,16 @@ package masters
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/Azure/go-autorest/autorest/to"
-	corev1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/core/v1alpha1"
 	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/microerror"
 	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/drainer"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
@@ -30,21 +30,6 @@ func (r *Resource) masterInstancesUpgradingTransition(ctx context.Context, obj i
 		}
 	}
 
-	var drainerConfigs []corev1alpha1.DrainerConfig
-	{
-		n := v1.NamespaceAll
-		o := metav1.ListOptions{
-			LabelSelector: fmt.Sprintf("%s=%s", key.ClusterIDLabel, key.ClusterID(cr)),
-		}
-
-		list, err := r.g8sClient.CoreV1alpha1().DrainerConfigs(n).List(o)
-		if err != nil {
-			return "", microerror.Mask(err)
-		}
-
-		drainerConfigs = list.Items
-	}
-
 	var masterUpgradeInProgress bool
 	{
 		allMasterInstances, err := r.allInstances(ctx, cr, key.MasterVMSSName)
@@ -55,26 +40,56 @@ func (r *Resource) masterInstancesUpgradingTransition(ctx context.Context, obj i
 		} else {
 			r.logger.LogCtx(ctx, "level", "debug", "message", "processing master VMSSs")
 
-			ws, err := r.nextInstance(ctx, cr, allMasterInstances, drainerConfigs, key.MasterInstanceName, versionValue)
+			ws, err := r.nextInstance(ctx, cr, allMasterInstances, key.MasterInstanceName, versionValue)
 			if err != nil {
 				return "", microerror.Mask(err)
 			}
 
+			// Updating an instance starts rolling it. Instances already being
+			// rolled are finished when the maintenance window closes.
+			if ws.InstanceToUpdate() != nil {
+				open, err := r.maintenance.Open(cr, time.Now())
+				if err != nil {
+					return "", microerror.Mask(err)
+				}
+				if !open {
+					r.logger.LogCtx(ctx, "level", "debug", "message", "maintenance window closed, waiting for the next one to roll the next master node")
+					return currentState, nil
+				}
+			}
+
+			// Masters are rolled one at a time, and only when the control
+			// plane survives losing the next one.
+			if ws.InstanceToUpdate() != nil || ws.InstanceToDrain() != nil || ws.InstanceToReimage() != nil {
+				preserved, err := r.isQuorumPreserved(ctx, cr)
+				if err != nil {
+					return "", microerror.Mask(err)
+				}
+				if !preserved {
+					r.logger.LogCtx(ctx, "level", "debug", "message", "rolling the next master node would break the etcd quorum, waiting for the masters to become healthy")
+					return currentState, nil
+				}
+			}
+
 			err = r.updateInstance(ctx, cr, ws.InstanceToUpdate(), key.MasterVMSSName, key.MasterInstanceName)
 			if err != nil {
 				return "", microerror.Mask(err)
 			}
-			if ws.InstanceToDrain() != nil {
-				err = r.createDrainerConfig(ctx, cr, key.MasterInstanceName(cr, *ws.InstanceToDrain().InstanceID))
+			// Etcd gets backed up right before every reimage, so that it can
+			// be restored when the member does not come back.
+			if ws.InstanceToReimage() != nil {
+				instanceID := *ws.InstanceToReimage().InstanceID
+				_, err = r.takeEtcdSnapshot(ctx, cr, key.MasterVMSSName(cr), instanceID, key.MasterInstanceName(cr, instanceID))
 				if err != nil {
 					return "", microerror.Mask(err)
 				}
 			}
+
 			err = r.reimageInstance(ctx, cr, ws.InstanceToReimage(), key.MasterVMSSName, key.MasterInstanceName)
 			if err != nil {
 				return "", microerror.Mask(err)
 			}
-			err = r.deleteDrainerConfig(ctx, cr, ws.InstanceToReimage(), key.MasterInstanceName, drainerConfigs)
+			err = r.resetDrainState(ctx, cr, ws.InstanceToReimage(), key.MasterInstanceName)
 			if err != nil {
 				return "", microerror.Mask(err)
 			}
@@ -127,85 +142,18 @@ func (r *Resource) allInstances(ctx context.Context, customObject providerv1alph
 	return instances, nil
 }
 
-func (r *Resource) createDrainerConfig(ctx context.Context, customObject providerv1alpha1.AzureConfig, nodeName string) error {
-	r.logger.LogCtx(ctx, "level", "debug", "message", "creating drainer config for tenant cluster node")
-
-	n := key.ClusterID(customObject)
-	c := &corev1alpha1.DrainerConfig{
-		ObjectMeta: metav1.ObjectMeta{
-			Labels: map[string]string{
-				key.ClusterIDLabel: key.ClusterID(customObject),
-			},
-			Name: nodeName,
-		},
-		Spec: corev1alpha1.DrainerConfigSpec{
-			Guest: corev1alpha1.DrainerConfigSpecGuest{
-				Cluster: corev1alpha1.DrainerConfigSpecGuestCluster{
-					API: corev1alpha1.DrainerConfigSpecGuestClusterAPI{
-						Endpoint: key.ClusterAPIEndpoint(customObject),
-					},
-					ID: key.ClusterID(customObject),
-				},
-				Node: corev1alpha1.DrainerConfigSpecGuestNode{
-					Name: nodeName,
-				},
-			},
-			VersionBundle: corev1alpha1.DrainerConfigSpecVersionBundle{
-				Version: "0.2.0",
-			},
-		},
-	}
-
-	_, err := r.g8sClient.CoreV1alpha1().DrainerConfigs(n).Create(c)
-	if errors.IsAlreadyExists(err) {
-		r.logger.LogCtx(ctx, "level", "debug", "message", "did not create drainer config for tenant cluster node")
-		r.logger.LogCtx(ctx, "level", "debug", "message", "drainer config for tenant cluster node does already exist")
-	} else if err != nil {
-		return microerror.Mask(err)
-	} else {
-		r.logger.LogCtx(ctx, "level", "debug", "message", "created drainer config for tenant cluster node")
-	}
-
-	return nil
-}
-
-func (r *Resource) deleteDrainerConfig(ctx context.Context, customObject providerv1alpha1.AzureConfig, instance *compute.VirtualMachineScaleSetVM, instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string, drainerConfigs []corev1alpha1.DrainerConfig) error {
+// resetDrainState removes the drain state of the node of the given instance
+// once it got reimaged.
+func (r *Resource) resetDrainState(ctx context.Context, customObject providerv1alpha1.AzureConfig, instance *compute.VirtualMachineScaleSetVM, instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string) error {
 	if instance == nil {
 		return nil
 	}
 
-	instanceName := instanceNameFunc(customObject, *instance.InstanceID)
-
-	if isNodeDrained(drainerConfigs, instanceName) {
-		r.logger.LogCtx(ctx, "level", "debug", "message", "deleting drainer config for tenant cluster node")
-
-		var drainerConfigToRemove corev1alpha1.DrainerConfig
-		for _, n := range drainerConfigs {
-			if n.GetName() == instanceName {
-				drainerConfigToRemove = n
-				break
-			}
-		}
-
-		n := drainerConfigToRemove.GetNamespace()
-		i := drainerConfigToRemove.GetName()
-		o := &metav1.DeleteOptions{}
-
-		err := r.g8sClient.CoreV1alpha1().DrainerConfigs(n).Delete(i, o)
-		if errors.IsNotFound(err) {
-			r.logger.LogCtx(ctx, "level", "debug", "message", "did not delete drainer config for tenant cluster node")
-			r.logger.LogCtx(ctx, "level", "debug", "message", "drainer config for tenant cluster node does not exist")
-		} else if err != nil {
-			return microerror.Mask(err)
-		} else {
-			r.logger.LogCtx(ctx, "level", "debug", "message", "deleted drainer config for tenant cluster node")
-		}
-	} else {
-		r.logger.LogCtx(ctx, "level", "debug", "message", "not deleting drainer config for tenant cluster node due to undrained node")
+	err := r.drainer.Reset(ctx, customObject, instanceNameFunc(customObject, *instance.InstanceID))
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
-	// TODO implement safety net to delete drainer configs that are over due for e.g. when node-operator fucks up
-
 	return nil
 }
 
@@ -218,21 +166,24 @@ func (r *Resource) deleteDrainerConfig(ctx context.Context, customObject provide
 // applied to all of the available instances until they got into the desired
 // state.
 //
-//     loop 1: worker 1 update
-//     loop 2: worker 2 update
-//     loop 3: worker 1 drained
-//     loop 4: worker 1 reimage
-//     loop 5: worker 2 drained
-//     loop 6: worker 2 reimage
-//
-func (r *Resource) nextInstance(ctx context.Context, customObject providerv1alpha1.AzureConfig, instances []compute.VirtualMachineScaleSetVM, drainerConfigs []corev1alpha1.DrainerConfig, instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string, versionValue map[string]string) (*workingSet, error) {
+//	loop 1: worker 1 update
+//	loop 2: worker 2 update
+//	loop 3: worker 1 drained
+//	loop 4: worker 1 reimage
+//	loop 5: worker 2 drained
+//	loop 6: worker 2 reimage
+func (r *Resource) nextInstance(ctx context.Context, customObject providerv1alpha1.AzureConfig, instances []compute.VirtualMachineScaleSetVM, instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string, versionValue map[string]string) (*workingSet, error) {
 	var err error
 
 	var ws *workingSet
 	{
 		r.logger.LogCtx(ctx, "level", "debug", "message", "looking for the next instance to be updated, drained or reimaged")
 
-		ws, err = getWorkingSet(customObject, instances, drainerConfigs, instanceNameFunc, versionValue)
+		isDrained := func(instanceName string) (bool, error) {
+			return r.isNodeDrained(ctx, customObject, instanceName)
+		}
+
+		ws, err = getWorkingSet(customObject, instances, isDrained, instanceNameFunc, versionValue)
 		if IsVersionBlobEmpty(err) {
 			// When no version bundle version is found it means the cluster just got
 			// created and the version bundle versions are not yet tracked within the
@@ -299,8 +250,9 @@ func (r *Resource) reimageInstance(ctx context.Context, customObject providerv1a
 		return microerror.Mask(err)
 	}
 
-	r.instanceWatchdog.GuardVMSS(ctx, g, s)
+	r.instanceWatchdog.GuardVMSS(ctx, &customObject, g, s)
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("ensured instance '%s' to be reimaged", instanceName))
+	r.eventRecorder.Eventf(&customObject, v1.EventTypeNormal, recorder.ReasonInstanceReimaged, "reimaged instance %s of VMSS %s", instanceName, s)
 
 	return nil
 }
@@ -336,14 +288,14 @@ func (r *Resource) updateInstance(ctx context.Context, customObject providerv1al
 	}
 
 	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("ensured instance '%s' to be updated", instanceName))
-	r.instanceWatchdog.GuardVMSS(ctx, g, s)
+	r.instanceWatchdog.GuardVMSS(ctx, &customObject, g, s)
 
 	return nil
 }
 
 // getWorkingSet either returns an instance to update or an instance to
 // reimage, but never both at the same time.
-func getWorkingSet(customObject providerv1alpha1.AzureConfig, instances []compute.VirtualMachineScaleSetVM, drainerConfigs []corev1alpha1.DrainerConfig, instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string, versionValue map[string]string) (*workingSet, error) {
+func getWorkingSet(customObject providerv1alpha1.AzureConfig, instances []compute.VirtualMachineScaleSetVM, isDrained func(instanceName string) (bool, error), instanceNameFunc func(customObject providerv1alpha1.AzureConfig, instanceID string) string, versionValue map[string]string) (*workingSet, error) {
 	var err error
 
 	var ws *workingSet
@@ -365,7 +317,11 @@ func getWorkingSet(customObject providerv1alpha1.AzureConfig, instances []comput
 	}
 	if instanceToReimage != nil {
 		instanceName := instanceNameFunc(customObject, *instanceToReimage.InstanceID)
-		if isNodeDrained(drainerConfigs, instanceName) {
+		drained, err := isDrained(instanceName)
+		if err != nil {
+			return ws, microerror.Mask(err)
+		}
+		if drained {
 			return ws.WithInstanceToReimage(instanceToReimage), nil
 		} else {
 			return ws.WithInstanceToDrain(instanceToReimage), nil
@@ -432,18 +388,14 @@ func firstInstanceToUpdate(list []compute.VirtualMachineScaleSetVM) *compute.Vir
 	return nil
 }
 
-func isNodeDrained(drainerConfigs []corev1alpha1.DrainerConfig, instanceName string) bool {
-	for _, n := range drainerConfigs {
-		if n.GetName() != instanceName {
-			continue
-		}
-		if n.Status.HasDrainedCondition() {
-			return true
-		}
-		if n.Status.HasTimeoutCondition() {
-			return true
-		}
+// isNodeDrained ensures that the node with the given name is being drained
+// and returns true once it is drained. Nodes which could not be drained in time
+// are considered drained, so that upgrading the masters does not get stuck.
+func (r *Resource) isNodeDrained(ctx context.Context, customObject providerv1alpha1.AzureConfig, nodeName string) (bool, error) {
+	status, err := r.drainer.Drain(ctx, customObject, nodeName)
+	if err != nil {
+		return false, microerror.Mask(err)
 	}
 
-	return false
+	return status == drainer.StatusDrained || status == drainer.StatusTimedOut, nil
 }
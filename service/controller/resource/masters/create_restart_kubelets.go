@@ -3,12 +3,14 @@ package masters
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/giantswarm/microerror"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/remotecommand"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
@@ -32,51 +34,96 @@ func (r *Resource) restartKubeletOnWorkersTransition(ctx context.Context, obj in
 		return "", microerror.Mask(err)
 	}
 
-	groupsClient, err := r.getGroupsClient(ctx)
+	// Kubelets are restarted in the background, so that the reconciliation
+	// loop is not held until the script finished on all workers. Instances
+	// are only restarted again when the restart failed on them.
+	enteredAt, err := r.stateEnteredAt(ctx, obj, currentState)
 	if err != nil {
 		return currentState, microerror.Mask(err)
 	}
+	requestID := restartKubeletRequestID(enteredAt)
 
-	group, err := groupsClient.Get(ctx, key.ClusterID(cr))
-	if err != nil {
-		return currentState, microerror.Mask(err)
+	if r.remoteCommand.Running(cr, requestID) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "waiting for kubelets to be restarted on worker instances")
+		return currentState, nil
 	}
 
-	vmssVMsClient, err := r.getVMsClient(ctx)
+	allWorkerInstances, err := r.allInstances(ctx, cr, key.LegacyWorkerVMSSName)
 	if err != nil {
 		return "", microerror.Mask(err)
 	}
 
-	commandId := "RunShellScript"
-	script := []string{
-		"sudo systemctl restart k8s-kubelet",
+	var instanceIDs []string
+	for _, instance := range allWorkerInstances {
+		instanceIDs = append(instanceIDs, *instance.InstanceID)
 	}
-	runCommandInput := compute.RunCommandInput{
-		CommandID: &commandId,
-		Script:    &script,
+
+	results, err := r.remoteCommand.Results(ctx, cr)
+	if err != nil {
+		return currentState, microerror.Mask(err)
 	}
 
-	allMasterInstances, err := r.allInstances(ctx, cr, key.LegacyWorkerVMSSName)
+	pending, failed := pendingKubeletRestarts(instanceIDs, results, requestID)
+	if len(pending) == 0 {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("restarted kubelet on %d worker instances", len(instanceIDs)))
+		return DeploymentCompleted, nil
+	}
+	if len(failed) > 0 {
+		r.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("restarting kubelet failed on instances %s", strings.Join(failed, ", ")))
+	}
+
+	request := remotecommand.Request{
+		ID:          requestID,
+		Script:      remotecommand.RestartKubelet,
+		VMSSName:    key.LegacyWorkerVMSSName(cr),
+		InstanceIDs: pending,
+	}
+	err = r.remoteCommand.Start(ctx, cr, request)
 	if err != nil {
-		return "", microerror.Mask(err)
+		return currentState, microerror.Mask(err)
 	}
 
-	for _, instance := range allMasterInstances {
-		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Sending restart kubelet command to %s", *instance.Name))
-		runCommandFuture, err := vmssVMsClient.RunCommand(ctx, *group.Name, key.LegacyWorkerVMSSName(cr), *instance.InstanceID, runCommandInput)
-		if err != nil {
-			return "", microerror.Mask(err)
-		}
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("restarting kubelet on %d worker instances", len(pending)))
+
+	return currentState, nil
+}
+
+// restartKubeletRequestID returns the ID of the remote command request
+// restarting the kubelets of the workers in the RestartKubeletOnWorkers state
+// entered at the given time, so that results of earlier migrations are not
+// taken into account.
+func restartKubeletRequestID(enteredAt time.Time) string {
+	if enteredAt.IsZero() {
+		return "flatcar-migration"
+	}
 
-		_, err = vmssVMsClient.RunCommandResponder(runCommandFuture.Response())
-		if err != nil {
-			return "", microerror.Mask(err)
+	return fmt.Sprintf("flatcar-migration-%d", enteredAt.Unix())
+}
+
+// pendingKubeletRestarts returns the given instances the kubelet was not
+// restarted on successfully by the request with the given ID yet, along with
+// the ones the restart failed on.
+func pendingKubeletRestarts(instanceIDs []string, results []remotecommand.Result, requestID string) ([]string, []string) {
+	outcomes := map[string]remotecommand.Result{}
+	for _, res := range results {
+		if res.RequestID == requestID {
+			outcomes[res.InstanceID] = res
 		}
+	}
 
-		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("Sent restart kubelet command to %s", *instance.Name))
+	var pending, failed []string
+	for _, id := range instanceIDs {
+		res, ok := outcomes[id]
+		if ok && res.Succeeded() {
+			continue
+		}
+		pending = append(pending, id)
+		if ok {
+			failed = append(failed, id)
+		}
 	}
 
-	return DeploymentCompleted, nil
+	return pending, failed
 }
 
 func (r *Resource) isApiServerUP(ctx context.Context) (bool, error) {
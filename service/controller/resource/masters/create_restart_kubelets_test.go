@@ -0,0 +1,71 @@
+package masters
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/remotecommand"
+)
+
+func Test_pendingKubeletRestarts(t *testing.T) {
+	requestID := restartKubeletRequestID(time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	testCases := []struct {
+		name            string
+		instanceIDs     []string
+		results         []remotecommand.Result
+		expectedPending []string
+		expectedFailed  []string
+	}{
+		{
+			name:            "case 0: kubelets not restarted yet",
+			instanceIDs:     []string{"0", "1"},
+			expectedPending: []string{"0", "1"},
+		},
+		{
+			name:        "case 1: kubelets restarted on all instances",
+			instanceIDs: []string{"0", "1"},
+			results: []remotecommand.Result{
+				{RequestID: requestID, InstanceID: "0"},
+				{RequestID: requestID, InstanceID: "1"},
+			},
+		},
+		{
+			name:        "case 2: restart failed on an instance",
+			instanceIDs: []string{"0", "1", "2"},
+			results: []remotecommand.Result{
+				{RequestID: requestID, InstanceID: "0"},
+				{RequestID: requestID, InstanceID: "1", ExitCode: 1},
+				{RequestID: requestID, InstanceID: "2", ExitCode: -1, Error: "context deadline exceeded"},
+			},
+			expectedPending: []string{"1", "2"},
+			expectedFailed:  []string{"1", "2"},
+		},
+		{
+			name:        "case 3: results of earlier migrations are ignored",
+			instanceIDs: []string{"0"},
+			results: []remotecommand.Result{
+				{RequestID: "flatcar-migration", InstanceID: "0"},
+			},
+			expectedPending: []string{"0"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			pending, failed := pendingKubeletRestarts(tc.instanceIDs, tc.results, requestID)
+
+			if !cmp.Equal(pending, tc.expectedPending) {
+				t.Fatalf("pending\n\n%s\n", cmp.Diff(tc.expectedPending, pending))
+			}
+			if !cmp.Equal(failed, tc.expectedFailed) {
+				t.Fatalf("failed\n\n%s\n", cmp.Diff(tc.expectedFailed, failed))
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package masters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// verifyRestoreTransition waits for the API server of the new master to come
+// up after etcd got restored and checks that it serves the objects counted when
+// the snapshot was taken. Only then the legacy VMSS gets deleted.
+func (r *Resource) verifyRestoreTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	snapshot, err := r.getEtcdSnapshot(cr)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	if snapshot == nil {
+		return r.failRestore(ctx, cr, currentState, "no etcd snapshot recorded to verify the restore against")
+	}
+
+	instanceID, err := r.firstMasterInstanceID(ctx, cr)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	if instanceID == "" {
+		return r.failRestore(ctx, cr, currentState, "master instance etcd got restored on not found")
+	}
+
+	verification, err := r.verifyEtcdRestore(ctx, cr, instanceID)
+	if IsScriptFailed(err) {
+		return r.failRestore(ctx, cr, currentState, fmt.Sprintf("verifying the restore of etcd snapshot %s failed: %s", snapshot.Blob, err))
+	} else if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	if !verification.Healthy {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "API server is not healthy yet")
+		return currentState, nil
+	}
+
+	missing := missingObjects(snapshot.Objects, verification.Objects)
+	if len(missing) > 0 {
+		return r.failRestore(ctx, cr, currentState, fmt.Sprintf("tenant cluster restored from etcd snapshot %s misses objects: %s", snapshot.Blob, strings.Join(missing, ", ")))
+	}
+
+	err = r.setResourceStatus(cr, RestoreFailureReason, "")
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	r.eventRecorder.Eventf(&cr, corev1.EventTypeNormal, recorder.ReasonEtcdRestored, "restored etcd snapshot %s taken on instance %s", snapshot.Blob, snapshot.Instance)
+
+	return DeleteLegacyVMSS, nil
+}
+
+// restoreFailedTransition halts the migration to flatcar after restoring etcd
+// failed, so that the legacy master is kept until humans looked into it.
+func (r *Resource) restoreFailedTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	reason, err := r.getResourceStatus(cr, RestoreFailureReason)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	r.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("The reconciliation on the masters resource is stopped because restoring etcd failed: %s. Set the masters's resource status to '%s' to retry the restore, or to '%s' once you restored etcd by hand", reason, WaitForRestore, DeleteLegacyVMSS))
+
+	return currentState, nil
+}
@@ -4,10 +4,39 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/giantswarm/microerror"
+
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
 
+// waitForBackupConfirmationTransition backs up etcd of the legacy master before
+// it gets deallocated for the migration to flatcar. The migration continues
+// once the snapshot got verified and recorded in the resource status.
 func (r *Resource) waitForBackupConfirmationTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
-	r.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("The reconciliation on the masters resource is stopped until the legacy VMSS instance ETCD backup is completed. When you completed the backup, set the masters's resource status to '%s'", DeallocateLegacyInstance))
-	return currentState, nil
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	instances, err := r.allInstances(ctx, cr, key.LegacyMasterVMSSName)
+	if IsScaleSetNotFound(err) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find the scale set '%s', no etcd snapshot to take", key.LegacyMasterVMSSName(cr)))
+		return DeallocateLegacyInstance, nil
+	} else if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	if len(instances) == 0 {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find instances in the scale set '%s', no etcd snapshot to take", key.LegacyMasterVMSSName(cr)))
+		return DeallocateLegacyInstance, nil
+	}
+
+	instanceID := *instances[0].InstanceID
+	_, err = r.takeEtcdSnapshot(ctx, cr, key.LegacyMasterVMSSName(cr), instanceID, key.LegacyMasterInstanceName(cr, instanceID))
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	return DeallocateLegacyInstance, nil
 }
@@ -9,12 +9,18 @@ import (
 
 	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
 
 func (r *Resource) waitForMastersToBecomeReadyTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
 	r.logger.LogCtx(ctx, "level", "debug", "message", "finding out if all tenant cluster master nodes are Ready")
 
-	readyForTransitioning, err := areNodesReadyForTransitioning(ctx, isMaster)
+	readyForTransitioning, err := areNodesReadyForTransitioning(ctx, isMaster, key.MasterCount(cr))
 	if IsClientNotFound(err) {
 		r.logger.LogCtx(ctx, "level", "debug", "message", "tenant cluster client not available yet")
 		return currentState, nil
@@ -32,7 +38,9 @@ func (r *Resource) waitForMastersToBecomeReadyTransition(ctx context.Context, ob
 	return DeploymentCompleted, nil
 }
 
-func areNodesReadyForTransitioning(ctx context.Context, nodeRoleMatchFunc func(corev1.Node) bool) (bool, error) {
+// areNodesReadyForTransitioning returns true when at least the given number of
+// nodes of the matching role registered and all of them are Ready.
+func areNodesReadyForTransitioning(ctx context.Context, nodeRoleMatchFunc func(corev1.Node) bool, minNodes int) (bool, error) {
 	cc, err := controllercontext.FromContext(ctx)
 	if err != nil {
 		return false, microerror.Mask(err)
@@ -60,7 +68,7 @@ func areNodesReadyForTransitioning(ctx context.Context, nodeRoleMatchFunc func(c
 	}
 
 	// There must be at least one node registered for the cluster.
-	if numNodes < 1 {
+	if numNodes < 1 || numNodes < minNodes {
 		return false, nil
 	}
 
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/microerror"
 
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
 
+// waitForRestoreTransition restores the etcd snapshot taken from the legacy
+// master on the new master during the migration to flatcar. The restore gets
+// verified in VerifyRestore before the legacy VMSS is deleted.
 func (r *Resource) waitForRestoreTransition(ctx context.Context, obj interface{}, currentState state.State) (state.State, error) {
 	cr, err := key.ToCustomResource(obj)
 	if err != nil {
@@ -27,8 +31,63 @@ func (r *Resource) waitForRestoreTransition(ctx context.Context, obj interface{}
 		return DeploymentCompleted, nil
 	}
 
-	r.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("The reconciliation on the masters resource is stopped until the ETCD backup is restored. When you completed the restore, set the masters's resource status to '%s'", DeleteLegacyVMSS))
-	return currentState, nil
+	if key.MasterCount(cr) != 1 {
+		return r.failRestore(ctx, cr, currentState, fmt.Sprintf("restoring etcd is only supported for a single master, cluster has %d masters", key.MasterCount(cr)))
+	}
+
+	snapshot, err := r.getEtcdSnapshot(cr)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	if snapshot == nil {
+		return r.failRestore(ctx, cr, currentState, "no etcd snapshot recorded to restore")
+	}
+
+	// The masters are cut off the internet during the migration, except for
+	// the storage account the snapshot is downloaded from.
+	found, err := r.ensureSecurityRules(ctx, key.ResourceGroupName(cr), key.MasterSecurityGroupName(cr), getMasterRules())
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	if !found {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "Security rules not in place yet")
+		return currentState, nil
+	}
+
+	instanceID, err := r.firstMasterInstanceID(ctx, cr)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+	if instanceID == "" {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "master instance to restore etcd on not found yet")
+		return currentState, nil
+	}
+
+	err = r.restoreEtcd(ctx, cr, *snapshot, instanceID)
+	if IsScriptFailed(err) {
+		return r.failRestore(ctx, cr, currentState, fmt.Sprintf("restoring etcd snapshot %s failed: %s", snapshot.Blob, err))
+	} else if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	return VerifyRestore, nil
+}
+
+// firstMasterInstanceID returns the ID of the first instance of the master
+// VMSS, or an empty string when there is none.
+func (r *Resource) firstMasterInstanceID(ctx context.Context, customObject providerv1alpha1.AzureConfig) (string, error) {
+	instances, err := r.allInstances(ctx, customObject, key.MasterVMSSName)
+	if IsScaleSetNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	if len(instances) == 0 {
+		return "", nil
+	}
+
+	return *instances[0].InstanceID, nil
 }
 
 func (r *Resource) vmssExists(ctx context.Context, resourceGroup string, vmssName string) (bool, error) {
@@ -28,6 +28,11 @@ func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureC
 		return azureresource.Deployment{}, microerror.Mask(err)
 	}
 
+	err = r.validateMasterCount(ctx, obj)
+	if err != nil {
+		return azureresource.Deployment{}, microerror.Mask(err)
+	}
+
 	prefixMaster := key.PrefixMaster()
 
 	masterBlobName := key.BlobName(obj, prefixMaster)
@@ -63,22 +68,11 @@ func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureC
 	encryptionKey := encrypter.GetEncryptionKey()
 	initialVector := encrypter.GetInitialVector()
 
-	storageAccountsClient, err := r.getStorageAccountsClient(ctx)
-	if err != nil {
-		return azureresource.Deployment{}, microerror.Mask(err)
-	}
-
-	groupName := key.ResourceGroupName(obj)
 	storageAccountName := key.StorageAccountName(obj)
-	keys, err := storageAccountsClient.ListKeys(ctx, groupName, storageAccountName, "")
+	primaryKey, err := r.getStorageAccountPrimaryKey(ctx, obj)
 	if err != nil {
 		return azureresource.Deployment{}, microerror.Mask(err)
 	}
-
-	if len(*(keys.Keys)) == 0 {
-		return azureresource.Deployment{}, microerror.Maskf(executionFailedError, "storage account key's list is empty")
-	}
-	primaryKey := *(((*keys.Keys)[0]).Value)
 	containerName := key.BlobContainerName()
 
 	// Masters cloudconfig
@@ -118,3 +112,35 @@ func (r Resource) newDeployment(ctx context.Context, obj providerv1alpha1.AzureC
 
 	return d, nil
 }
+
+// validateMasterCount ensures the given cluster has a number of masters which
+// can form an etcd cluster. Existing control planes cannot change between a
+// single master and highly available ones, because the etcd member of single
+// master control planes cannot join a cluster.
+func (r Resource) validateMasterCount(ctx context.Context, obj providerv1alpha1.AzureConfig) error {
+	count := key.MasterCount(obj)
+	if !key.IsValidMasterCount(count) {
+		return microerror.Maskf(invalidConfigError, "cluster must have 1, 3 or 5 masters, got %d", count)
+	}
+
+	c, err := r.getScaleSetsClient(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	vmss, err := c.Get(ctx, key.ResourceGroupName(obj), key.MasterVMSSName(obj))
+	if IsScaleSetNotFound(err) {
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if vmss.Sku != nil && vmss.Sku.Capacity != nil {
+		current := int(*vmss.Sku.Capacity)
+		if key.IsHighlyAvailable(obj) != (current >= 3) {
+			return microerror.Maskf(invalidConfigError, "cluster with %d masters cannot be changed to %d masters", current, count)
+		}
+	}
+
+	return nil
+}
@@ -22,6 +22,15 @@ func IsClientNotFound(err error) bool {
 //
 //     https://github.com/giantswarm/fmt/blob/master/go/errors.md#matching-errors
 //
+var etcdSnapshotFailedError = &microerror.Error{
+	Kind: "etcdSnapshotFailedError",
+}
+
+// IsEtcdSnapshotFailed asserts etcdSnapshotFailedError.
+func IsEtcdSnapshotFailed(err error) bool {
+	return microerror.Cause(err) == etcdSnapshotFailedError
+}
+
 var executionFailedError = &microerror.Error{
 	Kind: "executionFailedError",
 }
@@ -118,6 +127,15 @@ func IsScaleSetNotFound(err error) bool {
 	return false
 }
 
+var scriptFailedError = &microerror.Error{
+	Kind: "scriptFailedError",
+}
+
+// IsScriptFailed asserts scriptFailedError.
+func IsScriptFailed(err error) bool {
+	return microerror.Cause(err) == scriptFailedError
+}
+
 var versionBlobEmptyError = &microerror.Error{
 	Kind: "versionBlobEmptyError",
 }
@@ -0,0 +1,61 @@
+package masters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// stateEnteredAt looks up when the current state was entered based on the
+// transition history persisted in the resource status. It is used by the state
+// machine to enforce state timeouts.
+func (r *Resource) stateEnteredAt(ctx context.Context, obj interface{}, currentState state.State) (time.Time, error) {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return time.Time{}, microerror.Mask(err)
+	}
+
+	history, err := r.getStateHistory(cr)
+	if err != nil {
+		return time.Time{}, microerror.Mask(err)
+	}
+
+	return state.EnteredAt(history, currentState), nil
+}
+
+// escalateState records why the state machine escalated in the resource
+// status and emits a warning event, so that the reason is visible on the
+// custom resource.
+func (r *Resource) escalateState(ctx context.Context, obj interface{}, currentState state.State, newState state.State, heldFor time.Duration) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	reason := fmt.Sprintf("state %s held for %s, escalated to %s", currentState, heldFor.Round(time.Second), newState)
+	r.eventRecorder.Eventf(&cr, corev1.EventTypeWarning, recorder.ReasonStateEscalated, "%s %s", Name, reason)
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("setting resource status to '%s/%s'", LastEscalation, reason))
+	err = r.setResourceStatus(cr, LastEscalation, reason)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("set resource status to '%s/%s'", LastEscalation, reason))
+
+	// Restores escalate to RestoreFailed, which tells humans why it halted.
+	if newState == RestoreFailed {
+		err = r.setResourceStatus(cr, RestoreFailureReason, reason)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
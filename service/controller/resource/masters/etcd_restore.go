@@ -0,0 +1,185 @@
+package masters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/blobclient"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/remotecommand"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+const (
+	// etcdRestoreOutputPrefix marks the line of the output of the restore
+	// script telling the restore succeeded.
+	etcdRestoreOutputPrefix = "etcd-restore: "
+	// etcdSnapshotDownloadValidity is how long the master may use the URL the
+	// snapshot is downloaded from.
+	etcdSnapshotDownloadValidity = 1 * time.Hour
+	// etcdVerificationOutputPrefix marks the line of the output of the
+	// verification script describing the restored tenant cluster.
+	etcdVerificationOutputPrefix = "etcd-verification: "
+)
+
+// etcdRestoreScript replaces the data of the etcd member of a single master
+// control plane with the given snapshot. The member is restored with the name
+// and peer URL k8scloudconfig configures for single masters. The API server is
+// restarted afterwards, because it caches the state of etcd.
+var etcdRestoreScript = remotecommand.Script{
+	Name:    "etcd-restore",
+	Version: "1",
+	Template: `#!/bin/bash
+set -o errexit -o nounset -o pipefail
+
+ENVIRONMENT=$(systemctl show --property Environment etcd3.service | tr ' ' '\n')
+IMAGE=$(echo "${ENVIRONMENT}" | sed -n 's/^IMAGE=//p')
+NAME=$(echo "${ENVIRONMENT}" | sed -n 's/^NAME=//p')
+DIR=$(mktemp -d /var/lib/etcd/restore.XXXXXX)
+trap 'rm -rf "${DIR}"' EXIT
+
+curl --fail --silent --show-error --output "${DIR}/snapshot.db" '{{ .DownloadURL }}' >&2
+
+MD5=$(openssl dgst -md5 -binary "${DIR}/snapshot.db" | base64)
+if [ "${MD5}" != "{{ .MD5 }}" ]; then
+  echo "Downloaded snapshot has MD5 hash ${MD5}, expected {{ .MD5 }}" >&2
+  exit 1
+fi
+
+/usr/bin/docker run --rm -v "${DIR}":/restore -e ETCDCTL_API=3 "${IMAGE}" \
+  etcdctl snapshot restore /restore/snapshot.db \
+  --data-dir /restore/data \
+  --name etcd0 \
+  --initial-cluster etcd0=https://127.0.0.1:2380 \
+  --initial-cluster-token k8s-etcd-cluster \
+  --initial-advertise-peer-urls https://127.0.0.1:2380 >&2
+
+systemctl stop etcd3.service
+/usr/bin/docker stop "${NAME}" >&2 || true
+rm -rf /var/lib/etcd/member
+mv "${DIR}/data/member" /var/lib/etcd/member
+systemctl start etcd3.service
+
+/usr/bin/docker ps --quiet --filter name=k8s_k8s-api-server | xargs --no-run-if-empty /usr/bin/docker restart >&2
+
+echo '{{ .OutputPrefix }}{}'
+`,
+}
+
+// etcdVerificationScript tells whether the API server of the tenant cluster is
+// healthy and how many objects it serves.
+var etcdVerificationScript = remotecommand.Script{
+	Name:    "etcd-verification",
+	Version: "1",
+	Template: `#!/bin/bash
+set -o errexit -o nounset -o pipefail
+
+` + countObjectsFunc + `
+if [ "$(${KUBECTL} get --raw /healthz 2>/dev/null)" != "ok" ]; then
+  echo '{{ .OutputPrefix }}{"healthy":false}'
+  exit 0
+fi
+
+echo "{{ .OutputPrefix }}{\"healthy\":true,\"objects\":$(count_objects)}"
+`,
+}
+
+// etcdVerification describes the tenant cluster served from a restored etcd.
+type etcdVerification struct {
+	Healthy bool             `json:"healthy"`
+	Objects map[string]int64 `json:"objects"`
+}
+
+// restoreEtcd restores the given snapshot on the given master instance.
+func (r *Resource) restoreEtcd(ctx context.Context, customObject providerv1alpha1.AzureConfig, snapshot etcdSnapshot, instanceID string) error {
+	instanceName := key.MasterInstanceName(customObject, instanceID)
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("restoring etcd snapshot '%s' on instance '%s'", snapshot.Blob, instanceName))
+
+	primaryKey, err := r.getStorageAccountPrimaryKey(ctx, customObject)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	downloadURL, err := blobclient.GetBlobDownloadURL(snapshot.Blob, snapshot.Container, key.StorageAccountName(customObject), primaryKey, etcdSnapshotDownloadValidity)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	parameters := map[string]interface{}{
+		"DownloadURL":  downloadURL,
+		"MD5":          snapshot.MD5,
+		"OutputPrefix": etcdRestoreOutputPrefix,
+	}
+	stdout, err := r.runScript(ctx, customObject, etcdRestoreScript, parameters, key.MasterVMSSName(customObject), instanceID)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var output struct{}
+	err = parseScriptOutput(stdout, etcdRestoreOutputPrefix, &output)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("restored etcd snapshot '%s' on instance '%s'", snapshot.Blob, instanceName))
+
+	return nil
+}
+
+// verifyEtcdRestore checks the tenant cluster served by the given master
+// instance after etcd got restored there.
+func (r *Resource) verifyEtcdRestore(ctx context.Context, customObject providerv1alpha1.AzureConfig, instanceID string) (etcdVerification, error) {
+	parameters := map[string]interface{}{
+		"OutputPrefix": etcdVerificationOutputPrefix,
+		"Resources":    countedResources,
+	}
+	stdout, err := r.runScript(ctx, customObject, etcdVerificationScript, parameters, key.MasterVMSSName(customObject), instanceID)
+	if err != nil {
+		return etcdVerification{}, microerror.Mask(err)
+	}
+
+	var verification etcdVerification
+	err = parseScriptOutput(stdout, etcdVerificationOutputPrefix, &verification)
+	if err != nil {
+		return etcdVerification{}, microerror.Mask(err)
+	}
+
+	return verification, nil
+}
+
+// failRestore halts the restore of etcd with the given reason.
+func (r *Resource) failRestore(ctx context.Context, customObject providerv1alpha1.AzureConfig, currentState state.State, reason string) (state.State, error) {
+	r.logger.LogCtx(ctx, "level", "debug", "message", reason)
+
+	err := r.setResourceStatus(customObject, RestoreFailureReason, reason)
+	if err != nil {
+		return currentState, microerror.Mask(err)
+	}
+
+	r.eventRecorder.Eventf(&customObject, corev1.EventTypeWarning, recorder.ReasonEtcdRestoreFailed, "%s", reason)
+
+	return RestoreFailed, nil
+}
+
+// missingObjects compares the objects counted when the snapshot was taken with
+// the objects served after the snapshot got restored. The restored cluster
+// must serve at least as many objects of every counted resource.
+func missingObjects(expected map[string]int64, actual map[string]int64) []string {
+	var missing []string
+	for resource, count := range expected {
+		if actual[resource] < count {
+			missing = append(missing, fmt.Sprintf("%s: %d of %d", resource, actual[resource], count))
+		}
+	}
+	sort.Strings(missing)
+
+	return missing
+}
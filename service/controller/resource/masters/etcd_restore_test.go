@@ -0,0 +1,54 @@
+package masters
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_missingObjects(t *testing.T) {
+	testCases := []struct {
+		name            string
+		expected        map[string]int64
+		actual          map[string]int64
+		expectedMissing []string
+	}{
+		{
+			name:            "case 0: restored cluster serves all objects",
+			expected:        map[string]int64{"namespaces": 7, "secrets": 93},
+			actual:          map[string]int64{"namespaces": 7, "secrets": 93},
+			expectedMissing: nil,
+		},
+		{
+			name:            "case 1: restored cluster serves additional objects",
+			expected:        map[string]int64{"namespaces": 7, "secrets": 93},
+			actual:          map[string]int64{"namespaces": 7, "secrets": 95},
+			expectedMissing: nil,
+		},
+		{
+			name:            "case 2: restored cluster misses objects",
+			expected:        map[string]int64{"namespaces": 7, "secrets": 93, "services": 12},
+			actual:          map[string]int64{"namespaces": 4, "secrets": 93},
+			expectedMissing: []string{"namespaces: 4 of 7", "services: 0 of 12"},
+		},
+		{
+			name:            "case 3: no objects counted when the snapshot was taken",
+			expected:        nil,
+			actual:          map[string]int64{"namespaces": 4},
+			expectedMissing: nil,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			missing := missingObjects(tc.expected, tc.actual)
+
+			if !cmp.Equal(missing, tc.expectedMissing) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedMissing, missing))
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package masters
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/blobclient"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/remotecommand"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+const (
+	// etcdSnapshotRetention is the number of snapshots kept in the storage
+	// account of the cluster. Older ones are deleted once a new snapshot got
+	// recorded. All of them go along with the resource group of the cluster
+	// when it gets deleted.
+	etcdSnapshotRetention = 5
+	// etcdSnapshotOutputPrefix marks the line of the output of the snapshot
+	// script describing the snapshot it uploaded.
+	etcdSnapshotOutputPrefix = "etcd-snapshot: "
+	// etcdSnapshotUploadValidity is how long the master may use the URL the
+	// snapshot is uploaded to.
+	etcdSnapshotUploadValidity = 1 * time.Hour
+)
+
+// etcdSnapshotScript saves a snapshot of the local etcd member, checks its
+// integrity and uploads it to the given URL. The storage account verifies the
+// upload against the MD5 hash of the snapshot. The last line of the output
+// describes the uploaded snapshot along with the objects in the tenant cluster
+// at that time, unless the API server did not answer.
+var etcdSnapshotScript = remotecommand.Script{
+	Name:    "etcd-snapshot",
+	Version: "1",
+	Template: `#!/bin/bash
+set -o errexit -o nounset -o pipefail
+
+` + countObjectsFunc + `
+IMAGE=$(systemctl show --property Environment etcd3.service | tr ' ' '\n' | sed -n 's/^IMAGE=//p')
+DIR=$(mktemp -d /var/lib/etcd-snapshot.XXXXXX)
+trap 'rm -rf "${DIR}"' EXIT
+
+etcdctl() {
+  /usr/bin/docker run --rm --net=host \
+    -v /etc/kubernetes/ssl/etcd/:/etc/etcd \
+    -v "${DIR}":/snapshot \
+    -e ETCDCTL_API=3 \
+    "${IMAGE}" \
+    etcdctl \
+    --cacert /etc/etcd/server-ca.pem \
+    --cert /etc/etcd/server-crt.pem \
+    --key /etc/etcd/server-key.pem \
+    "$@"
+}
+
+OBJECTS=$(count_objects) || OBJECTS=null
+etcdctl --endpoints https://127.0.0.1:2379 snapshot save /snapshot/snapshot.db >&2
+STATUS=$(etcdctl snapshot status /snapshot/snapshot.db --write-out json)
+SIZE=$(stat --format %s "${DIR}/snapshot.db")
+MD5=$(openssl dgst -md5 -binary "${DIR}/snapshot.db" | base64)
+
+curl --fail --silent --show-error \
+  --header "x-ms-blob-type: BlockBlob" \
+  --header "x-ms-version: 2019-12-12" \
+  --header "Content-MD5: ${MD5}" \
+  --upload-file "${DIR}/snapshot.db" \
+  '{{ .UploadURL }}' >&2
+
+printf '{{ .OutputPrefix }}{"md5":"%s","objects":%s,"size":%s,"status":%s}\n' "${MD5}" "${OBJECTS}" "${SIZE}" "${STATUS}"
+`,
+}
+
+// etcdSnapshot references an etcd snapshot stored in the storage account of
+// the cluster. It is recorded in the resource status, so that etcd can be
+// restored from the latest snapshot.
+type etcdSnapshot struct {
+	Blob      string `json:"blob"`
+	Container string `json:"container"`
+	Instance  string `json:"instance"`
+	MD5       string `json:"md5"`
+	// Objects is the number of objects of the counted resources in the
+	// tenant cluster when the snapshot was taken, if the API server answered.
+	Objects  map[string]int64 `json:"objects,omitempty"`
+	Revision int64            `json:"revision"`
+	Size     int64            `json:"size"`
+	TakenAt  time.Time        `json:"takenAt"`
+	TotalKey int64            `json:"totalKey"`
+}
+
+// etcdSnapshotOutput is the description of the uploaded snapshot printed by
+// the snapshot script. Status is the output of etcdctl snapshot status.
+type etcdSnapshotOutput struct {
+	MD5     string           `json:"md5"`
+	Objects map[string]int64 `json:"objects"`
+	Size    int64            `json:"size"`
+	Status  struct {
+		Hash      int64 `json:"hash"`
+		Revision  int64 `json:"revision"`
+		TotalKey  int64 `json:"totalKey"`
+		TotalSize int64 `json:"totalSize"`
+	} `json:"status"`
+}
+
+// takeEtcdSnapshot snapshots the etcd member of the given master instance,
+// uploads the snapshot to the storage account of the cluster and records it
+// in the resource status once the upload got verified.
+func (r *Resource) takeEtcdSnapshot(ctx context.Context, customObject providerv1alpha1.AzureConfig, vmssName string, instanceID string, instanceName string) (etcdSnapshot, error) {
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("taking etcd snapshot on instance '%s'", instanceName))
+
+	storageAccountName := key.StorageAccountName(customObject)
+	containerName := key.EtcdSnapshotContainerName()
+
+	primaryKey, err := r.getStorageAccountPrimaryKey(ctx, customObject)
+	if err != nil {
+		return etcdSnapshot{}, microerror.Mask(err)
+	}
+
+	containerURL, err := blobclient.NewContainerURL(containerName, storageAccountName, primaryKey)
+	if err != nil {
+		return etcdSnapshot{}, microerror.Mask(err)
+	}
+	err = blobclient.EnsureContainer(ctx, containerURL)
+	if err != nil {
+		return etcdSnapshot{}, microerror.Mask(err)
+	}
+
+	takenAt := time.Now().UTC()
+	blobName := key.EtcdSnapshotBlobName(instanceName, takenAt)
+
+	uploadURL, err := blobclient.GetBlobUploadURL(blobName, containerName, storageAccountName, primaryKey, etcdSnapshotUploadValidity)
+	if err != nil {
+		return etcdSnapshot{}, microerror.Mask(err)
+	}
+
+	parameters := map[string]interface{}{
+		"OutputPrefix": etcdSnapshotOutputPrefix,
+		"Resources":    countedResources,
+		"UploadURL":    uploadURL,
+	}
+	stdout, err := r.runScript(ctx, customObject, etcdSnapshotScript, parameters, vmssName, instanceID)
+	if err != nil {
+		return etcdSnapshot{}, microerror.Mask(err)
+	}
+
+	var output etcdSnapshotOutput
+	err = parseScriptOutput(stdout, etcdSnapshotOutputPrefix, &output)
+	if err != nil {
+		return etcdSnapshot{}, microerror.Mask(err)
+	}
+
+	props, err := containerURL.NewBlockBlobURL(blobName).GetProperties(ctx, azblob.BlobAccessConditions{})
+	if err != nil {
+		return etcdSnapshot{}, microerror.Mask(err)
+	}
+	err = verifyEtcdSnapshot(output, props.ContentLength(), props.ContentMD5())
+	if err != nil {
+		return etcdSnapshot{}, microerror.Mask(err)
+	}
+
+	snapshot := etcdSnapshot{
+		Blob:      blobName,
+		Container: containerName,
+		Instance:  instanceName,
+		MD5:       output.MD5,
+		Objects:   output.Objects,
+		Revision:  output.Status.Revision,
+		Size:      output.Size,
+		TakenAt:   takenAt,
+		TotalKey:  output.Status.TotalKey,
+	}
+
+	err = r.setEtcdSnapshot(customObject, snapshot)
+	if err != nil {
+		return etcdSnapshot{}, microerror.Mask(err)
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("took etcd snapshot '%s' on instance '%s'", blobName, instanceName))
+	r.eventRecorder.Eventf(&customObject, corev1.EventTypeNormal, recorder.ReasonEtcdSnapshotTaken, "took etcd snapshot %s of %d keys at revision %d on instance %s", blobName, snapshot.TotalKey, snapshot.Revision, instanceName)
+
+	// Pruning is best effort. Failing here would take yet another snapshot in
+	// the next reconciliation loop.
+	err = r.pruneEtcdSnapshots(ctx, containerURL, blobName)
+	if err != nil {
+		r.logger.LogCtx(ctx, "level", "warning", "message", "failed to prune etcd snapshots", "stack", fmt.Sprintf("%#v", err))
+	}
+
+	return snapshot, nil
+}
+
+// pruneEtcdSnapshots deletes the snapshots in the given container beyond the
+// newest etcdSnapshotRetention ones. The given recorded snapshot is always
+// kept.
+func (r *Resource) pruneEtcdSnapshots(ctx context.Context, containerURL *azblob.ContainerURL, recorded string) error {
+	listBlobs, err := blobclient.ListBlobs(ctx, containerURL)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, blobName := range etcdSnapshotsToPrune(listBlobs.Segment.BlobItems, etcdSnapshotRetention, recorded) {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deleting etcd snapshot '%s'", blobName))
+
+		_, err = containerURL.NewBlockBlobURL(blobName).Delete(ctx, azblob.DeleteSnapshotsOptionInclude, azblob.BlobAccessConditions{})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("deleted etcd snapshot '%s'", blobName))
+	}
+
+	return nil
+}
+
+// etcdSnapshotsToPrune returns the names of the given snapshots beyond the
+// newest keep ones, except for the given recorded snapshot.
+func etcdSnapshotsToPrune(blobs []azblob.BlobItem, keep int, recorded string) []string {
+	sorted := append([]azblob.BlobItem{}, blobs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Properties.LastModified.Equal(sorted[j].Properties.LastModified) {
+			return sorted[i].Name > sorted[j].Name
+		}
+		return sorted[i].Properties.LastModified.After(sorted[j].Properties.LastModified)
+	})
+
+	var names []string
+	for i, b := range sorted {
+		if i < keep || b.Name == recorded {
+			continue
+		}
+		names = append(names, b.Name)
+	}
+
+	return names
+}
+
+func (r *Resource) getEtcdSnapshot(customObject providerv1alpha1.AzureConfig) (*etcdSnapshot, error) {
+	s, err := r.getResourceStatus(customObject, EtcdSnapshot)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	if s == "" {
+		return nil, nil
+	}
+
+	var snapshot etcdSnapshot
+	err = json.Unmarshal([]byte(s), &snapshot)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return &snapshot, nil
+}
+
+func (r *Resource) setEtcdSnapshot(customObject providerv1alpha1.AzureConfig, snapshot etcdSnapshot) error {
+	b, err := json.Marshal(snapshot)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.setResourceStatus(customObject, EtcdSnapshot, string(b))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// verifyEtcdSnapshot checks that the snapshot stored in the storage account
+// is the one the snapshot script took and that it holds any data.
+func verifyEtcdSnapshot(output etcdSnapshotOutput, size int64, md5 []byte) error {
+	if output.Size == 0 || output.Status.TotalKey == 0 {
+		return microerror.Maskf(etcdSnapshotFailedError, "snapshot is empty")
+	}
+	if size != output.Size {
+		return microerror.Maskf(etcdSnapshotFailedError, "stored snapshot has %d bytes, expected %d", size, output.Size)
+	}
+	if base64.StdEncoding.EncodeToString(md5) != output.MD5 {
+		return microerror.Maskf(etcdSnapshotFailedError, "stored snapshot has MD5 hash %q, expected %q", base64.StdEncoding.EncodeToString(md5), output.MD5)
+	}
+
+	return nil
+}
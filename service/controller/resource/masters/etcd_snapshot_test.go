@@ -0,0 +1,147 @@
+package masters
+
+import (
+	"crypto/md5" // nolint:gosec
+	"encoding/base64"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_etcdSnapshotsToPrune(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	newBlob := func(name string, age time.Duration) azblob.BlobItem {
+		return azblob.BlobItem{Name: name, Properties: azblob.BlobProperties{LastModified: now.Add(-age)}}
+	}
+
+	testCases := []struct {
+		name          string
+		blobs         []azblob.BlobItem
+		keep          int
+		recorded      string
+		expectedNames []string
+	}{
+		{
+			name: "case 0: fewer snapshots than kept",
+			blobs: []azblob.BlobItem{
+				newBlob("a1b2c-master-000000-20200601T100000Z.db", 2*time.Hour),
+				newBlob("a1b2c-master-000000-20200601T110000Z.db", time.Hour),
+			},
+			keep:     3,
+			recorded: "a1b2c-master-000000-20200601T110000Z.db",
+		},
+		{
+			name: "case 1: oldest snapshots get pruned",
+			blobs: []azblob.BlobItem{
+				newBlob("a1b2c-master-000000-20200601T110000Z.db", time.Hour),
+				newBlob("a1b2c-master-000000-20200601T080000Z.db", 4*time.Hour),
+				newBlob("a1b2c-master-000000-20200601T100000Z.db", 2*time.Hour),
+				newBlob("a1b2c-master-000000-20200601T090000Z.db", 3*time.Hour),
+			},
+			keep:     2,
+			recorded: "a1b2c-master-000000-20200601T110000Z.db",
+			expectedNames: []string{
+				"a1b2c-master-000000-20200601T090000Z.db",
+				"a1b2c-master-000000-20200601T080000Z.db",
+			},
+		},
+		{
+			name: "case 2: recorded snapshot is kept",
+			blobs: []azblob.BlobItem{
+				newBlob("a1b2c-master-000000-20200601T110000Z.db", time.Hour),
+				newBlob("a1b2c-master-000000-20200601T100000Z.db", 2*time.Hour),
+				newBlob("a1b2c-master-000000-20200601T090000Z.db", 3*time.Hour),
+			},
+			keep:     1,
+			recorded: "a1b2c-master-000000-20200601T090000Z.db",
+			expectedNames: []string{
+				"a1b2c-master-000000-20200601T100000Z.db",
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			names := etcdSnapshotsToPrune(tc.blobs, tc.keep, tc.recorded)
+
+			if !cmp.Equal(names, tc.expectedNames) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedNames, names))
+			}
+		})
+	}
+}
+
+func Test_verifyEtcdSnapshot(t *testing.T) {
+	snapshot := []byte("etcd snapshot")
+	sum := md5.Sum(snapshot) // nolint:gosec
+
+	output := etcdSnapshotOutput{
+		MD5:  base64.StdEncoding.EncodeToString(sum[:]),
+		Size: int64(len(snapshot)),
+	}
+	output.Status.TotalKey = 812
+
+	emptyOutput := output
+	emptyOutput.Status.TotalKey = 0
+
+	testCases := []struct {
+		name         string
+		output       etcdSnapshotOutput
+		size         int64
+		md5          []byte
+		errorMatcher func(error) bool
+	}{
+		{
+			name:   "case 0: stored snapshot matches",
+			output: output,
+			size:   int64(len(snapshot)),
+			md5:    sum[:],
+		},
+		{
+			name:         "case 1: stored snapshot is truncated",
+			output:       output,
+			size:         4,
+			md5:          sum[:],
+			errorMatcher: IsEtcdSnapshotFailed,
+		},
+		{
+			name:         "case 2: stored snapshot differs",
+			output:       output,
+			size:         int64(len(snapshot)),
+			md5:          []byte("different"),
+			errorMatcher: IsEtcdSnapshotFailed,
+		},
+		{
+			name:         "case 3: snapshot holds no keys",
+			output:       emptyOutput,
+			size:         int64(len(snapshot)),
+			md5:          sum[:],
+			errorMatcher: IsEtcdSnapshotFailed,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			err := verifyEtcdSnapshot(tc.output, tc.size, tc.md5)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+		})
+	}
+}
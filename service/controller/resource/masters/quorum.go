@@ -0,0 +1,89 @@
+package masters
+
+import (
+	"context"
+	"fmt"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// isQuorumPreserved returns true when the control plane of the given cluster
+// keeps its etcd quorum and a healthy API server while one more master is
+// being rolled. Masters are healthy when their node is ready and their etcd
+// member started. Control planes which are not highly available have no
+// quorum to preserve.
+func (r *Resource) isQuorumPreserved(ctx context.Context, customObject providerv1alpha1.AzureConfig) (bool, error) {
+	if !key.IsHighlyAvailable(customObject) {
+		return true, nil
+	}
+
+	cc, err := controllercontext.FromContext(ctx)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	if cc.Client.TenantCluster.K8s == nil {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "tenant cluster client not available yet")
+		return false, nil
+	}
+
+	healthy, err := r.etcd.Healthy(ctx, customObject)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+	if !healthy {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "etcd cluster is not healthy")
+		return false, nil
+	}
+
+	members, err := r.etcd.Members(ctx, customObject)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	var startedMembers int
+	for _, m := range members {
+		if m.Started() {
+			startedMembers++
+		}
+	}
+
+	err = cc.Client.TenantCluster.K8s.Discovery().RESTClient().Get().AbsPath("/healthz").Do().Error()
+	if err != nil {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "API server is not healthy", "stack", fmt.Sprintf("%#v", err))
+		return false, nil
+	}
+
+	nodeList, err := cc.Client.TenantCluster.K8s.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	var readyMasters int
+	for _, n := range nodeList.Items {
+		if isMaster(n) && isReady(n) {
+			readyMasters++
+		}
+	}
+
+	healthyMembers := startedMembers
+	if readyMasters < healthyMembers {
+		healthyMembers = readyMasters
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("found %d etcd members, %d of them started and %d ready master nodes", len(members), startedMembers, readyMasters))
+
+	return quorumPreserved(len(members), healthyMembers), nil
+}
+
+// quorumPreserved returns true when an etcd cluster with the given number of
+// members, of which the given number is healthy, keeps its quorum when one
+// more healthy member goes down.
+func quorumPreserved(members, healthyMembers int) bool {
+	return healthyMembers-1 >= key.EtcdQuorum(members)
+}
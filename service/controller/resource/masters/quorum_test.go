@@ -0,0 +1,64 @@
+package masters
+
+import (
+	"strconv"
+	"testing"
+)
+
+func Test_quorumPreserved(t *testing.T) {
+	testCases := []struct {
+		name              string
+		members           int
+		healthyMembers    int
+		expectedPreserved bool
+	}{
+		{
+			name:              "case 0: all of three members healthy",
+			members:           3,
+			healthyMembers:    3,
+			expectedPreserved: true,
+		},
+		{
+			name:              "case 1: one of three members down",
+			members:           3,
+			healthyMembers:    2,
+			expectedPreserved: false,
+		},
+		{
+			name:              "case 2: one of five members down",
+			members:           5,
+			healthyMembers:    4,
+			expectedPreserved: true,
+		},
+		{
+			name:              "case 3: two of five members down",
+			members:           5,
+			healthyMembers:    3,
+			expectedPreserved: false,
+		},
+		{
+			name:              "case 4: added member which did not start yet",
+			members:           4,
+			healthyMembers:    3,
+			expectedPreserved: false,
+		},
+		{
+			name:              "case 5: no members",
+			members:           0,
+			healthyMembers:    0,
+			expectedPreserved: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			preserved := quorumPreserved(tc.members, tc.healthyMembers)
+
+			if preserved != tc.expectedPreserved {
+				t.Fatalf("preserved == %t, want %t", preserved, tc.expectedPreserved)
+			}
+		})
+	}
+}
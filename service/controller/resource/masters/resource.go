@@ -7,15 +7,22 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-11-01/network"
 	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/apiextensions/pkg/clientset/versioned"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
 	"github.com/giantswarm/azure-operator/v4/service/controller/debugger"
 	"github.com/giantswarm/azure-operator/v4/service/controller/encrypter"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/drainer"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/etcd"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/maintenance"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/planner"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/remotecommand"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/vmsscheck"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
@@ -27,30 +34,59 @@ const (
 )
 
 type Config struct {
-	Debugger  *debugger.Debugger
-	G8sClient versioned.Interface
-	K8sClient kubernetes.Interface
-	Logger    micrologger.Logger
-
-	Azure            setting.Azure
+	Debugger      *debugger.Debugger
+	EventRecorder record.EventRecorder
+	G8sClient     versioned.Interface
+	K8sClient     kubernetes.Interface
+	Logger        micrologger.Logger
+
+	Azure setting.Azure
+	// Drainer drains the nodes of master instances before they get reimaged.
+	Drainer drainer.Interface
+	// Etcd tells whether rolling a master preserves the etcd quorum of highly
+	// available control planes.
+	Etcd             etcd.Interface
 	InstanceWatchdog vmsscheck.InstanceWatchdog
+	// Maintenance restricts rolling upgrades of the master instances to the
+	// maintenance windows of the cluster.
+	Maintenance maintenance.Interface
+	// Planner approves the deployment of the ARM template.
+	Planner planner.Interface
+	// RemoteCommand runs scripts on the master and worker instances, e.g. to
+	// snapshot and restore etcd.
+	RemoteCommand remotecommand.Interface
 }
 
 type Resource struct {
-	debugger     *debugger.Debugger
-	g8sClient    versioned.Interface
-	k8sClient    kubernetes.Interface
-	logger       micrologger.Logger
-	stateMachine state.Machine
+	debugger      *debugger.Debugger
+	eventRecorder record.EventRecorder
+	g8sClient     versioned.Interface
+	k8sClient     kubernetes.Interface
+	logger        micrologger.Logger
+	stateMachine  state.Machine
 
 	azure            setting.Azure
+	drainer          drainer.Interface
+	etcd             etcd.Interface
 	instanceWatchdog vmsscheck.InstanceWatchdog
+	maintenance      maintenance.Interface
+	planner          planner.Interface
+	remoteCommand    remotecommand.Interface
 }
 
 func New(config Config) (*Resource, error) {
 	if config.Debugger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Debugger must not be empty", config)
 	}
+	if config.Drainer == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Drainer must not be empty", config)
+	}
+	if config.Etcd == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Etcd must not be empty", config)
+	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.G8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.G8sClient must not be empty", config)
 	}
@@ -63,19 +99,34 @@ func New(config Config) (*Resource, error) {
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if config.Maintenance == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Maintenance must not be empty", config)
+	}
+	if config.Planner == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Planner must not be empty", config)
+	}
+	if config.RemoteCommand == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.RemoteCommand must not be empty", config)
+	}
 
 	if err := config.Azure.Validate(); err != nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Azure.%s", config, err)
 	}
 
 	r := &Resource{
-		debugger:  config.Debugger,
-		g8sClient: config.G8sClient,
-		k8sClient: config.K8sClient,
-		logger:    config.Logger,
+		debugger:      config.Debugger,
+		eventRecorder: config.EventRecorder,
+		g8sClient:     config.G8sClient,
+		k8sClient:     config.K8sClient,
+		logger:        config.Logger,
 
 		azure:            config.Azure,
+		drainer:          config.Drainer,
+		etcd:             config.Etcd,
 		instanceWatchdog: config.InstanceWatchdog,
+		maintenance:      config.Maintenance,
+		planner:          config.Planner,
+		remoteCommand:    config.RemoteCommand,
 	}
 
 	r.configureStateMachine()
@@ -163,6 +214,26 @@ func (r *Resource) getStorageAccountsClient(ctx context.Context) (*storage.Accou
 	return cc.AzureClientSet.StorageAccountsClient, nil
 }
 
+// getStorageAccountPrimaryKey returns the primary key of the storage account
+// of the cluster.
+func (r *Resource) getStorageAccountPrimaryKey(ctx context.Context, customObject providerv1alpha1.AzureConfig) (string, error) {
+	storageAccountsClient, err := r.getStorageAccountsClient(ctx)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	keys, err := storageAccountsClient.ListKeys(ctx, key.ResourceGroupName(customObject), key.StorageAccountName(customObject), "")
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	if keys.Keys == nil || len(*(keys.Keys)) == 0 {
+		return "", microerror.Maskf(executionFailedError, "storage account key's list is empty")
+	}
+
+	return *(((*keys.Keys)[0]).Value), nil
+}
+
 func (r *Resource) getVMsClient(ctx context.Context) (*compute.VirtualMachineScaleSetVMsClient, error) {
 	cc, err := controllercontext.FromContext(ctx)
 	if err != nil {
@@ -0,0 +1,88 @@
+package masters
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/remotecommand"
+)
+
+// countObjectsFunc defines the count_objects function of scripts run on
+// masters. It prints the number of objects of each of the given resources in
+// the tenant cluster as JSON object and fails when the API server does not
+// answer.
+const countObjectsFunc = `KUBECTL="/opt/bin/hyperkube kubectl --kubeconfig /etc/kubernetes/kubeconfig/addons.yaml"
+
+count_objects() {
+  local counts=""
+  for resource in {{ range .Resources }}{{ . }} {{ end }}; do
+    count=$(${KUBECTL} get "${resource}" --all-namespaces --no-headers --ignore-not-found | wc -l) || return 1
+    counts="${counts:+${counts},}\"${resource}\":${count}"
+  done
+  echo "{${counts}}"
+}
+`
+
+// countedResources are the resources whose objects are counted when etcd gets
+// backed up, so that restores can be verified.
+var countedResources = []string{
+	"namespaces",
+	"serviceaccounts",
+	"secrets",
+	"configmaps",
+	"services",
+	"deployments",
+	"daemonsets",
+	"statefulsets",
+}
+
+// runScript runs the given script on the given instance and returns its
+// standard output once it finished. Scripts exiting with a non-zero status
+// fail with scriptFailedError.
+func (r *Resource) runScript(ctx context.Context, customObject providerv1alpha1.AzureConfig, script remotecommand.Script, parameters map[string]interface{}, vmssName string, instanceID string) (string, error) {
+	request := remotecommand.Request{
+		ID:          script.Name,
+		Script:      script,
+		Parameters:  parameters,
+		VMSSName:    vmssName,
+		InstanceIDs: []string{instanceID},
+	}
+	results, err := r.remoteCommand.Run(ctx, customObject, request)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	result := results[0]
+	if result.Error != "" {
+		return "", microerror.Maskf(executionFailedError, "running script %#q on instance %#q failed: %s", script.ID(), instanceID, result.Error)
+	}
+	if result.ExitCode != 0 {
+		return "", microerror.Maskf(scriptFailedError, "script %#q exited with status %d: %s", script.ID(), result.ExitCode, result.Stderr)
+	}
+
+	return result.Stdout, nil
+}
+
+// parseScriptOutput decodes the JSON following the given prefix in the output
+// of a script into v. The script failed when the line is missing.
+func parseScriptOutput(stdout string, prefix string, v interface{}) error {
+	for _, l := range strings.Split(stdout, "\n") {
+		l = strings.TrimSpace(l)
+		if !strings.HasPrefix(l, prefix) {
+			continue
+		}
+
+		err := json.Unmarshal([]byte(strings.TrimPrefix(l, prefix)), v)
+		if err != nil {
+			return microerror.Maskf(scriptFailedError, "invalid script output: %s", err)
+		}
+
+		return nil
+	}
+
+	return microerror.Maskf(scriptFailedError, "missing script output: %s", stdout)
+}
@@ -0,0 +1,116 @@
+package masters
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/remotecommand"
+)
+
+func Test_scriptsRender(t *testing.T) {
+	testCases := []struct {
+		name       string
+		script     remotecommand.Script
+		parameters map[string]interface{}
+	}{
+		{
+			name:   "case 0: etcd snapshot script",
+			script: etcdSnapshotScript,
+			parameters: map[string]interface{}{
+				"OutputPrefix": etcdSnapshotOutputPrefix,
+				"Resources":    countedResources,
+				"UploadURL":    "https://example.blob.core.windows.net/etcd-snapshots/snapshot.db",
+			},
+		},
+		{
+			name:   "case 1: etcd restore script",
+			script: etcdRestoreScript,
+			parameters: map[string]interface{}{
+				"DownloadURL":  "https://example.blob.core.windows.net/etcd-snapshots/snapshot.db",
+				"MD5":          "1B2M2Y8AsgTpgAmY7PhCfg==",
+				"OutputPrefix": etcdRestoreOutputPrefix,
+			},
+		},
+		{
+			name:   "case 2: etcd verification script",
+			script: etcdVerificationScript,
+			parameters: map[string]interface{}{
+				"OutputPrefix": etcdVerificationOutputPrefix,
+				"Resources":    countedResources,
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			_, err := tc.script.Render(tc.parameters)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+		})
+	}
+}
+
+func Test_parseScriptOutput(t *testing.T) {
+	testCases := []struct {
+		name           string
+		stdout         string
+		expectedOutput etcdSnapshotOutput
+		errorMatcher   func(error) bool
+	}{
+		{
+			name:   "case 0: snapshot described after the script output",
+			stdout: "Counting objects\netcd-snapshot: {\"md5\":\"1B2M2Y8AsgTpgAmY7PhCfg==\",\"objects\":{\"namespaces\":7,\"secrets\":93},\"size\":20512,\"status\":{\"hash\":3927138126,\"revision\":5108,\"totalKey\":812,\"totalSize\":20512}}\n",
+			expectedOutput: func() etcdSnapshotOutput {
+				o := etcdSnapshotOutput{
+					MD5:     "1B2M2Y8AsgTpgAmY7PhCfg==",
+					Objects: map[string]int64{"namespaces": 7, "secrets": 93},
+					Size:    20512,
+				}
+				o.Status.Hash = 3927138126
+				o.Status.Revision = 5108
+				o.Status.TotalKey = 812
+				o.Status.TotalSize = 20512
+				return o
+			}(),
+		},
+		{
+			name:         "case 1: script did not describe the snapshot",
+			stdout:       "",
+			errorMatcher: IsScriptFailed,
+		},
+		{
+			name:         "case 2: invalid snapshot description",
+			stdout:       "etcd-snapshot: {\"md5\":\"1B2M2Y8AsgTpgAmY7PhCfg==\",\"size\":,\"status\":}\n",
+			errorMatcher: IsScriptFailed,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var output etcdSnapshotOutput
+			err := parseScriptOutput(tc.stdout, etcdSnapshotOutputPrefix, &output)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if !cmp.Equal(output, tc.expectedOutput) {
+				t.Fatalf("\n\n%s\n", cmp.Diff(tc.expectedOutput, output))
+			}
+		})
+	}
+}
@@ -1,9 +1,17 @@
 package masters
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
 	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/state"
 )
 
 const (
@@ -11,6 +19,9 @@ const (
 	Stage                        = "Stage"
 	DeploymentTemplateChecksum   = "TemplateChecksum"
 	DeploymentParametersChecksum = "ParametersChecksum"
+	EtcdSnapshot                 = "EtcdSnapshot"
+	LastEscalation               = "LastEscalation"
+	RestoreFailureReason         = "RestoreFailureReason"
 
 	// States
 	BlockAPICalls                  = "BlockAPICalls"
@@ -26,7 +37,9 @@ const (
 	MasterInstancesUpgrading       = "MasterInstancesUpgrading"
 	ProvisioningSuccessful         = "ProvisioningSuccessful"
 	RestartKubeletOnWorkers        = "RestartKubeletOnWorkers"
+	RestoreFailed                  = "RestoreFailed"
 	UnblockAPICalls                = "UnblockAPICalls"
+	VerifyRestore                  = "VerifyRestore"
 	WaitForBackupConfirmation      = "WaitForBackupConfirmation"
 	WaitForMastersToBecomeReady    = "WaitForMastersToBecomeReady"
 	WaitForRestore                 = "WaitForRestore"
@@ -89,6 +102,102 @@ func (r *Resource) setResourceStatus(customObject providerv1alpha1.AzureConfig,
 	return nil
 }
 
+// recordTransition appends the given state machine transition to the
+// transition history and emits a corresponding event. Recording the history is
+// best effort, so failures are only logged and do not interrupt the
+// reconciliation.
+func (r *Resource) recordTransition(ctx context.Context, customObject providerv1alpha1.AzureConfig, from, to state.State, transitionErr error) {
+	t := state.Transition{
+		From: from,
+		To:   to,
+		Time: time.Now(),
+	}
+	if transitionErr != nil {
+		t.Error = transitionErr.Error()
+		r.eventRecorder.Eventf(&customObject, corev1.EventTypeWarning, recorder.ReasonStateTransitionFailed, "%s state %s failed: %s", Name, from, transitionErr)
+	} else {
+		r.eventRecorder.Eventf(&customObject, corev1.EventTypeNormal, recorder.ReasonStateChanged, "%s state changed from %s to %s", Name, from, to)
+	}
+
+	t, err := r.appendStateHistory(customObject, t)
+	if err != nil {
+		r.logger.LogCtx(ctx, "level", "warning", "message", "failed to record state transition", "stack", fmt.Sprintf("%#v", err))
+		return
+	}
+
+	state.ReportTransition(Name, t)
+}
+
+// appendStateHistory persists the given state machine transition in the
+// bounded transition history kept in the resource status. It returns the
+// transition as recorded, including the time spent in the state it leaves.
+func (r *Resource) appendStateHistory(customObject providerv1alpha1.AzureConfig, transition state.Transition) (state.Transition, error) {
+	{
+		c, err := r.g8sClient.ProviderV1alpha1().AzureConfigs(customObject.Namespace).Get(customObject.Name, metav1.GetOptions{})
+		if err != nil {
+			return state.Transition{}, microerror.Mask(err)
+		}
+
+		customObject = *c
+	}
+
+	var conditions []providerv1alpha1.StatusClusterResourceCondition
+	var set bool
+	for i, r := range customObject.Status.Cluster.Resources {
+		if r.Name != Name {
+			continue
+		}
+
+		conditions = state.AppendHistory(r.Conditions, transition, state.HistoryLimit)
+		customObject.Status.Cluster.Resources[i].Conditions = conditions
+		set = true
+	}
+
+	if !set {
+		conditions = state.AppendHistory(nil, transition, state.HistoryLimit)
+		resourceStatus := providerv1alpha1.StatusClusterResource{
+			Conditions: conditions,
+			Name:       Name,
+		}
+		customObject.Status.Cluster.Resources = append(customObject.Status.Cluster.Resources, resourceStatus)
+	}
+
+	{
+		n := customObject.GetNamespace()
+		_, err := r.g8sClient.ProviderV1alpha1().AzureConfigs(n).UpdateStatus(&customObject)
+		if err != nil {
+			return state.Transition{}, microerror.Mask(err)
+		}
+	}
+
+	history := state.History(conditions)
+
+	return history[len(history)-1], nil
+}
+
+// getStateHistory returns the state machine transitions persisted in the
+// resource status, oldest first.
+func (r *Resource) getStateHistory(customObject providerv1alpha1.AzureConfig) ([]state.Transition, error) {
+	{
+		c, err := r.g8sClient.ProviderV1alpha1().AzureConfigs(customObject.Namespace).Get(customObject.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		customObject = *c
+	}
+
+	for _, r := range customObject.Status.Cluster.Resources {
+		if r.Name != Name {
+			continue
+		}
+
+		return state.History(r.Conditions), nil
+	}
+
+	return nil, nil
+}
+
 func (r *Resource) getResourceStatus(customObject providerv1alpha1.AzureConfig, t string) (string, error) {
 	{
 		c, err := r.g8sClient.ProviderV1alpha1().AzureConfigs(customObject.Namespace).Get(customObject.Name, metav1.GetOptions{})
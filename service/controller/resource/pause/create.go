@@ -0,0 +1,75 @@
+package pause
+
+import (
+	"context"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	now := time.Now()
+	paused := key.IsPaused(cr, now)
+
+	status := string(corev1.ConditionFalse)
+	if paused {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "reconciliation is paused")
+		status = string(corev1.ConditionTrue)
+	}
+
+	// The status is only updated when the paused condition changes.
+	if _, changed := key.SetResourceCondition(cr.DeepCopy(), Name, PausedConditionType, status, now); !changed {
+		return nil
+	}
+
+	// Get the newest CR version. Otherwise status update may fail because of:
+	//
+	//	 the object has been modified; please apply your changes to the
+	//	 latest version and try again
+	//
+	{
+		o, err := r.g8sClient.ProviderV1alpha1().AzureConfigs(cr.Namespace).Get(cr.Name, metav1.GetOptions{})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		cr = *o
+	}
+
+	if _, changed := key.SetResourceCondition(&cr, Name, PausedConditionType, status, now); !changed {
+		return nil
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", "updating paused condition")
+
+	_, err = r.g8sClient.ProviderV1alpha1().AzureConfigs(cr.Namespace).UpdateStatus(&cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.LogCtx(ctx, "level", "debug", "message", "updated paused condition")
+
+	until, expires := key.PausedUntil(cr)
+	switch {
+	case paused && expires:
+		r.eventRecorder.Eventf(&cr, corev1.EventTypeWarning, recorder.ReasonReconciliationPaused, "reconciliation paused with annotation %s until %s", key.AnnotationPaused, until.Format(time.RFC3339))
+	case paused:
+		r.eventRecorder.Eventf(&cr, corev1.EventTypeWarning, recorder.ReasonReconciliationPaused, "reconciliation paused with annotation %s", key.AnnotationPaused)
+	case expires && !now.Before(until):
+		r.eventRecorder.Eventf(&cr, corev1.EventTypeNormal, recorder.ReasonReconciliationResumed, "reconciliation resumed as the pause expired at %s", until.Format(time.RFC3339))
+	default:
+		r.eventRecorder.Eventf(&cr, corev1.EventTypeNormal, recorder.ReasonReconciliationResumed, "reconciliation resumed")
+	}
+
+	return nil
+}
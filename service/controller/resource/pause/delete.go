@@ -0,0 +1,11 @@
+package pause
+
+import (
+	"context"
+)
+
+// EnsureDeleted does not update the paused condition because the status of a
+// cluster being deleted is not of interest anymore.
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	return nil
+}
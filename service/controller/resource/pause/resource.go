@@ -0,0 +1,57 @@
+package pause
+
+import (
+	"github.com/giantswarm/apiextensions/pkg/clientset/versioned"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	Name = "pause"
+)
+
+const (
+	// PausedConditionType is the condition type telling whether the
+	// reconciliation of the cluster is paused.
+	PausedConditionType = "Paused"
+)
+
+type Config struct {
+	EventRecorder record.EventRecorder
+	G8sClient     versioned.Interface
+	Logger        micrologger.Logger
+}
+
+// Resource reflects whether the reconciliation of a cluster is paused with the
+// key.AnnotationPaused annotation in a condition of the cluster's status. The
+// resources which are actually skipped are wrapped with pauseresource.
+type Resource struct {
+	eventRecorder record.EventRecorder
+	g8sClient     versioned.Interface
+	logger        micrologger.Logger
+}
+
+func New(config Config) (*Resource, error) {
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
+	if config.G8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.G8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	r := &Resource{
+		eventRecorder: config.EventRecorder,
+		g8sClient:     config.G8sClient,
+		logger:        config.Logger,
+	}
+
+	return r, nil
+}
+
+func (r *Resource) Name() string {
+	return Name
+}
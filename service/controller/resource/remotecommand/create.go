@@ -0,0 +1,129 @@
+package remotecommand
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	providerv1alpha1 "github.com/giantswarm/apiextensions/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/remotecommand"
+	"github.com/giantswarm/azure-operator/v4/service/controller/key"
+)
+
+// EnsureCreated runs the script requested with the key.AnnotationRemoteCommand
+// annotation, unless the request ran already. Invalid requests are only
+// logged, so that they do not keep the cluster from being reconciled.
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCustomResource(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	rc, err := key.RemoteCommandRequest(cr)
+	if key.IsInvalidAnnotation(err) {
+		r.logger.LogCtx(ctx, "level", "warning", "message", "ignoring invalid remote command request", "stack", fmt.Sprintf("%#v", err))
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if rc == nil {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "no remote command requested")
+		return nil
+	}
+
+	lastRequestID, err := r.getResourceStatus(cr, LastRequestID)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	if lastRequestID == rc.ID {
+		r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("remote command %#q ran already", rc.ID))
+		return nil
+	}
+
+	script, err := remotecommand.Lookup(rc.Script, rc.Version)
+	if remotecommand.IsNotFound(err) {
+		r.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("ignoring remote command %#q of unknown script", rc.ID), "stack", fmt.Sprintf("%#v", err))
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if rc.Role == key.RemoteCommandRoleWorker {
+		_, err = key.NodePoolByName(cr, rc.NodePoolName())
+		if key.IsNotFound(err) || key.IsInvalidNodePool(err) {
+			r.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("ignoring remote command %#q of unknown node pool", rc.ID), "stack", fmt.Sprintf("%#v", err))
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	vmssName := rc.VMSSName(cr)
+
+	instanceIDs := rc.Instances
+	if len(instanceIDs) == 0 {
+		instanceIDs, err = r.allInstanceIDs(ctx, cr, vmssName)
+		if IsNotFound(err) {
+			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("did not find the scale set %#q", vmssName))
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	request := remotecommand.Request{
+		ID:          rc.ID,
+		Script:      script,
+		VMSSName:    vmssName,
+		InstanceIDs: instanceIDs,
+	}
+	results, err := r.remoteCommand.Run(ctx, cr, request)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.setResourceStatus(cr, LastRequestID, rc.ID)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	failed := remotecommand.FailedInstances(results)
+	if len(failed) > 0 {
+		r.eventRecorder.Eventf(&cr, corev1.EventTypeWarning, recorder.ReasonRemoteCommandFailed, "remote command %s failed to run script %s on instances %s of %s", rc.ID, script.ID(), strings.Join(failed, ", "), vmssName)
+	} else {
+		r.eventRecorder.Eventf(&cr, corev1.EventTypeNormal, recorder.ReasonRemoteCommandSucceeded, "remote command %s ran script %s on %d instances of %s", rc.ID, script.ID(), len(results), vmssName)
+	}
+
+	return nil
+}
+
+func (r *Resource) allInstanceIDs(ctx context.Context, customObject providerv1alpha1.AzureConfig, vmssName string) ([]string, error) {
+	c, err := r.getVMsClient(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	result, err := c.List(ctx, key.ResourceGroupName(customObject), vmssName, "", "", "")
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var ids []string
+	for result.NotDone() {
+		for _, i := range result.Values() {
+			ids = append(ids, *i.InstanceID)
+		}
+
+		err := result.Next()
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return ids, nil
+}
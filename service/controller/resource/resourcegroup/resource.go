@@ -2,6 +2,7 @@ package resourcegroup
 
 import (
 	"context"
+	"net/http"
 
 	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/Azure/go-autorest/autorest/to"
@@ -9,9 +10,12 @@ import (
 	"github.com/giantswarm/micrologger"
 	"github.com/giantswarm/operatorkit/controller/context/finalizerskeptcontext"
 	"github.com/giantswarm/operatorkit/controller/context/reconciliationcanceledcontext"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/giantswarm/azure-operator/v4/pkg/project"
 	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
 )
@@ -22,7 +26,8 @@ const (
 )
 
 type Config struct {
-	Logger micrologger.Logger
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
 
 	Azure            setting.Azure
 	InstallationName string
@@ -30,13 +35,17 @@ type Config struct {
 
 // Resource manages Azure resource groups.
 type Resource struct {
-	logger micrologger.Logger
+	eventRecorder record.EventRecorder
+	logger        micrologger.Logger
 
 	azure            setting.Azure
 	installationName string
 }
 
 func New(config Config) (*Resource, error) {
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
@@ -51,8 +60,9 @@ func New(config Config) (*Resource, error) {
 	r := &Resource{
 		installationName: config.InstallationName,
 
-		azure:  config.Azure,
-		logger: config.Logger,
+		azure:         config.Azure,
+		eventRecorder: config.EventRecorder,
+		logger:        config.Logger,
 	}
 
 	return r, nil
@@ -78,11 +88,15 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		ManagedBy: to.StringPtr(project.Name()),
 		Tags:      key.ClusterTags(cr, r.installationName),
 	}
-	_, err = groupsClient.CreateOrUpdate(ctx, *resourceGroup.Name, resourceGroup)
+	group, err := groupsClient.CreateOrUpdate(ctx, *resourceGroup.Name, resourceGroup)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
+	if group.Response.Response != nil && group.StatusCode == http.StatusCreated {
+		r.eventRecorder.Eventf(&cr, corev1.EventTypeNormal, recorder.ReasonResourceGroupCreated, "resource group %s created in %s", *resourceGroup.Name, r.azure.Location)
+	}
+
 	r.logger.LogCtx(ctx, "level", "debug", "message", "ensured resource group is created")
 
 	return nil
@@ -120,6 +134,7 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 			}
 
 			r.logger.LogCtx(ctx, "level", "debug", "message", "resource group deletion in progress")
+			r.eventRecorder.Eventf(&cr, corev1.EventTypeNormal, recorder.ReasonResourceGroupDeleting, "resource group %s deletion in progress", key.ClusterID(cr))
 			finalizerskeptcontext.SetKept(ctx)
 			reconciliationcanceledcontext.SetCanceled(ctx)
 			r.logger.LogCtx(ctx, "level", "debug", "message", "canceling reconciliation")
@@ -6,7 +6,9 @@ import (
 
 	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
 
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 )
 
@@ -60,19 +62,21 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 
 	// Prepare VPN Gateway deployment
 	var deployment azureresource.Deployment
+	var created bool
 	{
 		d, err := deploymentsClient.Get(ctx, key.ClusterID(cr), vpnDeploymentName)
 		if IsNotFound(err) {
-			// fallthrough
+			created = true
 		} else if err != nil {
 			return microerror.Mask(err)
 		} else {
 			s := *d.Properties.ProvisioningState
+			r.debugger.ReportDeployment(key.ClusterID(cr), d)
 
 			r.logger.LogCtx(ctx, "level", "debug", "message", fmt.Sprintf("vpn gateway deployment is in state '%s'", s))
 
 			if !key.IsSucceededProvisioningState(s) {
-				r.debugger.LogFailedDeployment(ctx, d, err)
+				r.debugger.LogFailedDeployment(ctx, &cr, d, err)
 			}
 			if !key.IsFinalProvisioningState(s) {
 				r.logger.LogCtx(ctx, "level", "debug", "message", "canceling resource")
@@ -86,6 +90,16 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		}
 	}
 
+	approved, err := r.planner.Approved(ctx, cr, vpnDeploymentName, deployment)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	if !approved {
+		r.logger.LogCtx(ctx, "level", "debug", "message", "vpn gateway deployment awaits approval")
+		r.logger.LogCtx(ctx, "level", "debug", "message", "canceling resource")
+		return nil
+	}
+
 	// Create/Update VPN Gateway deployment
 	res, err := deploymentsClient.CreateOrUpdate(ctx, key.ClusterID(cr), vpnDeploymentName, deployment)
 	if err != nil {
@@ -97,6 +111,10 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	}
 
+	if created {
+		r.eventRecorder.Eventf(&cr, corev1.EventTypeNormal, recorder.ReasonDeploymentUpdated, "deployment %s created", vpnDeploymentName)
+	}
+
 	r.logger.LogCtx(ctx, "level", "debug", "message", "ensured vpn gateway")
 
 	return nil
@@ -7,9 +7,11 @@ import (
 	azureresource "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
 	"github.com/giantswarm/azure-operator/v4/service/controller/debugger"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/planner"
 	"github.com/giantswarm/azure-operator/v4/service/controller/setting"
 )
 
@@ -20,18 +22,23 @@ const (
 
 // Config contains information required by Resource.
 type Config struct {
-	Debugger *debugger.Debugger
-	Logger   micrologger.Logger
+	Debugger      *debugger.Debugger
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
 
 	Azure setting.Azure
+	// Planner approves the deployment of the ARM template.
+	Planner planner.Interface
 }
 
 // Resource ensures Microsoft Virtual Network Gateways are running.
 type Resource struct {
-	debugger *debugger.Debugger
-	logger   micrologger.Logger
+	debugger      *debugger.Debugger
+	eventRecorder record.EventRecorder
+	logger        micrologger.Logger
 
-	azure setting.Azure
+	azure   setting.Azure
+	planner planner.Interface
 }
 
 // New validates Config and creates a new Resource with it.
@@ -39,18 +46,26 @@ func New(config Config) (*Resource, error) {
 	if config.Debugger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Debugger must not be empty", config)
 	}
+	if config.EventRecorder == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.EventRecorder must not be empty", config)
+	}
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if config.Planner == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Planner must not be empty", config)
+	}
 	if err := config.Azure.Validate(); err != nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Azure.%s", config, err)
 	}
 
 	r := &Resource{
-		debugger: config.Debugger,
-		logger:   config.Logger,
+		debugger:      config.Debugger,
+		eventRecorder: config.EventRecorder,
+		logger:        config.Logger,
 
-		azure: config.Azure,
+		azure:   config.Azure,
+		planner: config.Planner,
 	}
 
 	return r, nil
@@ -6,7 +6,6 @@ import (
 	"net"
 	"time"
 
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/giantswarm/certs"
 	"github.com/giantswarm/k8sclient"
 	"github.com/giantswarm/microerror"
@@ -19,14 +18,23 @@ import (
 	"github.com/giantswarm/randomkeys"
 	"github.com/giantswarm/statusresource"
 	"github.com/giantswarm/tenantcluster"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/giantswarm/azure-operator/v4/client"
-	"github.com/giantswarm/azure-operator/v4/pkg/credential"
 	"github.com/giantswarm/azure-operator/v4/pkg/locker"
 	"github.com/giantswarm/azure-operator/v4/pkg/project"
 	"github.com/giantswarm/azure-operator/v4/service/controller/cloudconfig"
 	"github.com/giantswarm/azure-operator/v4/service/controller/controllercontext"
 	"github.com/giantswarm/azure-operator/v4/service/controller/debugger"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/drainer"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/etcd"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/healthgate"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/maintenance"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/pauseresource"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/planner"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/quota"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/recorder"
+	"github.com/giantswarm/azure-operator/v4/service/controller/internal/remotecommand"
 	"github.com/giantswarm/azure-operator/v4/service/controller/internal/vmsscheck"
 	"github.com/giantswarm/azure-operator/v4/service/controller/key"
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/blobobject"
@@ -35,11 +43,14 @@ import (
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/dnsrecord"
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/encryptionkey"
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/endpoints"
+	"github.com/giantswarm/azure-operator/v4/service/controller/resource/etcdmembers"
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/instance"
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/ipam"
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/masters"
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/namespace"
+	"github.com/giantswarm/azure-operator/v4/service/controller/resource/pause"
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/release"
+	remotecommandresource "github.com/giantswarm/azure-operator/v4/service/controller/resource/remotecommand"
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/resourcegroup"
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/service"
 	"github.com/giantswarm/azure-operator/v4/service/controller/resource/tenantclients"
@@ -53,22 +64,33 @@ type ResourceSetConfig struct {
 	K8sClient     k8sclient.Interface
 	Logger        micrologger.Logger
 
-	Azure                     setting.Azure
-	CPAzureClientSet          *client.AzureClientSet
-	GSClientCredentialsConfig auth.ClientCredentialsConfig
-	GuestSubnetMaskBits       int
-	Ignition                  setting.Ignition
-	InstallationName          string
-	IPAMNetworkRange          net.IPNet
-	Locker                    locker.Interface
-	ProjectName               string
-	RegistryDomain            string
-	OIDC                      setting.OIDC
-	SSOPublicKey              string
-	VMSSCheckWorkers          int
+	Azure               setting.Azure
+	AzureClientSetCache *client.Cache
+	Canary              setting.Canary
+	CPAzureClientSet    *client.AzureClientSet
+	Drainer             setting.Drainer
+	DryRun              bool
+	GuestSubnetMaskBits int
+	Ignition            setting.Ignition
+	InstallationName    string
+	IPAMNetworkRange    net.IPNet
+	Locker              locker.Interface
+	Maintenance         setting.Maintenance
+	ProjectName         string
+	RegistryDomain      string
+	Remediation         setting.Remediation
+	RemoteCommand       setting.RemoteCommand
+	Rollback            setting.Rollback
+	RollingUpdate       setting.RollingUpdate
+	OIDC                setting.OIDC
+	SSOPublicKey        string
+	VMSSCheckWorkers    int
 }
 
 func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
+	if config.AzureClientSetCache == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.AzureClientSetCache must not be empty", config)
+	}
 	if config.K8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
 	}
@@ -96,10 +118,33 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 		}
 	}
 
+	var eventRecorder record.EventRecorder
+	{
+		c := recorder.Config{
+			K8sClient: config.K8sClient,
+			Logger:    config.Logger,
+
+			Component: config.ProjectName,
+		}
+
+		eventRecorder, err = recorder.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	checker := &credentialChecker{
+		cache:         config.AzureClientSetCache,
+		eventRecorder: eventRecorder,
+		k8sClient:     config.K8sClient,
+		logger:        config.Logger,
+	}
+
 	var newDebugger *debugger.Debugger
 	{
 		c := debugger.Config{
-			Logger: config.Logger,
+			EventRecorder: eventRecorder,
+			Logger:        config.Logger,
 		}
 
 		newDebugger, err = debugger.New(c)
@@ -184,7 +229,8 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 	var resourceGroupResource resource.Interface
 	{
 		c := resourcegroup.Config{
-			Logger: config.Logger,
+			EventRecorder: eventRecorder,
+			Logger:        config.Logger,
 
 			Azure:            config.Azure,
 			InstallationName: config.InstallationName,
@@ -243,14 +289,62 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 		}
 	}
 
+	var remoteCommandRunner remotecommand.Interface
+	{
+		c := remotecommand.Config{
+			K8sClient: config.K8sClient.K8sClient(),
+			Logger:    config.Logger,
+
+			RemoteCommand: config.RemoteCommand,
+		}
+
+		remoteCommandRunner, err = remotecommand.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var deploymentPlanner planner.Interface
+	{
+		c := planner.Config{
+			EventRecorder: eventRecorder,
+			G8sClient:     config.K8sClient.G8sClient(),
+			Logger:        config.Logger,
+
+			DryRun: config.DryRun,
+		}
+
+		deploymentPlanner, err = planner.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var quotaChecker quota.Interface
+	{
+		c := quota.Config{
+			EventRecorder: eventRecorder,
+			G8sClient:     config.K8sClient.G8sClient(),
+			Logger:        config.Logger,
+		}
+
+		quotaChecker, err = quota.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var deploymentResource resource.Interface
 	{
 		c := deployment.Config{
-			Debugger:  newDebugger,
-			G8sClient: config.K8sClient.G8sClient(),
-			Logger:    config.Logger,
+			Debugger:      newDebugger,
+			EventRecorder: eventRecorder,
+			G8sClient:     config.K8sClient.G8sClient(),
+			Logger:        config.Logger,
+			Planner:       deploymentPlanner,
 
-			Azure: config.Azure,
+			Azure:        config.Azure,
+			QuotaChecker: quotaChecker,
 		}
 
 		deploymentResource, err = deployment.New(c)
@@ -298,8 +392,9 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 	var iwd vmsscheck.InstanceWatchdog
 	{
 		c := vmsscheck.Config{
-			Logger:     config.Logger,
-			NumWorkers: config.VMSSCheckWorkers,
+			EventRecorder: eventRecorder,
+			Logger:        config.Logger,
+			NumWorkers:    config.VMSSCheckWorkers,
 		}
 
 		var err error
@@ -309,16 +404,75 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 		}
 	}
 
-	var mastersResource resource.Interface
+	var nodeDrainer drainer.Interface
 	{
-		c := masters.Config{
-			Debugger:  newDebugger,
+		c := drainer.Config{
 			G8sClient: config.K8sClient.G8sClient(),
-			K8sClient: config.K8sClient.K8sClient(),
 			Logger:    config.Logger,
 
+			Drainer: config.Drainer,
+		}
+
+		nodeDrainer, err = drainer.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var maintenanceChecker maintenance.Interface
+	{
+		c := maintenance.Config{
+			Maintenance: config.Maintenance,
+		}
+
+		maintenanceChecker, err = maintenance.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var etcdClient etcd.Interface
+	{
+		c := etcd.Config{
+			CertsSearcher: certsSearcher,
+			Logger:        config.Logger,
+		}
+
+		etcdClient, err = etcd.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var etcdMembersResource resource.Interface
+	{
+		c := etcdmembers.Config{
+			CertsSearcher: certsSearcher,
+			Logger:        config.Logger,
+		}
+
+		etcdMembersResource, err = etcdmembers.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var mastersResource resource.Interface
+	{
+		c := masters.Config{
+			Debugger:      newDebugger,
+			EventRecorder: eventRecorder,
+			G8sClient:     config.K8sClient.G8sClient(),
+			K8sClient:     config.K8sClient.K8sClient(),
+			Logger:        config.Logger,
+			Planner:       deploymentPlanner,
+
 			Azure:            config.Azure,
+			Drainer:          nodeDrainer,
+			Etcd:             etcdClient,
 			InstanceWatchdog: iwd,
+			Maintenance:      maintenanceChecker,
+			RemoteCommand:    remoteCommandRunner,
 		}
 
 		mastersResource, err = masters.New(c)
@@ -330,13 +484,23 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 	var instanceResource resource.Interface
 	{
 		c := instance.Config{
-			Debugger:  newDebugger,
-			G8sClient: config.K8sClient.G8sClient(),
-			K8sClient: config.K8sClient.K8sClient(),
-			Logger:    config.Logger,
+			Debugger:      newDebugger,
+			EventRecorder: eventRecorder,
+			G8sClient:     config.K8sClient.G8sClient(),
+			K8sClient:     config.K8sClient.K8sClient(),
+			Logger:        config.Logger,
+			Planner:       deploymentPlanner,
 
 			Azure:            config.Azure,
+			Canary:           config.Canary,
+			CanaryGates:      healthgate.Default(),
+			Drainer:          nodeDrainer,
 			InstanceWatchdog: iwd,
+			Maintenance:      maintenanceChecker,
+			QuotaChecker:     quotaChecker,
+			Remediation:      config.Remediation,
+			Rollback:         config.Rollback,
+			RollingUpdate:    config.RollingUpdate,
 		}
 
 		instanceResource, err = instance.New(c)
@@ -345,6 +509,21 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 		}
 	}
 
+	var remoteCommandResource resource.Interface
+	{
+		c := remotecommandresource.Config{
+			EventRecorder: eventRecorder,
+			G8sClient:     config.K8sClient.G8sClient(),
+			Logger:        config.Logger,
+			RemoteCommand: remoteCommandRunner,
+		}
+
+		remoteCommandResource, err = remotecommandresource.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var clusterChecker *ipam.ClusterChecker
 	{
 		c := ipam.ClusterCheckerConfig{
@@ -374,10 +553,10 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 	var subnetCollector *ipam.SubnetCollector
 	{
 		c := ipam.SubnetCollectorConfig{
-			GSClientCredentialsConfig: config.GSClientCredentialsConfig,
-			K8sClient:                 config.K8sClient,
-			InstallationName:          config.InstallationName,
-			Logger:                    config.Logger,
+			AzureClientSetCache: config.AzureClientSetCache,
+			K8sClient:           config.K8sClient,
+			InstallationName:    config.InstallationName,
+			Logger:              config.Logger,
 
 			NetworkRange: config.IPAMNetworkRange,
 		}
@@ -443,11 +622,27 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 		}
 	}
 
+	var pauseResource resource.Interface
+	{
+		c := pause.Config{
+			EventRecorder: eventRecorder,
+			G8sClient:     config.K8sClient.G8sClient(),
+			Logger:        config.Logger,
+		}
+
+		pauseResource, err = pause.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var vpnResource resource.Interface
 	{
 		c := vpn.Config{
-			Debugger: newDebugger,
-			Logger:   config.Logger,
+			Debugger:      newDebugger,
+			EventRecorder: eventRecorder,
+			Logger:        config.Logger,
+			Planner:       deploymentPlanner,
 
 			Azure: config.Azure,
 		}
@@ -479,6 +674,7 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 	}
 
 	resources := []resource.Interface{
+		pauseResource,
 		ipamResource,
 		statusResource,
 		releaseResource,
@@ -491,13 +687,35 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 		blobObjectResource,
 		deploymentResource,
 		dnsrecordResource,
+		etcdMembersResource,
 		mastersResource,
 		instanceResource,
+		remoteCommandResource,
 		endpointsResource,
 		vpnResource,
 		vpnconnectionResource,
 	}
 
+	// Only the status of paused clusters is updated.
+	{
+		c := pauseresource.WrapConfig{
+			Logger: config.Logger,
+
+			Exclude: []string{
+				pauseResource.Name(),
+				statusResource.Name(),
+				releaseResource.Name(),
+				tenantClientsResource.Name(),
+				containerURLResource.Name(),
+			},
+		}
+
+		resources, err = pauseresource.Wrap(resources, c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	{
 		c := retryresource.WrapConfig{
 			Logger: config.Logger,
@@ -537,15 +755,16 @@ func NewResourceSet(config ResourceSetConfig) (*controller.ResourceSet, error) {
 			return nil, microerror.Mask(err)
 		}
 
-		organizationAzureClientCredentialsConfig, subscriptionID, partnerID, err := credential.GetOrganizationAzureCredentials(config.K8sClient, cr, config.GSClientCredentialsConfig.TenantID)
+		err = checker.Check(ctx, cr)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
 
-		tenantClusterAzureClientSet, err := client.NewAzureClientSet(organizationAzureClientCredentialsConfig, subscriptionID, partnerID)
+		tenantClusterAzureClientSet, organizationAzureClientCredentialsConfig, err := config.AzureClientSetCache.GetOrganizationClientSet(cr)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
+		subscriptionID := tenantClusterAzureClientSet.SubscriptionID
 
 		var cloudConfig *cloudconfig.CloudConfig
 		{
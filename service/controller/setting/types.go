@@ -1,6 +1,11 @@
 package setting
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
 
 type Azure struct {
 	EnvironmentName string
@@ -51,6 +56,43 @@ type AzureMSI struct {
 	Enabled bool
 }
 
+const (
+	// DrainerTypeNative drains nodes within the operator using the tenant
+	// cluster's API.
+	DrainerTypeNative = "native"
+	// DrainerTypeNodeOperator drains nodes by creating DrainerConfig custom
+	// resources reconciled by the node-operator.
+	DrainerTypeNodeOperator = "node-operator"
+)
+
+// Drainer configures how tenant cluster nodes are drained before their
+// instances are reimaged or terminated.
+type Drainer struct {
+	// Type is either DrainerTypeNative or DrainerTypeNodeOperator.
+	Type string
+	// GracePeriod is the termination grace period of evicted pods. Negative
+	// values use the grace period of the pods. Only used by the native
+	// drainer.
+	GracePeriod time.Duration
+	// Timeout is the time after which draining a node is considered timed
+	// out. Only used by the native drainer.
+	Timeout time.Duration
+}
+
+func (d Drainer) Validate() error {
+	switch d.Type {
+	case DrainerTypeNative:
+		if d.Timeout <= 0 {
+			return fmt.Errorf("Timeout must be positive")
+		}
+	case DrainerTypeNodeOperator:
+	default:
+		return fmt.Errorf("Type must be one of %q or %q", DrainerTypeNative, DrainerTypeNodeOperator)
+	}
+
+	return nil
+}
+
 type Ignition struct {
 	Path       string
 	Debug      bool
@@ -58,9 +100,175 @@ type Ignition struct {
 	LogsToken  string
 }
 
+// Canary configures the canary phase of worker upgrades, which brings up a
+// single new worker per node pool and checks the health of the tenant cluster
+// before the other workers get replaced.
+type Canary struct {
+	// Enabled is the default of clusters without canary annotation.
+	Enabled bool
+	// Timeout is the time the health gates have to pass for a canary before
+	// the upgrade halts. Zero disables the timeout.
+	Timeout time.Duration
+}
+
+func (c Canary) Validate() error {
+	if c.Timeout < 0 {
+		return fmt.Errorf("Timeout must not be negative")
+	}
+
+	return nil
+}
+
+// OrganizationWildcard is the organization whose maintenance windows apply to
+// the clusters of all organizations without maintenance windows of their own.
+const OrganizationWildcard = "*"
+
+// Maintenance configures when disruptive upgrades of clusters may happen.
+type Maintenance struct {
+	// OrganizationWindows maps organizations to the maintenance windows of
+	// their clusters. Clusters of organizations without maintenance windows
+	// get upgraded at any time. The windows can be overridden per cluster with
+	// an annotation.
+	OrganizationWindows map[string][]MaintenanceWindow
+}
+
+// MaintenanceWindow is a recurring period of time in which disruptive
+// upgrades of clusters may start.
+type MaintenanceWindow struct {
+	// Schedule is a cron expression made of the five fields minute, hour, day
+	// of month, month and day of week, telling when the window opens.
+	Schedule string `json:"schedule"`
+	// Duration is the time the window stays open, e.g. "4h".
+	Duration string `json:"duration"`
+	// Timezone is the IANA time zone the schedule is in, e.g. "Europe/Berlin".
+	// Empty defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
+
 type OIDC struct {
 	ClientID      string
 	IssuerURL     string
 	UsernameClaim string
 	GroupsClaim   string
 }
+
+const (
+	// RemediationActionDelete deletes unhealthy worker instances. The capacity
+	// of their VMSS is restored afterwards, so that Azure creates new instances.
+	RemediationActionDelete = "delete"
+	// RemediationActionReimage reimages unhealthy worker instances in place.
+	RemediationActionReimage = "reimage"
+)
+
+// Remediation configures how worker instances whose nodes are unhealthy get
+// replaced.
+type Remediation struct {
+	// Action is either RemediationActionDelete or RemediationActionReimage.
+	Action string
+	// MaxConcurrent is the default number of instances of a cluster remediated
+	// at once. Zero disables remediation. It can be overridden per cluster
+	// with an annotation.
+	MaxConcurrent int
+	// UnhealthyPeriod is the time a node has to be not ready, or an instance
+	// has to be without node, before its instance gets remediated.
+	UnhealthyPeriod time.Duration
+}
+
+func (r Remediation) Validate() error {
+	switch r.Action {
+	case RemediationActionDelete, RemediationActionReimage:
+	default:
+		return fmt.Errorf("Action must be one of %q or %q", RemediationActionDelete, RemediationActionReimage)
+	}
+	if r.MaxConcurrent < 0 {
+		return fmt.Errorf("MaxConcurrent must not be negative")
+	}
+	if r.UnhealthyPeriod <= 0 {
+		return fmt.Errorf("UnhealthyPeriod must be positive")
+	}
+
+	return nil
+}
+
+// RemoteCommand configures how scripts are run on the instances of clusters.
+type RemoteCommand struct {
+	// MaxConcurrent is the number of instances of a cluster a script runs on
+	// at the same time.
+	MaxConcurrent int
+	// Timeout bounds the run of a script on a single instance.
+	Timeout time.Duration
+}
+
+func (r RemoteCommand) Validate() error {
+	if r.MaxConcurrent <= 0 {
+		return fmt.Errorf("MaxConcurrent must be positive")
+	}
+	if r.Timeout <= 0 {
+		return fmt.Errorf("Timeout must be positive")
+	}
+
+	return nil
+}
+
+// Rollback configures when failed upgrades of worker instances get rolled
+// back to the previous deployment.
+type Rollback struct {
+	// Timeout is the time new worker instances have to become ready before
+	// the upgrade gets rolled back. Zero disables the timeout.
+	Timeout time.Duration
+	// MaxFailures is the number of consecutive failed state transitions after
+	// which the upgrade gets rolled back. Zero disables rolling back on
+	// failures.
+	MaxFailures int
+}
+
+func (r Rollback) Validate() error {
+	if r.Timeout < 0 {
+		return fmt.Errorf("Timeout must not be negative")
+	}
+	if r.MaxFailures < 0 {
+		return fmt.Errorf("MaxFailures must not be negative")
+	}
+
+	return nil
+}
+
+// RollingUpdate configures how many worker instances are replaced at once
+// during upgrades. Values are either absolute numbers or percentages of the
+// desired number of workers, e.g. "3" or "25%".
+type RollingUpdate struct {
+	// MaxSurge is the number of instances created above the desired number of
+	// workers. Percentages are rounded up.
+	MaxSurge string
+	// MaxUnavailable is the number of old instances taken out of service
+	// without a replacement being available. Percentages are rounded down.
+	MaxUnavailable string
+}
+
+func (r RollingUpdate) Validate() error {
+	if err := validateIntOrPercent(r.MaxSurge); err != nil {
+		return fmt.Errorf("MaxSurge %s", err)
+	}
+	if err := validateIntOrPercent(r.MaxUnavailable); err != nil {
+		return fmt.Errorf("MaxUnavailable %s", err)
+	}
+
+	return nil
+}
+
+func validateIntOrPercent(s string) error {
+	if s == "" {
+		return fmt.Errorf("must not be empty")
+	}
+
+	v := intstr.Parse(s)
+	n, err := intstr.GetValueFromIntOrPercent(&v, 100, true)
+	if err != nil {
+		return fmt.Errorf("must be a number or a percentage: %s", err)
+	}
+	if n < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+
+	return nil
+}
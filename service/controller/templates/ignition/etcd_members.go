@@ -0,0 +1,111 @@
+package ignition
+
+// EtcdMembersDropIn makes etcd3.service start the local member of the etcd
+// cluster of highly available control planes instead of a single member
+// cluster.
+const EtcdMembersDropIn = `[Service]
+ExecStart=
+ExecStart=/opt/bin/etcd3-members
+`
+
+// EtcdMembersScript starts the local member of the etcd cluster of highly
+// available control planes. Masters share their cloudconfig, so every master
+// finds its member by the member DNS record pointing to its private IP. Masters
+// without etcd data join the running cluster, replacing their stale member.
+const EtcdMembersScript = `#!/bin/bash
+set -o errexit -o nounset -o pipefail
+
+MEMBERS=({{ range .EtcdMembers }}"{{ .Name }}={{ .Domain }}" {{ end }})
+PEER_URLS=({{ range .EtcdMembers }}"{{ .Name }}={{ .PeerURL }}" {{ end }})
+INITIAL_CLUSTER="{{ range $i, $m := .EtcdMembers }}{{ if $i }},{{ end }}{{ $m.Name }}={{ $m.PeerURL }}{{ end }}"
+
+etcdctl() {
+  /usr/bin/docker run --rm --net=host \
+    -v /etc/kubernetes/ssl/etcd/:/etc/etcd \
+    -e ETCDCTL_API=3 \
+    "${IMAGE}" \
+    etcdctl \
+    --cacert /etc/etcd/server-ca.pem \
+    --cert /etc/etcd/server-crt.pem \
+    --key /etc/etcd/server-key.pem \
+    "$@"
+}
+
+SELF_NAME=""
+until [ -n "${SELF_NAME}" ]; do
+  for member in "${MEMBERS[@]}"; do
+    if getent ahostsv4 "${member#*=}" | awk '{ print $1 }' | grep -qx "${DEFAULT_IPV4}"; then
+      SELF_NAME="${member%%=*}"
+    fi
+  done
+  if [ -z "${SELF_NAME}" ]; then
+    echo "Waiting for an etcd member DNS record to point to ${DEFAULT_IPV4}"
+    sleep 10
+  fi
+done
+
+SELF_PEER_URL=""
+for peer_url in "${PEER_URLS[@]}"; do
+  if [ "${peer_url%%=*}" = "${SELF_NAME}" ]; then
+    SELF_PEER_URL="${peer_url#*=}"
+  fi
+done
+INITIAL_CLUSTER_STATE="new"
+
+if [ ! -d /var/lib/etcd/member ]; then
+  for member in "${MEMBERS[@]}"; do
+    if [ "${member%%=*}" = "${SELF_NAME}" ]; then
+      continue
+    fi
+
+    ENDPOINT="https://${member#*=}:2379"
+    if ! MEMBER_LIST=$(etcdctl --endpoints "${ENDPOINT}" member list); then
+      continue
+    fi
+
+    # Members listed without name never started. They are part of the
+    # bootstrap of a new cluster, which this master joins as such.
+    SELF_ENTRY=$(echo "${MEMBER_LIST}" | awk -F', ' -v url="${SELF_PEER_URL}" '$4 == url')
+    if [ -n "${SELF_ENTRY}" ] && [ -z "$(echo "${SELF_ENTRY}" | awk -F', ' '{ print $3 }')" ]; then
+      break
+    fi
+
+    if [ -n "${SELF_ENTRY}" ]; then
+      echo "Removing stale etcd member ${SELF_NAME}"
+      etcdctl --endpoints "${ENDPOINT}" member remove "$(echo "${SELF_ENTRY}" | awk -F', ' '{ print $1 }')"
+    fi
+
+    echo "Adding etcd member ${SELF_NAME}"
+    INITIAL_CLUSTER=$(etcdctl --endpoints "${ENDPOINT}" member add "${SELF_NAME}" --peer-urls="${SELF_PEER_URL}" | sed -n 's/^ETCD_INITIAL_CLUSTER="\(.*\)"$/\1/p')
+    INITIAL_CLUSTER_STATE="existing"
+    break
+  done
+fi
+
+exec /usr/bin/docker run \
+  -v /etc/ssl/certs/ca-certificates.crt:/etc/ssl/certs/ca-certificates.crt \
+  -v /etc/kubernetes/ssl/etcd/:/etc/etcd \
+  -v /var/lib/etcd/:/var/lib/etcd \
+  --net=host \
+  --name "${NAME}" \
+  "${IMAGE}" \
+  etcd \
+  --name "${SELF_NAME}" \
+  --trusted-ca-file /etc/etcd/server-ca.pem \
+  --cert-file /etc/etcd/server-crt.pem \
+  --key-file /etc/etcd/server-key.pem \
+  --client-cert-auth=true \
+  --peer-trusted-ca-file /etc/etcd/server-ca.pem \
+  --peer-cert-file /etc/etcd/server-crt.pem \
+  --peer-key-file /etc/etcd/server-key.pem \
+  --peer-client-cert-auth=true \
+  --advertise-client-urls=https://{{ .EtcdDomain }}:{{ .EtcdPort }} \
+  --initial-advertise-peer-urls="${SELF_PEER_URL}" \
+  --listen-client-urls=https://0.0.0.0:2379 \
+  --listen-peer-urls="https://${DEFAULT_IPV4}:2380" \
+  --initial-cluster-token k8s-etcd-cluster \
+  --initial-cluster "${INITIAL_CLUSTER}" \
+  --initial-cluster-state "${INITIAL_CLUSTER_STATE}" \
+  --data-dir=/var/lib/etcd \
+  --enable-v2
+`
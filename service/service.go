@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"sync"
@@ -20,7 +21,9 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/giantswarm/azure-operator/v4/client"
+	"github.com/giantswarm/azure-operator/v4/client/senddecorator"
 	"github.com/giantswarm/azure-operator/v4/flag"
+	"github.com/giantswarm/azure-operator/v4/pkg/backpressure"
 	"github.com/giantswarm/azure-operator/v4/pkg/credential"
 	"github.com/giantswarm/azure-operator/v4/pkg/locker"
 	"github.com/giantswarm/azure-operator/v4/pkg/project"
@@ -105,6 +108,46 @@ func New(config Config) (*Service, error) {
 		Location: config.Viper.GetString(config.Flag.Service.Azure.Location),
 	}
 
+	rollingUpdate := setting.RollingUpdate{
+		MaxSurge:       config.Viper.GetString(config.Flag.Service.Azure.RollingUpdate.MaxSurge),
+		MaxUnavailable: config.Viper.GetString(config.Flag.Service.Azure.RollingUpdate.MaxUnavailable),
+	}
+
+	drainer := setting.Drainer{
+		GracePeriod: config.Viper.GetDuration(config.Flag.Service.Drainer.GracePeriod),
+		Timeout:     config.Viper.GetDuration(config.Flag.Service.Drainer.Timeout),
+		Type:        config.Viper.GetString(config.Flag.Service.Drainer.Type),
+	}
+
+	canary := setting.Canary{
+		Enabled: config.Viper.GetBool(config.Flag.Service.Canary.Enabled),
+		Timeout: config.Viper.GetDuration(config.Flag.Service.Canary.Timeout),
+	}
+
+	remoteCommand := setting.RemoteCommand{
+		MaxConcurrent: config.Viper.GetInt(config.Flag.Service.RemoteCommand.MaxConcurrent),
+		Timeout:       config.Viper.GetDuration(config.Flag.Service.RemoteCommand.Timeout),
+	}
+
+	var maintenance setting.Maintenance
+	if v := config.Viper.GetString(config.Flag.Service.Maintenance.Windows); v != "" {
+		err := json.Unmarshal([]byte(v), &maintenance.OrganizationWindows)
+		if err != nil {
+			return nil, microerror.Maskf(invalidConfigError, "%s must be a JSON object mapping organizations to maintenance windows: %s", config.Flag.Service.Maintenance.Windows, err)
+		}
+	}
+
+	remediation := setting.Remediation{
+		Action:          config.Viper.GetString(config.Flag.Service.Remediation.Action),
+		MaxConcurrent:   config.Viper.GetInt(config.Flag.Service.Remediation.MaxConcurrent),
+		UnhealthyPeriod: config.Viper.GetDuration(config.Flag.Service.Remediation.UnhealthyPeriod),
+	}
+
+	rollback := setting.Rollback{
+		MaxFailures: config.Viper.GetInt(config.Flag.Service.Rollback.MaxFailures),
+		Timeout:     config.Viper.GetDuration(config.Flag.Service.Rollback.Timeout),
+	}
+
 	Ignition := setting.Ignition{
 		Path:       config.Viper.GetString(config.Flag.Service.Tenant.Ignition.Path),
 		Debug:      config.Viper.GetBool(config.Flag.Service.Tenant.Ignition.Debug.Enabled),
@@ -223,27 +266,70 @@ func New(config Config) (*Service, error) {
 			return nil, microerror.Mask(err)
 		}
 
-		cpAzureClientSet, err := NewCPAzureClientSet(config, gsClientCredentialsConfig)
+		// Backpressure is shared per subscription and resource provider
+		// between all Azure client sets.
+		backpressures := &backpressure.Registry{}
+
+		var throttle *senddecorator.Throttle
+		{
+			c := senddecorator.ThrottleConfig{
+				DeferThreshold:    config.Viper.GetFloat64(config.Flag.Service.Azure.RateLimit.DeferThreshold),
+				SlowdownDelay:     config.Viper.GetDuration(config.Flag.Service.Azure.RateLimit.SlowdownDelay),
+				SlowdownThreshold: config.Viper.GetFloat64(config.Flag.Service.Azure.RateLimit.SlowdownThreshold),
+			}
+
+			throttle, err = senddecorator.NewThrottle(c)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+		}
+
+		cpAzureClientSet, err := NewCPAzureClientSet(config, gsClientCredentialsConfig, backpressures, throttle)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
 
+		var azureClientSetCache *client.Cache
+		{
+			c := client.CacheConfig{
+				Backpressures: backpressures,
+				K8sClient:     k8sClient,
+				Throttle:      throttle,
+
+				GSTenantID: gsClientCredentialsConfig.TenantID,
+				MSIEnabled: azure.MSI.Enabled,
+			}
+
+			azureClientSetCache, err = client.NewCache(c)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+		}
+
 		c := controller.ClusterConfig{
-			Azure:                     azure,
-			CPAzureClientSet:          cpAzureClientSet,
-			GSClientCredentialsConfig: gsClientCredentialsConfig,
-			GuestSubnetMaskBits:       config.Viper.GetInt(config.Flag.Service.Installation.Guest.IPAM.Network.SubnetMaskBits),
-			Ignition:                  Ignition,
-			InstallationName:          config.Viper.GetString(config.Flag.Service.Installation.Name),
-			IPAMNetworkRange:          ipamNetworkRange,
-			K8sClient:                 k8sClient,
-			Locker:                    kubeLockLocker,
-			Logger:                    config.Logger,
-			OIDC:                      OIDC,
-			ProjectName:               config.ProjectName,
-			RegistryDomain:            config.Viper.GetString(config.Flag.Service.RegistryDomain),
-			SSOPublicKey:              config.Viper.GetString(config.Flag.Service.Tenant.SSH.SSOPublicKey),
-			VMSSCheckWorkers:          config.Viper.GetInt(config.Flag.Service.Azure.VMSSCheckWorkers),
+			Azure:               azure,
+			AzureClientSetCache: azureClientSetCache,
+			Canary:              canary,
+			CPAzureClientSet:    cpAzureClientSet,
+			Drainer:             drainer,
+			DryRun:              config.Viper.GetBool(config.Flag.Service.Azure.DryRun),
+			GuestSubnetMaskBits: config.Viper.GetInt(config.Flag.Service.Installation.Guest.IPAM.Network.SubnetMaskBits),
+			Ignition:            Ignition,
+			InstallationName:    config.Viper.GetString(config.Flag.Service.Installation.Name),
+			IPAMNetworkRange:    ipamNetworkRange,
+			K8sClient:           k8sClient,
+			Locker:              kubeLockLocker,
+			Logger:              config.Logger,
+			Maintenance:         maintenance,
+			OIDC:                OIDC,
+			ProjectName:         config.ProjectName,
+			RegistryDomain:      config.Viper.GetString(config.Flag.Service.RegistryDomain),
+			Remediation:         remediation,
+			RemoteCommand:       remoteCommand,
+			Rollback:            rollback,
+			RollingUpdate:       rollingUpdate,
+			SSOPublicKey:        config.Viper.GetString(config.Flag.Service.Tenant.SSH.SSOPublicKey),
+			VMSSCheckWorkers:    config.Viper.GetInt(config.Flag.Service.Azure.VMSSCheckWorkers),
 		}
 
 		clusterController, err = controller.NewCluster(c)
@@ -324,7 +410,7 @@ func buildK8sRestConfig(config Config) (*rest.Config, error) {
 }
 
 // NewCPAzureClientSet return an Azure client set configured for the Control Plane cluster.
-func NewCPAzureClientSet(config Config, gsClientCredentialsConfig auth.ClientCredentialsConfig) (*client.AzureClientSet, error) {
+func NewCPAzureClientSet(config Config, gsClientCredentialsConfig auth.ClientCredentialsConfig, backpressures *backpressure.Registry, throttle *senddecorator.Throttle) (*client.AzureClientSet, error) {
 	cpTenantID := config.Viper.GetString(config.Flag.Service.Azure.HostCluster.Tenant.TenantID)
 	if cpTenantID != "" {
 		// We want the code to work both when using Single Tenant Service Principal and Multi Tenant Service Principal.
@@ -342,5 +428,10 @@ func NewCPAzureClientSet(config Config, gsClientCredentialsConfig auth.ClientCre
 		cpPartnerID = config.Viper.GetString(config.Flag.Service.Azure.PartnerID)
 	}
 
-	return client.NewAzureClientSet(gsClientCredentialsConfig, cpSubscriptionID, cpPartnerID)
+	authorizer, err := gsClientCredentialsConfig.Authorizer()
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return client.NewAzureClientSet(authorizer, cpSubscriptionID, cpPartnerID, backpressures, throttle)
 }